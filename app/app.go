@@ -112,6 +112,7 @@ func (app *App) setConfig() {
 	app.config["NT_GRPC_ENABLED"] = ut.ToBoolean(os.Getenv("NT_GRPC_ENABLED"), true)
 	app.config["NT_GRPC_PORT"] = ut.ToInteger(os.Getenv("NT_GRPC_PORT"), 9200)
 	app.config["NT_GRPC_TLS_ENABLED"] = ut.ToBoolean(os.Getenv("NT_GRPC_TLS_ENABLED"), false)
+	app.config["NT_GRPC_MAX_MESSAGE_SIZE"] = ut.ToInteger(os.Getenv("NT_GRPC_MAX_MESSAGE_SIZE"), 64)
 
 	app.config["NT_CLIENT_CONFIG"] = ut.ToString(os.Getenv("NT_CLIENT_CONFIG"), "")
 
@@ -144,6 +145,27 @@ func (app *App) setConfig() {
 	app.config["NT_SMTP_USER"] = ut.ToString(os.Getenv("NT_SMTP_USER"), "")
 	app.config["NT_SMTP_PASSWORD"] = ut.ToString(os.Getenv("NT_SMTP_PASSWORD"), "")
 
+	app.config["NT_EVENT_ENABLED"] = ut.ToBoolean(os.Getenv("NT_EVENT_ENABLED"), false)
+	app.config["NT_EVENT_WEBHOOKS"] = ut.ToString(os.Getenv("NT_EVENT_WEBHOOKS"), "")
+	app.config["NT_EVENT_SECRET"] = ut.ToString(os.Getenv("NT_EVENT_SECRET"), "")
+	app.config["NT_EVENT_DATABASES"] = ut.ToString(os.Getenv("NT_EVENT_DATABASES"), ut.ToString(os.Getenv("NT_ALIAS_DEFAULT"), ""))
+	app.config["NT_EVENT_INTERVAL"] = ut.ToFloat(os.Getenv("NT_EVENT_INTERVAL"), 10)
+	app.config["NT_EVENT_MAX_ATTEMPTS"] = ut.ToInteger(os.Getenv("NT_EVENT_MAX_ATTEMPTS"), 5)
+	app.config["NT_EVENT_RETRY_DELAY"] = ut.ToFloat(os.Getenv("NT_EVENT_RETRY_DELAY"), 10)
+	app.config["NT_EVENT_TIMEOUT"] = ut.ToFloat(os.Getenv("NT_EVENT_TIMEOUT"), 10)
+	app.config["NT_EVENT_STREAM_INTERVAL"] = ut.ToFloat(os.Getenv("NT_EVENT_STREAM_INTERVAL"), 2)
+
+	app.config["NT_VIEW_TABLES"] = ut.ToString(os.Getenv("NT_VIEW_TABLES"), "")
+	app.config["NT_VIEW_MAX_ROWS"] = ut.ToInteger(os.Getenv("NT_VIEW_MAX_ROWS"), 10000)
+	app.config["NT_VIEW_TIMEOUT"] = ut.ToFloat(os.Getenv("NT_VIEW_TIMEOUT"), 30)
+
+	app.config["NT_SCRIPT_ENABLED"] = ut.ToBoolean(os.Getenv("NT_SCRIPT_ENABLED"), false)
+	app.config["NT_SCRIPT_TIMEOUT"] = ut.ToFloat(os.Getenv("NT_SCRIPT_TIMEOUT"), 5)
+
+	app.config["NT_JOURNAL_POSTING"] = ut.ToBoolean(os.Getenv("NT_JOURNAL_POSTING"), false)
+
+	app.config["NT_BANK_MAX_FILE_SIZE"] = ut.ToInteger(os.Getenv("NT_BANK_MAX_FILE_SIZE"), 10)
+
 	app.config["SQL_MAX_OPEN_CONNS"] = ut.ToInteger(os.Getenv("SQL_MAX_OPEN_CONNS"), 10)
 	app.config["SQL_MAX_IDLE_CONNS"] = ut.ToInteger(os.Getenv("SQL_MAX_IDLE_CONNS"), 3)
 	app.config["SQL_CONN_MAX_LIFETIME"] = ut.ToInteger(os.Getenv("SQL_CONN_MAX_LIFETIME"), 15)
@@ -154,7 +176,7 @@ func (app *App) setConfig() {
 	app.config["NT_CORS_ALLOW_ORIGINS"] = strings.Split(ut.ToString(os.Getenv("NT_CORS_ALLOW_ORIGINS"), "*"), ",")
 	app.config["NT_CORS_ALLOW_METHODS"] = strings.Split(ut.ToString(os.Getenv("NT_CORS_ALLOW_METHODS"), "GET,POST,DELETE,OPTIONS"), ",")
 	app.config["NT_CORS_ALLOW_HEADERS"] = strings.Split(ut.ToString(os.Getenv("NT_CORS_ALLOW_HEADERS"), "Accept,Authorization,Content-Type,X-CSRF-Token,X-Api-Key"), ",")
-	app.config["NT_CORS_EXPOSE_HEADERS"] = strings.Split(ut.ToString(os.Getenv("NT_CORS_EXPOSE_HEADERS"), ""), ",")
+	app.config["NT_CORS_EXPOSE_HEADERS"] = strings.Split(ut.ToString(os.Getenv("NT_CORS_EXPOSE_HEADERS"), "X-Total-Count"), ",")
 	app.config["NT_CORS_ALLOW_CREDENTIALS"] = ut.ToBoolean(os.Getenv("NT_CORS_ALLOW_CREDENTIALS"), false)
 	app.config["NT_CORS_MAX_AGE"] = ut.ToInteger(os.Getenv("NT_CORS_MAX_AGE"), 0)
 
@@ -257,6 +279,12 @@ func (app *App) startServer() {
 		return
 	}
 
+	if app.config["NT_EVENT_WEBHOOKS"] != "" && app.config["NT_EVENT_DATABASES"] != "" {
+		g.Go(func() error {
+			return app.startEventDispatcher(ctx)
+		})
+	}
+
 	select {
 	case <-interrupt:
 		break
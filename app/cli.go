@@ -67,14 +67,16 @@ func (s *cliServer) StopService(interface{}) error {
 
 func (s *cliServer) parseFlags() (err error) {
 	s.args = make(nt.SM)
-	var cmds = []string{"server", "Delete", "Function", "Get", "Update", "View",
+	var cmds = []string{"server", "Delete", "Function", "FunctionList", "Get", "Update", "View",
 		"UserPassword", "TokenLogin", "TokenRefresh", "UserLogin", "DatabaseCreate",
-		"Report", "ReportDelete", "ReportInstall", "ReportList", "TokenDecode"}
+		"Report", "ReportDelete", "ReportInstall", "ReportList", "TokenDecode", "Export", "Import", "BankImport",
+		"DatabaseBackup", "DatabaseRestore", "DatabaseUpgrade"}
 	var cmdsO = []string{"Delete", "Function", "Get", "Update", "UserPassword",
-		"UserLogin", "DatabaseCreate", "Report", "ReportDelete", "ReportInstall", "ReportList"}
+		"UserLogin", "DatabaseCreate", "Report", "ReportDelete", "ReportInstall", "ReportList", "Export", "Import",
+		"BankImport", "DatabaseBackup", "DatabaseRestore", "DatabaseUpgrade"}
 	var cmdsNT = []string{"Update"}
 	var cmdsD = []string{"Update", "View"}
-	var cmdsK = []string{"DatabaseCreate"}
+	var cmdsK = []string{"DatabaseCreate", "DatabaseBackup", "DatabaseRestore", "DatabaseUpgrade"}
 
 	var help bool
 	flag.BoolVar(&help, "help", false, ut.GetMessage("cli_usage"))
@@ -124,17 +126,19 @@ func (s *cliServer) parseFlags() (err error) {
 }
 
 func (s *cliServer) checkRequired() (err error) {
-	if _, found := s.args["token"]; !found && s.args["cmd"] != "UserLogin" && s.args["cmd"] != "DatabaseCreate" {
+	if _, found := s.args["token"]; !found && s.args["cmd"] != "UserLogin" &&
+		s.args["cmd"] != "DatabaseCreate" && s.args["cmd"] != "DatabaseBackup" && s.args["cmd"] != "DatabaseRestore" &&
+		s.args["cmd"] != "DatabaseUpgrade" {
 		return errors.New(ut.GetMessage("missing_parameter") + ": token(-t)")
 	}
 	switch s.args["cmd"] {
 	case "Delete", "Function", "Get", "UserPassword",
-		"UserLogin", "Report", "ReportDelete", "ReportInstall", "ReportList":
+		"UserLogin", "Report", "ReportDelete", "ReportInstall", "ReportList", "Export", "Import", "BankImport":
 		if _, found := s.args["options"]; !found {
 			return errors.New(ut.GetMessage("missing_parameter") + ": options(-o)")
 		}
 
-	case "DatabaseCreate":
+	case "DatabaseCreate", "DatabaseBackup", "DatabaseRestore", "DatabaseUpgrade":
 		if _, found := s.args["options"]; !found {
 			return errors.New(ut.GetMessage("missing_parameter") + ": options(-o)")
 		}
@@ -210,6 +214,9 @@ func (s *cliServer) parseCommand() (result string, err error) {
 		"Function": func(api *nt.API) string {
 			return s.service.Function(api, options)
 		},
+		"FunctionList": func(api *nt.API) string {
+			return s.service.FunctionList(api)
+		},
 		"Update": func(api *nt.API) string {
 			return s.service.Update(api, s.args["nervatype"], data)
 		},
@@ -219,6 +226,15 @@ func (s *cliServer) parseCommand() (result string, err error) {
 		"DatabaseCreate": func(api *nt.API) string {
 			return s.service.DatabaseCreate(s.args["key"], options)
 		},
+		"DatabaseBackup": func(api *nt.API) string {
+			return s.service.DatabaseBackup(s.args["key"], options)
+		},
+		"DatabaseRestore": func(api *nt.API) string {
+			return s.service.DatabaseRestore(s.args["key"], options)
+		},
+		"DatabaseUpgrade": func(api *nt.API) string {
+			return s.service.DatabaseUpgrade(s.args["key"], options)
+		},
 		"Report": func(api *nt.API) string {
 			return s.service.Report(api, options)
 		},
@@ -231,6 +247,15 @@ func (s *cliServer) parseCommand() (result string, err error) {
 		"ReportDelete": func(api *nt.API) string {
 			return s.service.ReportDelete(api, options)
 		},
+		"Export": func(api *nt.API) string {
+			return s.service.Export(api, options)
+		},
+		"Import": func(api *nt.API) string {
+			return s.service.Import(api, options)
+		},
+		"BankImport": func(api *nt.API) string {
+			return s.service.BankImport(api, options)
+		},
 	}
 	if _, found := apiMap[s.args["cmd"]]; !found {
 		return "", errors.New(ut.GetMessage("invalid_command") + ": " + s.args["cmd"] + " (-c)")
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	nt "github.com/nervatura/nervatura-service/pkg/nervatura"
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+func (app *App) startEventDispatcher(ctx context.Context) error {
+	databases := []string{}
+	for _, database := range strings.Split(app.config["NT_EVENT_DATABASES"].(string), ",") {
+		if strings.TrimSpace(database) != "" {
+			databases = append(databases, strings.ToLower(strings.TrimSpace(database)))
+		}
+	}
+	interval := ut.ToFloat(app.config["NT_EVENT_INTERVAL"], 10)
+	app.infoLog.Printf(ut.GetMessage("event_dispatching"), strings.Join(databases, ","), interval)
+
+	apis := make(map[string]*nt.API)
+	ticker := time.NewTicker(time.Duration(interval * float64(time.Second)))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			for _, database := range databases {
+				if _, found := apis[database]; !found {
+					apis[database] = &nt.API{NStore: app.GetNervaStore(database)}
+				}
+				_, err := apis[database].DispatchEvents(nt.IM{"database": database})
+				if err != nil {
+					app.errorLog.Printf(ut.GetMessage("error_event_dispatch"), database, err)
+				}
+			}
+		}
+	}
+}
@@ -5,11 +5,13 @@ package app
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"time"
 
+	nt "github.com/nervatura/nervatura-service/pkg/nervatura"
 	pb "github.com/nervatura/nervatura-service/pkg/proto"
 	srv "github.com/nervatura/nervatura-service/pkg/service"
 	ut "github.com/nervatura/nervatura-service/pkg/utils"
@@ -21,6 +23,15 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
 type rpcServer struct {
 	app        *App
 	service    srv.RPCService
@@ -63,12 +74,17 @@ func (s *rpcServer) StartService() error {
 		os.Exit(2)
 	}
 
+	// the backup archives and the export/import files are sent in a single message
+	maxMessageSize := int(s.app.config["NT_GRPC_MAX_MESSAGE_SIZE"].(int64)) * 1024 * 1024
 	s.server = grpc.NewServer(
 		grpc.Creds(cred),
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.MaxSendMsgSize(maxMessageSize),
 		// MaxConnectionAge is just to avoid long connection, to facilitate load balancing
 		// MaxConnectionAgeGrace will torn them, default to infinity
 		grpc.KeepaliveParams(keepalive.ServerParameters{MaxConnectionAge: 2 * time.Minute}),
 		grpc.UnaryInterceptor(s.tokenAuth),
+		grpc.StreamInterceptor(s.tokenStreamAuth),
 	)
 
 	pb.RegisterAPIServer(s.server, &s.service)
@@ -95,6 +111,14 @@ func (s *rpcServer) Results() string {
 	return s.result
 }
 
+// rpcError - the access right errors of the API are returned with PermissionDenied status code
+func rpcError(err error) error {
+	if errors.Is(err, nt.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
 func (s *rpcServer) tokenAuth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 
 	switch info.FullMethod {
@@ -106,7 +130,9 @@ func (s *rpcServer) tokenAuth(ctx context.Context, req interface{}, info *grpc.U
 			return nil, status.Errorf(codes.InvalidArgument, "missing metadata")
 		}
 
-		if info.FullMethod == "/nervatura.API/DatabaseCreate" {
+		switch info.FullMethod {
+		case "/nervatura.API/DatabaseCreate", "/nervatura.API/DatabaseBackup", "/nervatura.API/DatabaseRestore",
+			"/nervatura.API/DatabaseUpgrade":
 			ictx, err := s.service.ApiKeyAuth(md["x-api-key"], ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Unauthenticated, "missing api key")
@@ -118,6 +144,19 @@ func (s *rpcServer) tokenAuth(ctx context.Context, req interface{}, info *grpc.U
 		if err != nil {
 			return nil, status.Errorf(codes.Unauthenticated, "invalid token")
 		}
-		return handler(ictx, req)
+		res, err := handler(ictx, req)
+		return res, rpcError(err)
+	}
+}
+
+func (s *rpcServer) tokenStreamAuth(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	md, ok := metadata.FromIncomingContext(ss.Context())
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "missing metadata")
+	}
+	ictx, err := s.service.TokenAuth(md["authorization"], ss.Context())
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid token")
 	}
+	return rpcError(handler(srv, &authStream{ServerStream: ss, ctx: ictx}))
 }
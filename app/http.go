@@ -258,11 +258,16 @@ func (s *httpServer) setRoutes() {
 	})
 	s.mux.Route("/api", func(r chi.Router) {
 		r.Post("/database", s.service.DatabaseCreate)
+		r.Get("/database/backup", s.service.DatabaseBackup)
+		r.Post("/database/restore", s.service.DatabaseRestore)
+		r.Post("/database/upgrade", s.service.DatabaseUpgrade)
 		r.Get("/config", s.service.ClientConfig)
 		r.Group(func(r chi.Router) {
 			r.Use(s.tokenAuth)
+			r.Get("/function", s.service.FunctionList)
 			r.Post("/function", s.service.Function)
 			r.Post("/view", s.service.View)
+			r.Post("/bank/statement", s.service.BankImport)
 		})
 
 		r.Route("/auth", func(r chi.Router) {
@@ -274,6 +279,12 @@ func (s *httpServer) setRoutes() {
 			})
 		})
 
+		r.Route("/bulk/{nervatype}", func(r chi.Router) {
+			r.Use(s.tokenAuth)
+			r.Get("/", s.service.Export)
+			r.Post("/", s.service.Import)
+		})
+
 		r.Route("/{nervatype}", func(r chi.Router) {
 			r.Use(s.tokenAuth)
 			r.Get("/", s.service.GetFilter)
@@ -5,6 +5,7 @@ go 1.16
 require (
 	github.com/alexedwards/argon2id v0.0.0-20210511081203-7d35d68092b8
 	github.com/boombuler/barcode v1.0.1
+	github.com/denisenkom/go-mssqldb v0.10.0
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/go-chi/chi/v5 v5.0.3
 	github.com/go-chi/cors v1.2.0
@@ -14,6 +15,7 @@ require (
 	github.com/mattn/go-isatty v0.0.13 // indirect
 	github.com/phpdave11/gofpdi v1.0.13 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
+	github.com/robertkrimen/otto v0.0.0-20210614181706-373ff5438452
 	github.com/signintech/gopdf v0.9.17
 	github.com/unrolled/secure v1.0.9
 	golang.org/x/crypto v0.0.0-20210616213533-5ff15b29337e // indirect
@@ -23,6 +25,7 @@ require (
 	google.golang.org/genproto v0.0.0-20210617175327-b9e0b3197ced // indirect
 	google.golang.org/grpc v1.38.0
 	google.golang.org/protobuf v1.26.0
+	gopkg.in/sourcemap.v1 v1.0.5 // indirect
 	modernc.org/libc v1.9.8 // indirect
 	modernc.org/mathutil v1.4.0 // indirect
 	modernc.org/sqlite v1.10.8
@@ -0,0 +1,9 @@
+//+build mssql all
+
+package nervatura
+
+import _ "github.com/denisenkom/go-mssqldb" // mssql driver
+
+func init() {
+	registerDriver("mssql")
+}
@@ -1,9 +1,11 @@
 package nervatura
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -47,16 +49,16 @@ type SQLDriver struct {
 }
 
 var dropList = []string{
-	"pattern", "movement", "payment", "item", "trans", "barcode", "price", "tool", "product", "tax", "rate",
-	"place", "currency", "project", "customer", "event", "contact", "address", "numberdef", "log", "fieldvalue",
+	"journal", "postrule", "account", "pattern", "movement", "payment", "item", "trans", "barcode", "price", "tool", "product", "tax", "rate",
+	"place", "currency", "project", "customer", "event", "contact", "address", "numberdef", "outbox", "log", "fieldvalue",
 	"deffield", "ui_audit", "link", "ui_userconfig", "ui_printqueue", "employee",
-	"ui_report", "ui_message", "ui_menufields", "ui_menu", "groups"}
+	"ui_script", "ui_report", "ui_message", "ui_menufields", "ui_menu", "groups"}
 
 var createList = []string{
-	"groups", "ui_menu", "ui_menufields", "ui_message", "ui_report",
-	"employee", "ui_printqueue", "ui_userconfig", "link", "ui_audit", "deffield", "fieldvalue", "log", "numberdef",
+	"groups", "ui_menu", "ui_menufields", "ui_message", "ui_report", "ui_script",
+	"employee", "ui_printqueue", "ui_userconfig", "link", "ui_audit", "deffield", "fieldvalue", "log", "outbox", "numberdef",
 	"address", "contact", "event", "customer", "project", "currency", "place", "rate", "tax", "product", "tool", "price",
-	"barcode", "trans", "item", "payment", "movement", "pattern"}
+	"barcode", "trans", "item", "payment", "movement", "pattern", "account", "postrule", "journal"}
 
 func (ds *SQLDriver) decodeEngine(sqlStr string) string {
 	const (
@@ -126,21 +128,21 @@ func (ds *SQLDriver) getDataType(dtype string) string {
 		"float": SM{
 			"base":     "DOUBLE",
 			"postgres": "FLOAT8",
-			"mssql":    "DECIMAL(19,4)"},
+			"mssql":    "FLOAT"},
 		"string": SM{
 			"base":     "CHAR",
 			"postgres": "VARCHAR",
 			"mysql":    "VARCHAR",
-			"mssql":    "VARCHAR"},
+			"mssql":    "NVARCHAR"},
 		"password": SM{
 			"base":     "CHAR",
 			"postgres": "VARCHAR",
 			"mysql":    "VARCHAR",
-			"mssql":    "VARCHAR"},
+			"mssql":    "NVARCHAR"},
 		"text": SM{
 			"base":  "TEXT",
 			"mysql": "LONGTEXT",
-			"mssql": "VARCHAR(max)"},
+			"mssql": "NVARCHAR(max)"},
 		"date": SM{
 			"base": "DATE"},
 		"datetime": SM{
@@ -259,7 +261,10 @@ func (ds *SQLDriver) CreateConnection(alias, connStr string) error {
 		connStr = strings.ReplaceAll(connStr, "sqlite://", "")
 	case "mysql":
 		connStr = strings.TrimPrefix(connStr, engine+"://")
-	case "postgres", "mssql":
+	case "mssql":
+		// the mssql driver name accepts the ? parameter placeholders
+		connStr = "sqlserver://" + strings.TrimPrefix(connStr, engine+"://")
+	case "postgres":
 	default:
 		return errors.New(ut.GetMessage("valid_engine"))
 	}
@@ -281,6 +286,11 @@ func (ds *SQLDriver) CreateConnection(alias, connStr string) error {
 	return nil
 }
 
+//PrmString returns the query parameter placeholder of the database engine
+func (ds *SQLDriver) PrmString(index int) string {
+	return ds.getPrmString(index)
+}
+
 // getPrmString - get database parameter string
 func (ds *SQLDriver) getPrmString(index int) string {
 	if ds.engine == "postgres" {
@@ -306,8 +316,12 @@ func (ds *SQLDriver) CheckHashtable(hashtable string) error {
 	}
 	err := ds.db.QueryRow(sqlString, hashtable).Scan(&name)
 	if err != nil {
-		textType := ds.getDataType("text")
-		sqlString = fmt.Sprintf("CREATE TABLE %s ( refname %s, value %s);", hashtable, textType, textType)
+		textType, refType := ds.getDataType("text"), ds.getDataType("text")
+		if ds.engine == "mssql" {
+			// the max length columns cannot be index key columns
+			refType = ds.getDataType("string") + "(255)"
+		}
+		sqlString = fmt.Sprintf("CREATE TABLE %s ( refname %s, value %s);", hashtable, refType, textType)
 		_, err = ds.db.Exec(sqlString)
 		if err != nil {
 			return err
@@ -370,7 +384,7 @@ func (ds *SQLDriver) dropData(logData []SM) ([]SM, error) {
 	for index := 0; index < len(dropList); index++ {
 		sqlString := ""
 		if ds.engine == "mssql" {
-			sqlString = "DROP TABLE " + dropList[index] + ";"
+			sqlString = fmt.Sprintf("IF OBJECT_ID('%s', 'U') IS NOT NULL DROP TABLE %s;", dropList[index], dropList[index])
 		} else {
 			sqlString = "DROP TABLE IF EXISTS " + ds.tableName(dropList[index]) + ";"
 		}
@@ -431,6 +445,41 @@ func (ds *SQLDriver) createTableFields(sqlString, fieldname, indexName string, f
 	return sqlString
 }
 
+// createTableSQL - the CREATE TABLE statement of a model
+func (ds *SQLDriver) createTableSQL(mname string) string {
+	model := nt.DataModel()["model"].(IM)
+	sqlString := "CREATE TABLE " + ds.tableName(mname) + "("
+	for fld := 0; fld < len(model[mname].(IM)["_fields"].(SL)); fld++ {
+		fieldname := model[mname].(IM)["_fields"].(SL)[fld]
+		field := model[mname].(IM)[fieldname].(nt.MF)
+		sqlString = ds.createTableFields(sqlString, fieldname, mname, field)
+	}
+	sqlString += ");"
+	return strings.ReplaceAll(sqlString, ", );", ");")
+}
+
+// createIndexSQL - the CREATE INDEX statement of a model index
+func (ds *SQLDriver) createIndexSQL(ikey string, ifield nt.MI) string {
+	sqlString := "CREATE INDEX "
+	if ifield.Unique {
+		sqlString = "CREATE UNIQUE INDEX "
+	}
+	model := nt.DataModel()["model"].(IM)[ifield.Model].(IM)
+	nullable := []string{}
+	for _, fieldname := range strings.Split(strings.Join(ifield.Fields, ","), ",") {
+		fieldname = strings.TrimSpace(fieldname)
+		if field, found := model[fieldname].(nt.MF); found && (!field.NotNull || field.References != nil) {
+			nullable = append(nullable, fieldname+" IS NOT NULL")
+		}
+	}
+	sqlString += ikey + " ON " + ds.tableName(ifield.Model) + "(" + strings.Join(ifield.Fields, ", ") + ")"
+	if ds.engine == "mssql" && ifield.Unique && len(nullable) > 0 {
+		// the SQL Server unique index allows only one NULL value (filtered index)
+		sqlString += " WHERE " + strings.Join(nullable, " AND ")
+	}
+	return sqlString + ";"
+}
+
 //createTable - create all tables
 func (ds *SQLDriver) createTable(logData []SM, trans *sql.Tx) ([]SM, error) {
 
@@ -438,17 +487,9 @@ func (ds *SQLDriver) createTable(logData []SM, trans *sql.Tx) ([]SM, error) {
 		"stamp":   time.Now().Format(nt.TimeLayout),
 		"state":   "create",
 		"message": ut.GetMessage("log_create_table")})
-	model := nt.DataModel()["model"].(IM)
 
 	for index := 0; index < len(createList); index++ {
-		sqlString := "CREATE TABLE " + ds.tableName(createList[index]) + "("
-		for fld := 0; fld < len(model[createList[index]].(IM)["_fields"].(SL)); fld++ {
-			fieldname := model[createList[index]].(IM)["_fields"].(SL)[fld]
-			field := model[createList[index]].(IM)[fieldname].(nt.MF)
-			sqlString = ds.createTableFields(sqlString, fieldname, createList[index], field)
-		}
-		sqlString += ");"
-		sqlString = strings.ReplaceAll(sqlString, ", );", ");")
+		sqlString := ds.createTableSQL(createList[index])
 		//println(sqlString)
 		_, err := trans.Exec(sqlString)
 		if err != nil {
@@ -472,16 +513,7 @@ func (ds *SQLDriver) createIndex(logData []SM, trans *sql.Tx) ([]SM, error) {
 		"message": ut.GetMessage("log_create_index")})
 	indexRows := nt.DataModel()["index"].(map[string]nt.MI)
 	for ikey, ifield := range indexRows {
-		sqlString := "CREATE INDEX "
-		if ifield.Unique {
-			sqlString = "CREATE UNIQUE INDEX "
-		}
-		sqlString += ikey + " ON " + ds.tableName(ifield.Model) + "("
-		for index := 0; index < len(ifield.Fields); index++ {
-			sqlString += ifield.Fields[index] + ", "
-		}
-		sqlString += ");"
-		sqlString = strings.ReplaceAll(sqlString, ", );", ");")
+		sqlString := ds.createIndexSQL(ikey, ifield)
 		//println(sqlString)
 		_, err := trans.Exec(sqlString)
 		if err != nil {
@@ -512,9 +544,10 @@ func (ds *SQLDriver) insertData(logData []SM, trans *sql.Tx) ([]SM, error) {
 	for index := 0; index < len(dataList); index++ {
 		mname := dataList[index]
 		keyFields := model[mname].(IM)["_key"].([]string)
-		insertID := ""
+		insertID, insertEnd := "", ""
 		if ds.engine == "mssql" {
 			insertID = fmt.Sprintf("SET IDENTITY_INSERT %s ON; ", mname)
+			insertEnd = fmt.Sprintf(" SET IDENTITY_INSERT %s OFF;", mname)
 		}
 		for index := 0; index < len(dataRows[mname]); index++ {
 			refID = setRefID(refID, mname, keyFields, dataRows[mname][index], int64(index+1))
@@ -527,7 +560,7 @@ func (ds *SQLDriver) insertData(logData []SM, trans *sql.Tx) ([]SM, error) {
 				fldValues = append(fldValues, ds.getPrmString(len(params)))
 			}
 			sqlString := insertID + fmt.Sprintf("INSERT INTO %s (%s) VALUES(%s);",
-				ds.tableName(mname), strings.Join(fldNames, ","), strings.Join(fldValues, ","))
+				ds.tableName(mname), strings.Join(fldNames, ","), strings.Join(fldValues, ",")) + insertEnd
 			//println(sqlString)
 			_, err := trans.Exec(sqlString, params...)
 			if err != nil {
@@ -602,6 +635,15 @@ func (ds *SQLDriver) CreateDatabase(logData []SM) ([]SM, error) {
 		return logData, err
 	}
 
+	err = ds.setSchemaVersion(trans)
+	if err != nil {
+		logData = append(logData, SM{
+			"stamp":   time.Now().Format(nt.TimeLayout),
+			"state":   "err",
+			"message": err.Error()})
+		return logData, err
+	}
+
 	switch ds.engine {
 	case "postgres":
 		//update all sequences
@@ -650,6 +692,53 @@ func (ds *SQLDriver) CreateDatabase(logData []SM) ([]SM, error) {
 	return logData, nil
 }
 
+//ClearData - delete all rows of a table
+func (ds *SQLDriver) ClearData(model string, trans interface{}) error {
+	switch trans.(type) {
+	case *sql.Tx:
+	default:
+		return errors.New(ut.GetMessage("invalid_trans"))
+	}
+	_, err := trans.(*sql.Tx).Exec("DELETE FROM " + ds.tableName(model))
+	return err
+}
+
+//RestoreData - insert rows with their original id values and update the id sequence of the table
+func (ds *SQLDriver) RestoreData(model string, rows []IM, trans interface{}) error {
+	switch trans.(type) {
+	case *sql.Tx:
+	default:
+		return errors.New(ut.GetMessage("invalid_trans"))
+	}
+	insertID, insertEnd := "", ""
+	if ds.engine == "mssql" {
+		insertID = fmt.Sprintf("SET IDENTITY_INSERT %s ON; ", ds.tableName(model))
+		insertEnd = fmt.Sprintf(" SET IDENTITY_INSERT %s OFF;", ds.tableName(model))
+	}
+	for index := 0; index < len(rows); index++ {
+		params := []interface{}{}
+		fldNames := []string{}
+		fldValues := []string{}
+		for field, value := range rows[index] {
+			params = append(params, value)
+			fldNames = append(fldNames, field)
+			fldValues = append(fldValues, ds.getPrmString(len(params)))
+		}
+		sqlString := insertID + fmt.Sprintf("INSERT INTO %s (%s) VALUES(%s);",
+			ds.tableName(model), strings.Join(fldNames, ","), strings.Join(fldValues, ",")) + insertEnd
+		if _, err := trans.(*sql.Tx).Exec(sqlString, params...); err != nil {
+			return err
+		}
+	}
+	if ds.engine == "postgres" && len(rows) > 0 {
+		sqlString := fmt.Sprintf("SELECT setval('%s_id_seq', (SELECT max(id) FROM %s));", model, ds.tableName(model))
+		if _, err := trans.(*sql.Tx).Exec(sqlString); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (ds *SQLDriver) getFilterString(filter nt.Filter, start bool, sqlString string, params []interface{}) (string, []interface{}) {
 	if start {
 		sqlString += "("
@@ -658,6 +747,24 @@ func (ds *SQLDriver) getFilterString(filter nt.Filter, start bool, sqlString str
 	} else {
 		sqlString += " or "
 	}
+	if len(filter.Group) > 0 {
+		sqlString += "("
+		for gi := 0; gi < len(filter.Group); gi++ {
+			sqlString, params = ds.getFilterString(filter.Group[gi], (gi == 0), sqlString, params)
+		}
+		sqlString += ")"
+		if !filter.Or {
+			sqlString += ")"
+		}
+		return sqlString, params
+	}
+	if filter.Meta != "" {
+		sqlString, params = ds.getMetaFilterString(filter, sqlString, params)
+		if !filter.Or {
+			sqlString += ")"
+		}
+		return sqlString, params
+	}
 	sqlString += filter.Field
 	switch filter.Comp {
 	case "==":
@@ -669,8 +776,16 @@ func (ds *SQLDriver) getFilterString(filter nt.Filter, start bool, sqlString str
 	case "is":
 		sqlString += " " + filter.Comp + " " + filter.Value.(string)
 	case "in":
-		if filterValue, valid := filter.Value.(string); valid {
-			values := strings.Split(filterValue, ",")
+		values := make([]interface{}, 0)
+		switch filterValue := filter.Value.(type) {
+		case string:
+			for _, value := range strings.Split(filterValue, ",") {
+				values = append(values, value)
+			}
+		case []interface{}:
+			values = filterValue
+		}
+		if len(values) > 0 {
 			prmStr := make([]string, 0)
 			for _, value := range values {
 				params = append(params, value)
@@ -678,6 +793,13 @@ func (ds *SQLDriver) getFilterString(filter nt.Filter, start bool, sqlString str
 			}
 			sqlString += " in(" + strings.Join(prmStr, ",") + ")"
 		}
+	case "between":
+		if values, valid := filter.Value.([]interface{}); valid && len(values) == 2 {
+			params = append(params, values[0])
+			sqlString += " between " + ds.getPrmString(len(params))
+			params = append(params, values[1])
+			sqlString += " and " + ds.getPrmString(len(params))
+		}
 	}
 	if !filter.Or {
 		sqlString += ")"
@@ -685,6 +807,26 @@ func (ds *SQLDriver) getFilterString(filter nt.Filter, start bool, sqlString str
 	return sqlString, params
 }
 
+// getMetaFilterString - filtering by the fieldvalue rows of a deffield
+func (ds *SQLDriver) getMetaFilterString(filter nt.Filter, sqlString string, params []interface{}) (string, []interface{}) {
+	valueField := "value"
+	switch filter.Meta {
+	case "integer", "customer", "tool", "product", "project", "employee", "place", "transitem", "transmovement", "transpayment":
+		valueField = "{CAS_INT}value{CAE_INT}"
+	case "float":
+		valueField = "{CAS_FLOAT}value{CAE_FLOAT}"
+	}
+	params = append(params, filter.Field)
+	if filter.Comp == "is" && filter.Value == "null" {
+		sqlString += "id not in (select ref_id from fieldvalue where deleted = 0 and fieldname = " + ds.getPrmString(len(params)) +
+			" and value is not null)"
+		return sqlString, params
+	}
+	sqlString += "id in (select ref_id from fieldvalue where deleted = 0 and fieldname = " + ds.getPrmString(len(params)) + " and "
+	sqlString, params = ds.getFilterString(nt.Filter{Field: valueField, Comp: filter.Comp, Value: filter.Value}, true, sqlString, params)
+	return sqlString + ")", params
+}
+
 func (ds *SQLDriver) decodeSQL(queries []nt.Query) (string, []interface{}, error) {
 	sqlString := ""
 	params := make([]interface{}, 0)
@@ -707,6 +849,9 @@ func (ds *SQLDriver) decodeSQL(queries []nt.Query) (string, []interface{}, error
 		if order != "" {
 			sqlString += " order by " + order
 		}
+		if query.Limit > 0 || query.Offset > 0 {
+			sqlString = ds.getPageString(sqlString, (order != ""), query.Limit, query.Offset)
+		}
 	}
 	return strings.Trim(sqlString, " "), params, nil
 }
@@ -736,12 +881,12 @@ func initQueryCols(engine string, cols []*sql.ColumnType) ([]interface{}, []stri
 		switch dbType {
 		case "BOOL", "BOOLEAN", "BIT":
 			values[i] = new(sql.NullBool)
-		case "INTEGER", "SERIAL", "INT", "INT4", "INT8":
+		case "INTEGER", "SERIAL", "INT", "INT4", "INT8", "BIGINT", "SMALLINT", "TINYINT":
 			values[i] = new(sql.NullInt64)
-		case "DOUBLE", "FLOAT8", "DECIMAL(19,4)", "DECIMAL", "NUMERIC":
+		case "DOUBLE", "FLOAT8", "DECIMAL(19,4)", "DECIMAL", "NUMERIC", "FLOAT", "REAL":
 			values[i] = new(sql.NullFloat64)
-		case "DATETIME", "TIMESTAMP", "DATE":
-			if engine == "postgres" {
+		case "DATETIME", "TIMESTAMP", "DATE", "DATETIME2":
+			if engine == "postgres" || engine == "mssql" {
 				values[i] = new(sql.NullTime)
 			}
 		}
@@ -790,6 +935,25 @@ func getQueryRowValue(value interface{}, dbtype string) interface{} {
 
 //QuerySQL executes a SQL query
 func (ds *SQLDriver) QuerySQL(sqlString string, params []interface{}, trans interface{}) ([]IM, error) {
+	return ds.queryRows(context.Background(), sqlString, params, 0, trans)
+}
+
+//QueryLimit executes a SQL query with a max. row number and a timeout (0: unlimited)
+func (ds *SQLDriver) QueryLimit(sqlString string, params []interface{}, maxRows int64, timeout time.Duration, trans interface{}) ([]IM, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	result, err := ds.queryRows(ctx, sqlString, params, maxRows, trans)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return result, errors.New(ut.GetMessage("sql_timeout") + " " + timeout.String())
+	}
+	return result, err
+}
+
+func (ds *SQLDriver) queryRows(ctx context.Context, sqlString string, params []interface{}, maxRows int64, trans interface{}) ([]IM, error) {
 	result := make([]IM, 0)
 	var rows *sql.Rows
 	var err error
@@ -803,9 +967,9 @@ func (ds *SQLDriver) QuerySQL(sqlString string, params []interface{}, trans inte
 
 	//println(ds.decodeEngine(sqlString))
 	if trans != nil {
-		rows, err = trans.(*sql.Tx).Query(ds.decodeEngine(sqlString), params...)
+		rows, err = trans.(*sql.Tx).QueryContext(ctx, ds.decodeEngine(sqlString), params...)
 	} else {
-		rows, err = ds.db.Query(ds.decodeEngine(sqlString), params...)
+		rows, err = ds.db.QueryContext(ctx, ds.decodeEngine(sqlString), params...)
 	}
 	if err != nil {
 		return result, err
@@ -819,6 +983,9 @@ func (ds *SQLDriver) QuerySQL(sqlString string, params []interface{}, trans inte
 	values, fields, dbtypes := initQueryCols(ds.engine, cols)
 
 	for rows.Next() {
+		if maxRows > 0 && int64(len(result)) >= maxRows {
+			return result, fmt.Errorf("%s %d", ut.GetMessage("sql_max_rows"), maxRows)
+		}
 		err = rows.Scan(values...)
 		if err != nil {
 			return result, err
@@ -829,7 +996,7 @@ func (ds *SQLDriver) QuerySQL(sqlString string, params []interface{}, trans inte
 		}
 		result = append(result, row)
 	}
-	return result, nil
+	return result, rows.Err()
 }
 
 func (ds *SQLDriver) lastInsertID(model string, result sql.Result, trans interface{}) (int64, error) {
@@ -912,6 +1079,18 @@ func (ds *SQLDriver) BeginTransaction() (interface{}, error) {
 	return ds.db.Begin()
 }
 
+//BeginReadTransaction begins a transaction with a consistent view of the database (e.g. backup)
+func (ds *SQLDriver) BeginReadTransaction() (interface{}, error) {
+	switch ds.engine {
+	case "postgres", "mysql":
+		return ds.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	case "mssql":
+		return ds.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable})
+	}
+	// sqlite: the read transaction holds the shared lock until the end of the transaction
+	return ds.db.Begin()
+}
+
 //CommitTransaction commit a *sql.Tx transaction
 func (ds *SQLDriver) CommitTransaction(trans interface{}) error {
 	switch trans.(type) {
@@ -970,6 +1149,45 @@ func (ds *SQLDriver) getQueryKeySplit(options IM, key, sqlString string, params
 	return "", params
 }
 
+func (ds *SQLDriver) getLimitString(sqlString string, limit int64) string {
+	if ds.engine == "mssql" {
+		return fmt.Sprintf("select top %d", limit) + strings.TrimSpace(sqlString)[len("select"):]
+	}
+	return sqlString + fmt.Sprintf(" limit %d", limit)
+}
+
+func (ds *SQLDriver) getPageString(sqlString string, ordered bool, limit, offset int64) string {
+	switch ds.engine {
+	case "mssql":
+		if !ordered {
+			sqlString += " order by (select null)"
+		}
+		sqlString += fmt.Sprintf(" offset %d rows", offset)
+		if limit > 0 {
+			sqlString += fmt.Sprintf(" fetch next %d rows only", limit)
+		}
+		return sqlString
+	case "postgres":
+		if limit > 0 {
+			sqlString += fmt.Sprintf(" limit %d", limit)
+		}
+	case "mysql":
+		if limit <= 0 {
+			limit = math.MaxInt64
+		}
+		sqlString += fmt.Sprintf(" limit %d", limit)
+	default:
+		if limit <= 0 {
+			limit = -1
+		}
+		sqlString += fmt.Sprintf(" limit %d", limit)
+	}
+	if offset > 0 {
+		sqlString += fmt.Sprintf(" offset %d", offset)
+	}
+	return sqlString
+}
+
 func (ds *SQLDriver) getID2Refnumber(options IM) (string, IL, error) {
 	var sqlString, whereString string
 	params := make(IL, 0)
@@ -1371,7 +1589,7 @@ func (ds *SQLDriver) getRefnumber2ID(options IM) (string, IL, error) {
 
 	switch options["nervatype"] {
 
-	case "employee", "pattern", "project", "tool", "currency", "numberdef", "ui_report", "ui_menu":
+	case "employee", "pattern", "project", "tool", "currency", "numberdef", "ui_report", "ui_menu", "account":
 
 		sqlString = fmt.Sprintf(`select * from %s where %s `, options["nervatype"], options["refField"])
 		whereString, params = ds.getQueryKeyOption(options,
@@ -1452,6 +1670,20 @@ func (ds *SQLDriver) getIntegrity(options IM) (string, IL, error) {
 	params := make(IL, 0)
 
 	keys := map[string]func(options IM) (string, IL, error){
+		"account": func(options IM) (string, IL, error) {
+			//postrule,journal
+			sqlString = `select {CAS_INT}sum(co){CAE_INT} as count from (
+				select {CAS_INT}count(*){CAE_INT} as co from postrule where deleted = 0 `
+			whereString, params = ds.getQueryKeyOption(options,
+				SL{"ref_id", "ref_id"}, ` and (debit_id = %s or credit_id = %s) `, params)
+			sqlString += whereString
+			sqlString += `union all select {CAS_INT}count(*){CAE_INT} as co from journal where `
+			whereString, params = ds.getQueryKeyOption(options,
+				SL{"ref_id"}, ` account_id = %s) foo `, params)
+			sqlString += whereString
+			return sqlString, params, nil
+		},
+
 		"currency": func(options IM) (string, IL, error) {
 			//(link), place,price,rate,trans
 			sqlString = `select {CAS_INT}sum(co){CAE_INT} as count from (
@@ -1786,12 +2018,21 @@ func (ds *SQLDriver) QueryKey(options IM, trans interface{}) (result []IM, err e
 			sqlString =
 				`select e.id, e.username, e.empnumber, ug.id as usergroup, ug.groupvalue as scope, 
 				case when dg.groupvalue is null then '' else dg.groupvalue end as department
-				from employee e, groups ug
+				from employee e
+				inner join groups ug on ug.groupname = 'usergroup' and ug.groupvalue = 'guest'
 				left join groups dg on e.department = dg.id
-				where e.deleted = 0 and e.inactive = 0 and ug.groupname = 'usergroup' and ug.groupvalue = 'guest' and e.username = 'admin'`
+				where e.deleted = 0 and e.inactive = 0 and e.username = 'admin'`
 			return sqlString, params, nil
 		},
 
+		"metadata_fieldtype": func(options IM) (string, IL, error) {
+			sqlString = `select df.fieldname, ft.groupvalue as fieldtype from deffield df
+				inner join groups nt on df.nervatype = nt.id 
+				inner join groups ft on df.fieldtype = ft.id 
+				where df.deleted = 0 and nt.groupvalue = ` + ds.getPrmString(1) + ` and df.fieldname = ` + ds.getPrmString(2)
+			return sqlString, IL{options["nervatype"], options["fieldname"]}, nil
+		},
+
 		"metadata": func(options IM) (string, IL, error) {
 			params := IL{options["nervatype"]}
 			values, inPrm := ds.splitInParams(options["ids"].(string), len(params))
@@ -1952,6 +2193,46 @@ func (ds *SQLDriver) QueryKey(options IM, trans interface{}) (result []IM, err e
 			return sqlString, params, nil
 		},
 
+		"outbox_pending": func(options IM) (string, IL, error) {
+			params := IL{options["nextdate"]}
+			sqlString =
+				`select * from outbox
+				where state = 'pending' and nextdate <= ` + ds.getPrmString(1) + `
+				order by id`
+			return ds.getLimitString(sqlString, ut.ToInteger(options["limit"], 100)), params, nil
+		},
+
+		"outbox_events": func(options IM) (string, IL, error) {
+			whereString := ""
+			params := IL{options["cursor"]}
+			sqlString = `select * from outbox where id > ` + ds.getPrmString(1)
+			whereString, params = ds.getQueryKeySplit(options, "nervatype", ` and nervatype in (%s)`, params)
+			sqlString += whereString
+			whereString, params = ds.getQueryKeySplit(options, "operation", ` and operation in (%s)`, params)
+			sqlString += whereString
+			sqlString += ` order by id`
+			return ds.getLimitString(sqlString, ut.ToInteger(options["limit"], 100)), params, nil
+		},
+
+		"trial_balance": func(options IM) (string, IL, error) {
+			whereString := ""
+			params := IL{options["date_from"], options["date_from"], options["date_from"], options["date_to"]}
+			sqlString =
+				`select a.accnumber, a.description, a.acctype, j.curr,
+					sum(case when j.entrydate < ` + ds.getPrmString(1) + ` then j.debit - j.credit else 0 end) as opening,
+					sum(case when j.entrydate >= ` + ds.getPrmString(2) + ` then j.debit else 0 end) as debit,
+					sum(case when j.entrydate >= ` + ds.getPrmString(3) + ` then j.credit else 0 end) as credit,
+					sum(j.debit - j.credit) as closing
+				from journal j
+				inner join account a on j.account_id = a.id
+				where j.entrydate <= ` + ds.getPrmString(4)
+			whereString, params = ds.getQueryKeyOption(options, SL{"curr"}, ` and j.curr = %s`, params)
+			sqlString += whereString
+			sqlString += ` group by a.accnumber, a.description, a.acctype, j.curr
+				order by a.accnumber, j.curr`
+			return sqlString, params, nil
+		},
+
 		"delete_deffields": func(options IM) (string, IL, error) {
 			sqlString =
 				`select fv.id as id from deffield df 
@@ -0,0 +1,251 @@
+package nervatura
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	nt "github.com/nervatura/nervatura-service/pkg/nervatura"
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// schemaTable - the schema version table of the database
+const schemaTable = "schema_version"
+
+func (ds *SQLDriver) schemaFilter() string {
+	switch ds.engine {
+	case "postgres":
+		return "table_schema = current_schema()"
+	case "mysql":
+		return "table_schema = database()"
+	case "mssql":
+		return "table_schema = schema_name()"
+	}
+	return ""
+}
+
+func (ds *SQLDriver) schemaNames(sqlString string, params []interface{}, trans interface{}) (map[string]bool, error) {
+	names := make(map[string]bool)
+	rows, err := ds.QuerySQL(sqlString, params, trans)
+	if err != nil {
+		return names, err
+	}
+	for _, row := range rows {
+		names[strings.ToLower(ut.ToString(row["name"], ""))] = true
+	}
+	return names, nil
+}
+
+// schemaTables - the table names of the live database
+func (ds *SQLDriver) schemaTables(trans interface{}) (map[string]bool, error) {
+	if ds.engine == "sqlite" {
+		return ds.schemaNames("select name from sqlite_master where type = 'table'", nil, trans)
+	}
+	return ds.schemaNames(
+		"select table_name as name from information_schema.tables where "+ds.schemaFilter(), nil, trans)
+}
+
+// schemaColumns - the column names of a live table
+func (ds *SQLDriver) schemaColumns(table string, trans interface{}) (map[string]bool, error) {
+	if ds.engine == "sqlite" {
+		return ds.schemaNames("select name from pragma_table_info('"+table+"')", nil, trans)
+	}
+	return ds.schemaNames(fmt.Sprintf(
+		"select column_name as name from information_schema.columns where %s and table_name = %s",
+		ds.schemaFilter(), ds.getPrmString(1)), []interface{}{table}, trans)
+}
+
+// schemaIndexes - the index names of the live database
+func (ds *SQLDriver) schemaIndexes(trans interface{}) (map[string]bool, error) {
+	switch ds.engine {
+	case "sqlite":
+		return ds.schemaNames("select name from sqlite_master where type = 'index'", nil, trans)
+	case "postgres":
+		return ds.schemaNames("select indexname as name from pg_indexes where schemaname = current_schema()", nil, trans)
+	case "mssql":
+		return ds.schemaNames("select name from sys.indexes where name is not null", nil, trans)
+	}
+	return ds.schemaNames(
+		"select distinct index_name as name from information_schema.statistics where "+ds.schemaFilter(), nil, trans)
+}
+
+// SchemaVersion - returns the DataModel version of the database. The version of a database without
+// version table is 0.
+func (ds *SQLDriver) SchemaVersion() (int64, error) {
+	if ds.db == nil {
+		return 0, errors.New(ut.GetMessage("missing_driver"))
+	}
+	tables, err := ds.schemaTables(nil)
+	if err != nil || !tables[schemaTable] {
+		return 0, err
+	}
+	rows, err := ds.QuerySQL("select max(version) as version from "+schemaTable, nil, nil)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+	return ut.ToInteger(rows[0]["version"], 0), nil
+}
+
+// setSchemaVersion - set the current DataModel version of the database
+func (ds *SQLDriver) setSchemaVersion(trans *sql.Tx) error {
+	tables, err := ds.schemaTables(trans)
+	if err != nil {
+		return err
+	}
+	if !tables[schemaTable] {
+		sqlString := fmt.Sprintf("CREATE TABLE %s (version %s NOT NULL, upgraded %s);",
+			schemaTable, ds.getDataType("integer"), ds.getDataType("datetime"))
+		if _, err = trans.Exec(sqlString); err != nil {
+			return err
+		}
+	}
+	if _, err = trans.Exec("DELETE FROM " + schemaTable); err != nil {
+		return err
+	}
+	sqlString := fmt.Sprintf("INSERT INTO %s (version, upgraded) VALUES(%s,%s);",
+		schemaTable, ds.getPrmString(1), ds.getPrmString(2))
+	_, err = trans.Exec(sqlString, nt.DataModelVersion, time.Now().Format("2006-01-02T15:04:05-07:00"))
+	return err
+}
+
+// addColumnSQL - the ALTER TABLE statement of a new model field
+func (ds *SQLDriver) addColumnSQL(mname, fieldname string, field nt.MF) string {
+	if field.NotNull && field.Default == nil {
+		// the existing rows have no value
+		field.NotNull = false
+	}
+	column := strings.TrimSuffix(ds.createTableFields("", fieldname, mname, field), ", ")
+	switch ds.engine {
+	case "mysql":
+		column = strings.ReplaceAll(column, ", INDEX ", ", ADD INDEX ")
+		column = strings.ReplaceAll(column, ", FOREIGN KEY ", ", ADD FOREIGN KEY ")
+		return "ALTER TABLE " + ds.tableName(mname) + " ADD COLUMN " + column + ";"
+	case "mssql":
+		return "ALTER TABLE " + ds.tableName(mname) + " ADD " + column + ";"
+	}
+	return "ALTER TABLE " + ds.tableName(mname) + " ADD COLUMN " + column + ";"
+}
+
+// upgradeSQL - the difference between the DataModel and the live database schema
+func (ds *SQLDriver) upgradeSQL() ([]string, error) {
+	result := []string{}
+	model := nt.DataModel()["model"].(IM)
+	tables, err := ds.schemaTables(nil)
+	if err != nil {
+		return result, err
+	}
+	for _, mname := range createList {
+		if !tables[mname] {
+			result = append(result, ds.createTableSQL(mname))
+			continue
+		}
+		columns, err := ds.schemaColumns(mname, nil)
+		if err != nil {
+			return result, err
+		}
+		for _, fieldname := range model[mname].(IM)["_fields"].(SL) {
+			if !columns[fieldname] {
+				result = append(result, ds.addColumnSQL(mname, fieldname, model[mname].(IM)[fieldname].(nt.MF)))
+			}
+		}
+	}
+
+	indexes, err := ds.schemaIndexes(nil)
+	if err != nil {
+		return result, err
+	}
+	indexRows := nt.DataModel()["index"].(map[string]nt.MI)
+	ikeys := []string{}
+	for ikey := range indexRows {
+		ikeys = append(ikeys, ikey)
+	}
+	sort.Strings(ikeys)
+	for _, ikey := range ikeys {
+		if !indexes[strings.ToLower(ikey)] {
+			result = append(result, ds.createIndexSQL(ikey, indexRows[ikey]))
+		}
+	}
+	return result, nil
+}
+
+// UpgradeDatabase - create the missing tables, fields and indexes of the DataModel in an existing database
+func (ds *SQLDriver) UpgradeDatabase(logData []SM, dryRun bool) ([]SM, error) {
+	logErr := func(err error) ([]SM, error) {
+		logData = append(logData, SM{
+			"stamp":   time.Now().Format(nt.TimeLayout),
+			"state":   "err",
+			"message": err.Error()})
+		return logData, err
+	}
+	if ds.db == nil {
+		return logErr(errors.New(ut.GetMessage("missing_driver")))
+	}
+
+	version, err := ds.SchemaVersion()
+	if err != nil {
+		return logErr(err)
+	}
+	logData = append(logData, SM{
+		"database": ds.alias,
+		"stamp":    time.Now().Format(nt.TimeLayout),
+		"state":    "upgrade",
+		"version":  fmt.Sprintf("%d -> %d", version, nt.DataModelVersion),
+		"message":  ut.GetMessage("log_start_process")})
+	if version > nt.DataModelVersion {
+		return logErr(errors.New(ut.GetMessage("invalid_schema_version")))
+	}
+
+	sqlList, err := ds.upgradeSQL()
+	if err != nil {
+		return logErr(err)
+	}
+	if len(sqlList) == 0 && version == nt.DataModelVersion {
+		logData = append(logData, SM{
+			"stamp":   time.Now().Format(nt.TimeLayout),
+			"state":   "log",
+			"message": ut.GetMessage("info_upgrade_none")})
+		return logData, nil
+	}
+
+	state := "upgrade"
+	if dryRun {
+		state = "sql"
+	}
+	var trans *sql.Tx
+	if !dryRun {
+		if trans, err = ds.db.Begin(); err != nil {
+			return logErr(err)
+		}
+		defer rollBackTrans(trans, err)
+	}
+	for _, sqlString := range sqlList {
+		logData = append(logData, SM{
+			"stamp":   time.Now().Format(nt.TimeLayout),
+			"state":   state,
+			"message": sqlString})
+		if !dryRun {
+			if _, err = trans.Exec(sqlString); err != nil {
+				trans.Rollback()
+				return logErr(err)
+			}
+		}
+	}
+	if dryRun {
+		return logData, nil
+	}
+	if err = ds.setSchemaVersion(trans); err != nil {
+		trans.Rollback()
+		return logErr(err)
+	}
+	if err = trans.Commit(); err != nil {
+		return logErr(err)
+	}
+	logData = append(logData, SM{
+		"stamp":   time.Now().Format(nt.TimeLayout),
+		"state":   "log",
+		"message": ut.GetMessage("info_upgrade_ok")})
+	return logData, nil
+}
@@ -345,6 +345,31 @@ func (api *API) DatabaseCreate(options IM) ([]SM, error) {
 	return logData, nil
 }
 
+/*
+DatabaseUpgrade - create the missing tables, fields and indexes of the current DataModel
+in an existing Nervatura Database and set the schema version of the database.
+The dry_run option returns the upgrade SQL statements, without changes.
+
+Example:
+
+  options := map[string]interface{}{
+    "database": alias,
+    "dry_run": true}
+  log, err := getAPI().DatabaseUpgrade(options)
+
+*/
+func (api *API) DatabaseUpgrade(options IM) ([]SM, error) {
+	logData := []SM{}
+	if err := api.databaseConnect(ut.ToString(options["database"], "")); err != nil {
+		logData = append(logData, SM{
+			"stamp":   time.Now().Format(TimeLayout),
+			"state":   "err",
+			"message": err.Error()})
+		return logData, err
+	}
+	return api.NStore.ds.UpgradeDatabase(logData, ut.ToBoolean(options["dry_run"], false))
+}
+
 func (api *API) demoDatabase(options IM) ([]SM, error) {
 	var err error
 	logData := options["logData"].([]SM)
@@ -424,6 +449,10 @@ func (api *API) demoDatabase(options IM) ([]SM, error) {
 		{section: "tool", datatype: "event", data: data["tool"].(IM)["event"].([]IM)},
 		//create +1 warehouse
 		{section: "", datatype: "place", data: data["place"].([]IM)},
+		//general ledger
+		//chart of accounts and posting rules
+		{section: "ledger(account,postrule)", datatype: "account", data: data["ledger"].(IM)["account"].([]IM)},
+		{section: "", datatype: "postrule", data: data["ledger"].(IM)["postrule"].([]IM)},
 		//documents
 		//offer, order, invoice, worksheet, rent
 		{section: "document(offer,order,invoice,rent,worksheet)",
@@ -501,6 +530,12 @@ func (api *API) Delete(options IM) error {
 	if _, found := options["id"]; found {
 		options["ref_id"] = ut.ToInteger(options["id"], 0)
 	}
+	if _, found := api.NStore.models[ut.ToString(options["nervatype"], "")]; found {
+		if err := api.auditDelete(ut.ToString(options["nervatype"], ""),
+			ut.ToInteger(options["ref_id"], 0), ut.ToString(options["key"], "")); err != nil {
+			return err
+		}
+	}
 	return api.NStore.DeleteData(IM{
 		"nervatype": options["nervatype"],
 		"ref_id":    options["ref_id"],
@@ -508,9 +543,111 @@ func (api *API) Delete(options IM) error {
 	})
 }
 
+func getOptionList(value interface{}) []string {
+	if values, valid := value.([]string); valid {
+		return values
+	}
+	values := []string{}
+	for _, item := range strings.Split(ut.ToString(value, ""), ",") {
+		if strings.TrimSpace(item) != "" {
+			values = append(values, strings.TrimSpace(item))
+		}
+	}
+	return values
+}
+
+func (api *API) getQueryFilter(nervatype string, options IM) (query Query, err error) {
+	query = Query{Fields: []string{"*"}, From: nervatype, Filters: []Filter{}}
+	if _, found := api.NStore.models[nervatype].(IM)["deleted"]; found {
+		query.Filters = append(query.Filters, Filter{Field: "deleted", Comp: "==", Value: 0})
+	}
+	if ut.ToString(options["ids"], "") != "" {
+		query.Filters = append(query.Filters, Filter{Field: "id", Comp: "in", Value: ut.ToString(options["ids"], "")})
+	} else if _, found := options["filter"]; found {
+		filter, err := api.getFilter(nervatype, options["filter"])
+		if err != nil {
+			return query, err
+		}
+		query.Filters = append(query.Filters, filter)
+	} else {
+		return query, errors.New(ut.GetMessage("missing_required_field") + ": filter or ids")
+	}
+	state, err := api.getAuditState()
+	if err != nil {
+		return query, err
+	}
+	query.Filter, err = api.auditFilter(state, nervatype)
+	return query, err
+}
+
+func (api *API) getQueryPage(nervatype string, query Query, options IM) (Query, error) {
+	validField := func(fieldname string) bool {
+		_, found := api.NStore.models[nervatype].(IM)[fieldname].(MF)
+		return found
+	}
+
+	if fields := getOptionList(options["fields"]); len(fields) > 0 {
+		query.Fields = []string{"id"}
+		for _, fieldname := range fields {
+			if !validField(fieldname) {
+				return query, errors.New(ut.GetMessage("invalid_value") + "- fieldname: " + fieldname)
+			}
+			if fieldname != "id" {
+				query.Fields = append(query.Fields, fieldname)
+			}
+		}
+	}
+
+	for _, order := range getOptionList(options["order"]) {
+		fields := strings.Fields(order)
+		if !validField(fields[0]) || len(fields) > 2 ||
+			(len(fields) == 2 && strings.ToLower(fields[1]) != "asc" && strings.ToLower(fields[1]) != "desc") {
+			return query, errors.New(ut.GetMessage("invalid_value") + "- order: " + order)
+		}
+		query.OrderBy = append(query.OrderBy, strings.Join(fields, " "))
+	}
+
+	if _, found := options["cursor"]; found {
+		// keyset pagination: the rows after the id of the last row of the previous page
+		comp := ">"
+		switch strings.ToLower(strings.Join(query.OrderBy, ",")) {
+		case "", "id", "id asc":
+		case "id desc":
+			comp = "<"
+		default:
+			return query, errors.New(ut.GetMessage("invalid_value") + "- cursor order: " + strings.Join(query.OrderBy, ","))
+		}
+		if cursor := ut.ToInteger(options["cursor"], 0); cursor > 0 || comp == ">" {
+			query.Filters = append(query.Filters, Filter{Field: "id", Comp: comp, Value: cursor})
+		}
+	}
+
+	query.Limit = ut.ToInteger(options["limit"], 0)
+	query.Offset = ut.ToInteger(options["offset"], 0)
+	if query.Limit < 0 || query.Offset < 0 {
+		return query, errors.New(ut.GetMessage("invalid_value") + "- limit, offset")
+	}
+	if len(query.OrderBy) == 0 && (query.Limit > 0 || query.Offset > 0 || options["cursor"] != nil) {
+		query.OrderBy = []string{"id"}
+	}
+	return query, nil
+}
+
 /*
 Get - returns one or more records
 
+The filter value can be a compact string or a JSON filter tree (string, map or array value).
+Compact form: fieldname;comparison;value conditions, the "|" separated conditions are joined with AND,
+the "||" separated condition groups are joined with OR. Comparison values: ==, !=, <, <=, >, >=, in, like,
+is (null or notnull), between (two comma separated values). The filter values are validated by the field types.
+The metadata (deffield) fieldnames of the nervatype can also be used, the values are validated by the deffield fieldtype.
+JSON tree form: condition nodes ({"field": "custname", "comp": "like", "value": "%Co.%"}),
+{"and": [nodes]} and {"or": [nodes]} group nodes. The nodes of an array are joined with AND.
+
+The optional "fields" (projection), "order", "limit" and "offset" values can be used with the filter or ids.
+The "id" field is always returned. Keyset pagination: the "cursor" value is the id of the last row of the previous page
+(only with the default "id" or "id desc" ordering).
+
 Examples:
 
   Find data by Filter:
@@ -524,8 +661,46 @@ Examples:
   options = map[string]interface{}{"nervatype": "customer", "metadata": true, "ids": "2,4"}
   _, err = api.Get(options)
 
+  Find data by Filter groups:
+
+  options = map[string]interface{}{"nervatype": "customer",
+    "filter": "custname;like;%Co.%|notes;is;notnull||custtype;in;116,117"}
+  _, err = api.Get(options)
+
+  Find data by metadata (deffield) values:
+
+  options = map[string]interface{}{"nervatype": "customer",
+    "filter": "sample_customer_valuelist;in;yellow,brown|sample_customer_float;>;100"}
+  _, err = api.Get(options)
+
+  Find data by JSON filter tree:
+
+  options = map[string]interface{}{"nervatype": "trans", "filter": map[string]interface{}{
+    "or": []interface{}{
+      map[string]interface{}{"field": "transdate", "comp": "between", "value": []interface{}{"2021-01-01", "2021-03-31"}},
+      map[string]interface{}{"field": "closed", "comp": "==", "value": 1},
+    }}}
+  _, err = api.Get(options)
+
+  Fields, ordering and paging:
+
+  options = map[string]interface{}{"nervatype": "customer", "filter": "custtype;==;116",
+    "fields": "custnumber,custname", "order": "custname desc,id", "limit": 100, "offset": 200}
+  _, err = api.Get(options)
+
+  Keyset pagination:
+
+  options = map[string]interface{}{"nervatype": "item", "filter": "deleted;==;0",
+    "cursor": 10250, "limit": 1000}
+  _, err = api.Get(options)
+
 */
 func (api *API) Get(options IM) (results []IM, err error) {
+	return api.getData(options, nil)
+}
+
+// getData - the Get query in a transaction
+func (api *API) getData(options IM, trans interface{}) (results []IM, err error) {
 	nervatype := ut.ToString(options["nervatype"], "")
 	if nervatype == "" {
 		return results, errors.New(ut.GetMessage("missing_required_field") + ": nervatype")
@@ -535,36 +710,16 @@ func (api *API) Get(options IM) (results []IM, err error) {
 	}
 	metadata := ut.ToBoolean(options["metadata"], false)
 
-	query := []Query{{
-		Fields: []string{"*"}, From: nervatype, Filters: []Filter{}}}
-	if _, found := api.NStore.models[nervatype].(IM)["deleted"]; found {
-		query[0].Filters = append(query[0].Filters, Filter{Field: "deleted", Comp: "==", Value: 0})
+	query, err := api.getQueryFilter(nervatype, options)
+	if err != nil {
+		return results, err
 	}
-	if ut.ToString(options["ids"], "") != "" {
-		query[0].Filters = append(query[0].Filters, Filter{Field: "id", Comp: "in", Value: ut.ToString(options["ids"], "")})
-	} else if _, found := options["filter"]; found {
-		filters := strings.Split(ut.ToString(options["filter"], ""), "|")
-		for index := 0; index < len(filters); index++ {
-			fields := strings.Split(filters[index], ";")
-			if len(fields) != 3 {
-				return results, errors.New(ut.GetMessage("invalid_value") + "- filter: " + filters[index])
-			}
-			if _, found := api.NStore.models[nervatype].(IM)[fields[0]]; !found {
-				return results, errors.New(ut.GetMessage("invalid_value") + "- fieldname: " + fields[0])
-			}
-			switch fields[1] {
-			case "==", "!=", "<", "<=", ">", ">=", "in":
-			default:
-				return results, errors.New(ut.GetMessage("invalid_value") + "- comparison: " + fields[1])
-			}
-			value := fields[2]
-			query[0].Filters = append(query[0].Filters, Filter{Field: fields[0], Comp: fields[1], Value: value})
-		}
-	} else {
-		return results, errors.New(ut.GetMessage("missing_required_field") + ": filter or ids")
+	query, err = api.getQueryPage(nervatype, query, options)
+	if err != nil {
+		return results, err
 	}
 
-	results, err = api.NStore.ds.Query(query, nil)
+	results, err = api.NStore.ds.Query([]Query{query}, trans)
 	if err != nil {
 		return results, err
 	}
@@ -578,7 +733,7 @@ func (api *API) Get(options IM) (results []IM, err error) {
 				ids += "," + ut.ToString(results[index]["id"], "")
 			}
 			ids = ids[1:]
-			metadata, err := api.NStore.ds.QueryKey(IM{"qkey": "metadata", "nervatype": nervatype, "ids": ids}, nil)
+			metadata, err := api.NStore.ds.QueryKey(IM{"qkey": "metadata", "nervatype": nervatype, "ids": ids}, trans)
 			if err != nil {
 				return results, err
 			}
@@ -603,10 +758,40 @@ func (api *API) Get(options IM) (results []IM, err error) {
 	return results, err
 }
 
+/*
+GetTotal - returns the number of the records of the Get filter or ids (without paging)
+
+Example:
+
+  options = map[string]interface{}{"nervatype": "customer", "filter": "custtype;==;116"}
+  total, err = api.GetTotal(options)
+
+*/
+func (api *API) GetTotal(options IM) (int64, error) {
+	nervatype := ut.ToString(options["nervatype"], "")
+	if nervatype == "" {
+		return 0, errors.New(ut.GetMessage("missing_required_field") + ": nervatype")
+	}
+	if _, found := api.NStore.models[nervatype]; !found {
+		return 0, errors.New(ut.GetMessage("invalid_nervatype") + " " + nervatype)
+	}
+	query, err := api.getQueryFilter(nervatype, options)
+	if err != nil {
+		return 0, err
+	}
+	query.Fields = []string{"count(*) as total"}
+	rows, err := api.NStore.ds.Query([]Query{query}, nil)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+	return ut.ToInteger(rows[0]["total"], 0), nil
+}
+
 /*
 View - run raw SQL queries in safe mode
 
-Only "select" queries and functions can be executed. Changes to the data are not saved in the database.
+Only single "select" (or "with") queries of the allowed tables (NT_VIEW_TABLES) can be executed, with a max. number of rows (NT_VIEW_MAX_ROWS) and a timeout (NT_VIEW_TIMEOUT).
+The queries run in a read-only transaction (postgres, mysql), which is always rolled back.
 
 Examples:
 
@@ -624,9 +809,13 @@ Examples:
 */
 func (api *API) View(options []IM) (results IM, err error) {
 	results = IM{}
+	if err = api.auditView(); err != nil {
+		return results, err
+	}
+	// the read-only transaction (postgres, mysql) rejects the data changes in the database
 	var trans interface{}
 	if api.NStore.ds.Properties().Transaction {
-		trans, err = api.NStore.ds.BeginTransaction()
+		trans, err = api.NStore.ds.BeginReadTransaction()
 		if err != nil {
 			return results, err
 		}
@@ -654,7 +843,7 @@ func (api *API) View(options []IM) (results IM, err error) {
 		if _, valid := options[index]["values"].([]interface{}); !valid {
 			return results, errors.New(ut.GetMessage("missing_required_field") + ": values")
 		}
-		result, err := api.NStore.ds.QuerySQL(
+		result, err := api.viewQuery(
 			text, options[index]["values"].([]interface{}), trans)
 		if err != nil {
 			return results, err
@@ -665,7 +854,9 @@ func (api *API) View(options []IM) (results IM, err error) {
 }
 
 /*
-Function - call a server-side function
+Function - call a registered server-side function (RegisterFunction) or a function script (ui_script) of the database.
+The values are checked by the argument schema of the function and the user needs the ui_audit object rights of the function.
+The script gets the values in the params object (NT_SCRIPT_ENABLED).
 
 Examples:
 
@@ -692,6 +883,100 @@ Examples:
   }
   _, err = api.Function(options)
 
+  General ledger trial balance (all currencies, from 1900-01-01 to the current date):
+
+  options = map[string]interface{}{
+    "key": "trialBalance",
+    "values": map[string]interface{}{
+      "date_from": "2021-01-01",
+      "date_to":   "2021-12-31",
+    },
+  }
+  _, err = api.Function(options)
+
+  Account statement with running balance:
+
+  options = map[string]interface{}{
+    "key": "accountStatement",
+    "values": map[string]interface{}{
+      "accnumber": "1200",
+      "curr":      "EUR",
+    },
+  }
+  _, err = api.Function(options)
+
+  Stock quantity, FIFO unit cost and value of a product by warehouses (current date, default currency):
+
+  options = map[string]interface{}{
+    "key": "stockValuation",
+    "values": map[string]interface{}{
+      "method":     "fifo",
+      "partnumber": "DMPROD/00001",
+    },
+  }
+  _, err = api.Function(options)
+
+  Convert an amount by the latest exchange rates on or before a date (via the default currency):
+
+  options = map[string]interface{}{
+    "key": "convertCurrency",
+    "values": map[string]interface{}{
+      "amount":    100,
+      "from_curr": "USD",
+      "to_curr":   "GBP",
+      "ratedate":  "2021-03-31",
+    },
+  }
+  _, err = api.Function(options)
+
+  Create an outgoing invoice from the not invoiced quantities of an order (with the link to the order):
+
+  options = map[string]interface{}{
+    "key": "createTransFrom",
+    "values": map[string]interface{}{
+      "transnumber": "DMORD/00002",
+      "transtype":   "invoice",
+      "direction":   "out",
+      "netto":       true,
+    },
+  }
+  _, err = api.Function(options)
+
+  The amounts of an item row (gross pricing, currency rounding):
+
+  options = map[string]interface{}{
+    "key": "calcItemAmounts",
+    "values": map[string]interface{}{
+      "qty":     3,
+      "amount":  100,
+      "taxcode": "20%",
+      "curr":    "EUR",
+      "calc":    "gross",
+    },
+  }
+  _, err = api.Function(options)
+
+  Check the item amounts and totals of a document (gross-priced items: "calc": "gross"):
+
+  options = map[string]interface{}{
+    "key": "checkTransAmounts",
+    "values": map[string]interface{}{
+      "transnumber": "DMINV/00001",
+      "calc": "net",
+    },
+  }
+  _, err = api.Function(options)
+
+  Create again the journal entries of a document (all invoice, receipt, bank and cash documents without transnumber):
+
+  options = map[string]interface{}{
+    "key": "postJournal",
+    "values": map[string]interface{}{
+      "transnumber": "DMINV/00001",
+    },
+  }
+  _, err = api.Function(options)
+
   Email sending with attached report:
 
 	options := map[string]interface{}{
@@ -712,6 +997,24 @@ Examples:
 			},
 		},
 	}
+  Email sending with the HTML invoice in the message body:
+
+	options := map[string]interface{}{
+		"key": "sendEmail",
+		"values": map[string]interface{}{
+			"provider": "smtp",
+			"email": map[string]interface{}{
+				"from": "info@nervatura.com", "name": "Nervatura",
+				"recipients": []interface{}{
+					map[string]interface{}{"email": "sample@company.com"}},
+				"subject": "Demo Invoice",
+				"report": map[string]interface{}{
+					"reportkey": "ntr_invoice_en",
+					"nervatype": "trans",
+					"refnumber": "DMINV/00001"},
+			},
+		},
+	}
 
 */
 func (api *API) Function(options IM) (results interface{}, err error) {
@@ -722,9 +1025,43 @@ func (api *API) Function(options IM) (results interface{}, err error) {
 	if _, valid := options["values"].(IM); !valid {
 		return results, errors.New(ut.GetMessage("missing_required_field") + ": values")
 	}
+	fn, found := GetFunction(key)
+	if !found {
+		return api.scriptFunction(key, options["values"].(IM))
+	}
+	if err = api.checkFunctionAudit(&fn); err != nil {
+		return results, err
+	}
 	return api.NStore.GetService(key, options["values"].(IM))
 }
 
+/*
+FunctionList - returns the name, description, argument schema, required object rights
+and transaction handling of the registered server-side functions and the function scripts (ui_script) of the database
+
+Example:
+
+  results, err := api.FunctionList()
+
+*/
+func (api *API) FunctionList() (results []IM, err error) {
+	results = []IM{}
+	for _, fn := range Functions() {
+		args := []IM{}
+		for _, arg := range fn.Args {
+			args = append(args, IM{"name": arg.Name, "type": arg.Type, "required": arg.Required, "description": arg.Description})
+		}
+		audit := []IM{}
+		for _, right := range fn.Audit {
+			audit = append(audit, IM{"nervatype": right.Nervatype, "transtype": right.Transtype, "right": right.Right})
+		}
+		results = append(results, IM{"name": fn.Name, "description": fn.Description,
+			"args": args, "audit": audit, "transaction": fn.Transaction, "script": false})
+	}
+	scripts, err := api.scriptFunctionList()
+	return append(results, scripts...), err
+}
+
 func (api *API) updateTransInfo(data []IM) ([]IM, error) {
 	for index := 0; index < len(data); index++ {
 		_, fkeys := data[index]["keys"]
@@ -928,6 +1265,15 @@ func (api *API) updateCheckInfo(nervatype string, data []IM) ([]IM, error) {
 				if _, found := data[index]["trans_transcast"]; !found {
 					data[index]["trans_transcast"] = "normal"
 				}
+				if ut.ToFloat(data[index]["acrate"], 0) == 0 {
+					acrate, err := api.NStore.transAcrate(ut.ToString(data[index]["curr"], ""), data[index]["transdate"], nil)
+					if err != nil {
+						return data, err
+					}
+					if acrate > 0 {
+						data[index]["acrate"] = acrate
+					}
+				}
 			}
 		} else {
 			if _, found := data[index]["crdate"]; found {
@@ -983,8 +1329,29 @@ Examples:
 
   _, err = api.Update("address", addressData)
 
+The calc (net or gross) value of an item row recalculates the netamount, vatamount and amount values
+(net: from the fxprice, discount, qty and the tax rate, gross: the fxprice and the net values from the amount),
+rounded to the digits of the document currency:
+
+  itemData := []map[string]interface{}{
+    map[string]interface{}{
+      "id":    12,
+      "qty":   3,
+      "calc":  "net"},
+    map[string]interface{}{
+      "id":     13,
+      "amount": 120,
+      "calc":   "gross"}}
+
+  _, err = api.Update("item", itemData)
+
 */
 func (api *API) Update(nervatype string, data []IM) (results []int64, err error) {
+	return api.updateItems(nervatype, data, false)
+}
+
+// updateItems - Update with an optional dry run mode (all changes are rolled back)
+func (api *API) updateItems(nervatype string, data []IM, dryRun bool) (results []int64, err error) {
 	if _, found := api.NStore.models[nervatype]; !found {
 		return results, errors.New(ut.GetMessage("invalid_nervatype") + " " + nervatype)
 	}
@@ -1006,21 +1373,27 @@ func (api *API) Update(nervatype string, data []IM) (results []int64, err error)
 		return results, err
 	}
 
+	if err = api.auditUpdate(nervatype, data); err != nil {
+		return results, err
+	}
+
 	var trans interface{}
 	if api.NStore.ds.Properties().Transaction {
 		trans, err = api.NStore.ds.BeginTransaction()
 		if err != nil {
 			return results, err
 		}
+	} else if dryRun {
+		return results, errors.New(ut.GetMessage("dry_run_transaction"))
 	}
 
 	defer func() {
 		pe := recover()
 		if trans != nil {
-			if err != nil || pe != nil {
-				api.NStore.ds.RollbackTransaction(trans)
+			if err != nil || pe != nil || dryRun {
+				api.NStore.rollbackTrans(trans)
 			} else {
-				err = api.NStore.ds.CommitTransaction(trans)
+				err = api.NStore.commitTrans(trans)
 			}
 		}
 		if pe != nil {
@@ -1029,6 +1402,11 @@ func (api *API) Update(nervatype string, data []IM) (results []int64, err error)
 	}()
 
 	for index := 0; index < len(data); index++ {
+		if nervatype == "item" {
+			if err = api.NStore.calcItem(data[index], trans); err != nil {
+				return results, err
+			}
+		}
 		id, err := api.NStore.UpdateData(IM{
 			"nervatype":    nervatype,
 			"values":       data[index],
@@ -1047,7 +1425,7 @@ func (api *API) Update(nervatype string, data []IM) (results []int64, err error)
 }
 
 /*
-Report - server-side PDF and CSV report generation
+Report - server-side PDF, CSV, XLSX and HTML report generation
 
 Examples:
 
@@ -1084,8 +1462,33 @@ Examples:
   }
   _, err = api.Report(options)
 
+  HTML document (browser preview, email body) of a PDF or CSV template:
+
+  options = map[string]interface{}{
+    "reportkey": "ntr_invoice_en",
+    "output":    "html",
+    "nervatype": "trans",
+    "refnumber": "DMINV/00001",
+  }
+  _, err = api.Report(options)
+
+  XLSX (Excel spreadsheet) output of a PDF or CSV template:
+
+  options = map[string]interface{}{
+    "reportkey": "csv_vat_en",
+    "output":    "xlsx",
+    "filters": map[string]interface{}{
+      "date_from": "2014-01-01",
+      "date_to":   "2019-01-01",
+    },
+  }
+  _, err = api.Report(options)
+
 */
 func (api *API) Report(options IM) (results IM, err error) {
+	if err = api.auditReport(options); err != nil {
+		return results, err
+	}
 	return api.NStore.getReport(options)
 }
 
@@ -1288,3 +1691,76 @@ func (api *API) ReportInstall(options IM) (result int64, err error) {
 
 	return api.NStore.ds.Update(Update{Model: "ui_report", Values: report})
 }
+
+/*
+DispatchEvents - deliver the pending change events of the outbox table to the webhook endpoints
+
+Every event is posted to all endpoints as a JSON object. If a secret is set, the
+X-Nervatura-Signature header contains the hex encoded HMAC-SHA256 of the request body.
+Failed deliveries are retried with exponential backoff (only to the failed endpoints),
+and after max_attempts failures the event state is set to dead. The delivery is at-least-once, the receivers
+can use the X-Nervatura-Event header (event id) for deduplication.
+The missing options are loaded from the NT_EVENT_* config values.
+
+Example:
+
+  options := map[string]interface{}{
+    "database":     "demo",
+    "webhooks":     []string{"https://example.com/webhook"},
+    "secret":       "SECRET_KEY",
+    "max_attempts": 5,
+    "retry_delay":  10,
+    "limit":        100}
+  result, err := api.DispatchEvents(options)
+
+*/
+func (api *API) DispatchEvents(options IM) (IM, error) {
+	if !api.NStore.ds.Connection().Connected {
+		database := ut.ToString(options["database"], "")
+		if database == "" {
+			return nil, errors.New(ut.GetMessage("missing_database"))
+		}
+		alias := ut.ToString(api.NStore.config["NT_ALIAS_"+strings.ToUpper(database)], os.Getenv("NT_ALIAS_"+strings.ToUpper(database)))
+		if alias == "" {
+			return nil, errors.New(ut.GetMessage("missing_database"))
+		}
+		if err := api.NStore.ds.CreateConnection(database, alias); err != nil {
+			return nil, err
+		}
+	}
+	return api.NStore.dispatchEvents(options)
+}
+
+/*
+Events - returns the change events of the outbox table after the cursor (event id)
+
+If the cursor value is missing, returns only the current last event id (the starting cursor, 0 on an empty outbox).
+The events are filtered by the access rights (ui_audit, transfilter) of the user. Requires NT_EVENT_ENABLED.
+The optional filters are checked against the current values of the record (the deleted values of the delete events),
+and only applied to the nervatypes that have the filter field.
+Reference field values can be ids or keys (e.g. transtype: invoice, customer_id: DMCUST/00001).
+
+Example:
+
+  options := map[string]interface{}{
+    "cursor":    125,
+    "nervatype": []string{"trans", "item"},
+    "operation": []string{"insert", "update"},
+    "filters":   map[string]interface{}{"transtype": "invoice"},
+    "limit":     100}
+  results, err := api.Events(options)
+
+*/
+func (api *API) Events(options IM) (results IM, err error) {
+	if !api.NStore.eventEnabled("") {
+		return results, errors.New(ut.GetMessage("disabled_feature") + ": NT_EVENT_ENABLED")
+	}
+	if values, valid := options["nervatype"].([]string); valid {
+		for _, nervatype := range values {
+			if _, found := api.NStore.models[nervatype]; !found {
+				return results, errors.New(ut.GetMessage("invalid_nervatype") + " " + nervatype)
+			}
+		}
+	}
+	return api.getEvents(options)
+}
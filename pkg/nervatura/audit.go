@@ -0,0 +1,406 @@
+package nervatura
+
+import (
+	"errors"
+	"fmt"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// ErrPermissionDenied - the ui_audit object rights or the transfilter data rights of the user do not allow the operation
+var ErrPermissionDenied = errors.New(ut.GetMessage("permission_denied"))
+
+func permissionError(detail string) error {
+	return fmt.Errorf("%w: %s", ErrPermissionDenied, detail)
+}
+
+// auditState - the access rights of the logged in user
+type auditState struct {
+	userID        int64
+	usergroup     int64
+	transfilter   string // own, usergroup or all
+	disabled      bool   // the usergroup has disabled object rights
+	transDisabled bool   // the usergroup has disabled transtype rights
+	rights        SM     // object right cache (nervatype/transtype)
+}
+
+/*
+getAuditState - returns the access rights of the logged in user.
+The result is nil, if the user has no restricted rights or the request is not a user request (API key, internal calls).
+*/
+func (api *API) getAuditState() (*auditState, error) {
+	if api.NStore.User == nil {
+		return nil, nil
+	}
+	transfilter, err := api.NStore.GetDataAudit()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := api.NStore.ds.Query([]Query{{
+		Fields: []string{"inf.groupvalue as inputfilter", "nt.groupvalue as nervatype"},
+		From:   "ui_audit a inner join groups inf on a.inputfilter = inf.id inner join groups nt on a.nervatype = nt.id",
+		Filters: []Filter{
+			{Field: "a.usergroup", Comp: "==", Value: api.NStore.User.Usergroup}}}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 && transfilter == "all" {
+		return nil, nil
+	}
+	state := &auditState{userID: api.NStore.User.Id, usergroup: api.NStore.User.Usergroup,
+		transfilter: transfilter, rights: SM{}}
+	for _, row := range rows {
+		state.disabled = state.disabled || (row["inputfilter"] == "disabled")
+		state.transDisabled = state.transDisabled || (row["inputfilter"] == "disabled" && row["nervatype"] == "trans")
+	}
+	return state, nil
+}
+
+// auditRight - the object right (disabled, readonly, update, all) of a ui_audit nervatype and transtype
+func (api *API) auditRight(state *auditState, nervatype, transtype string) (string, error) {
+	key := nervatype + "/" + transtype
+	if right, found := state.rights[key]; found {
+		return right, nil
+	}
+	options := IM{"nervatype": nervatype}
+	if transtype != "" {
+		options["transtype"] = transtype
+	}
+	audit, err := api.NStore.GetObjectAudit(options)
+	if err != nil {
+		return "", err
+	}
+	state.rights[key] = audit[0]
+	return audit[0], nil
+}
+
+// auditRefFields - the nervatype and ref_id field pairs of the models with more than one access nervatype
+func auditRefFields(nervatype string) [][]string {
+	if nervatype == "link" {
+		return [][]string{{"nervatype_1", "ref_id_1"}, {"nervatype_2", "ref_id_2"}}
+	}
+	return [][]string{{"nervatype", "ref_id"}}
+}
+
+// auditTransSQL - the trans rows of the transtype object rights and the transfilter data rights
+func (state *auditState) auditTransSQL() string {
+	sqlString := fmt.Sprintf(`transtype not in (select a.subtype from ui_audit a
+		inner join groups nt on a.nervatype = nt.id and nt.groupvalue = 'trans'
+		inner join groups inf on a.inputfilter = inf.id and inf.groupvalue = 'disabled'
+		where a.usergroup = %d and a.subtype is not null)`, state.usergroup)
+	switch state.transfilter {
+	case "own":
+		sqlString += fmt.Sprintf(" and cruser_id = %d", state.userID)
+	case "usergroup":
+		sqlString += fmt.Sprintf(" and cruser_id in (select id from employee where usergroup = %d)", state.usergroup)
+	}
+	return sqlString
+}
+
+// auditFilter - the Query.Filter condition of the readable rows of a nervatype
+func (api *API) auditFilter(state *auditState, nervatype string) (string, error) {
+	if state == nil || nervatype == "fieldvalue" {
+		return "", nil
+	}
+	access := api.NStore.models[nervatype].(IM)["_access"].(SL)
+	switch {
+	case access[0] == "transtype" && nervatype == "trans":
+		return " and (" + state.auditTransSQL() + ")", nil
+
+	case access[0] == "transtype":
+		return " and trans_id in (select id from trans where " + state.auditTransSQL() + ")", nil
+
+	case len(access) > 1:
+		sqlString := ""
+		for _, fields := range auditRefFields(nervatype) {
+			sqlString += fmt.Sprintf(` and %[1]s not in (select a.nervatype from ui_audit a
+				inner join groups inf on a.inputfilter = inf.id and inf.groupvalue = 'disabled'
+				where a.usergroup = %[3]d and a.subtype is null)
+				and (%[1]s <> (select id from groups where groupname = 'nervatype' and groupvalue = 'trans')
+					or %[2]s in (select id from trans where %[4]s))`,
+				fields[0], fields[1], state.usergroup, state.auditTransSQL())
+		}
+		return sqlString, nil
+	}
+
+	right, err := api.auditRight(state, access[0], "")
+	if err != nil {
+		return "", err
+	}
+	if right == "disabled" {
+		return "", permissionError(nervatype)
+	}
+	return "", nil
+}
+
+// auditTrans - check the transtype object right and the transfilter data right of a trans
+func (api *API) auditTrans(state *auditState, transID int64, transtypeID interface{}) (string, error) {
+	if transID <= 0 {
+		// new trans
+		rows, err := api.NStore.ds.Query([]Query{{
+			Fields: []string{"groupvalue"}, From: "groups", Filters: []Filter{
+				{Field: "id", Comp: "==", Value: ut.ToInteger(transtypeID, 0)}}}}, nil)
+		if err != nil || len(rows) == 0 {
+			return "", err
+		}
+		return ut.ToString(rows[0]["groupvalue"], ""), nil
+	}
+	rows, err := api.NStore.ds.Query([]Query{{
+		Fields: []string{"tt.groupvalue as transtype", "t.cruser_id", "e.usergroup"},
+		From:   "trans t inner join groups tt on t.transtype = tt.id left join employee e on t.cruser_id = e.id",
+		Filters: []Filter{
+			{Field: "t.id", Comp: "==", Value: transID}}}}, nil)
+	if err != nil || len(rows) == 0 {
+		return "", err
+	}
+	switch state.transfilter {
+	case "own":
+		if ut.ToInteger(rows[0]["cruser_id"], 0) != state.userID {
+			return "", permissionError("trans")
+		}
+	case "usergroup":
+		if ut.ToInteger(rows[0]["usergroup"], 0) != state.usergroup {
+			return "", permissionError("trans")
+		}
+	}
+	return ut.ToString(rows[0]["transtype"], ""), nil
+}
+
+/*
+auditRecord - check the access rights of a record operation (read, insert, update, delete).
+The read needs a not disabled object right. The insert and delete of a main record (customer, product, trans etc.)
+need the "all", the other changes need the "update" or "all" object right of the nervatype (and transtype).
+*/
+func (api *API) auditRecord(state *auditState, nervatype string, row IM, operation string) error {
+	if state == nil || nervatype == "fieldvalue" {
+		return nil
+	}
+	type auditTarget struct {
+		nervatype, transtype string
+		transID              int64
+		transtypeID          interface{}
+	}
+	targets := []auditTarget{}
+	access := api.NStore.models[nervatype].(IM)["_access"].(SL)
+	switch {
+	case access[0] == "transtype" && nervatype == "trans":
+		targets = append(targets, auditTarget{nervatype: "trans",
+			transID: ut.ToInteger(row["id"], 0), transtypeID: row["transtype"]})
+
+	case access[0] == "transtype":
+		targets = append(targets, auditTarget{nervatype: "trans", transID: ut.ToInteger(row["trans_id"], 0)})
+
+	case len(access) > 1:
+		for _, fields := range auditRefFields(nervatype) {
+			rows, err := api.NStore.ds.Query([]Query{{
+				Fields: []string{"groupvalue"}, From: "groups", Filters: []Filter{
+					{Field: "id", Comp: "==", Value: ut.ToInteger(row[fields[0]], 0)}}}}, nil)
+			if err != nil {
+				return err
+			}
+			if len(rows) > 0 {
+				target := auditTarget{nervatype: ut.ToString(rows[0]["groupvalue"], "")}
+				if target.nervatype == "trans" {
+					target.transID = ut.ToInteger(row[fields[1]], 0)
+				}
+				targets = append(targets, target)
+			}
+		}
+
+	default:
+		targets = append(targets, auditTarget{nervatype: access[0]})
+	}
+
+	for _, target := range targets {
+		if target.nervatype == "trans" {
+			if target.transID <= 0 && target.transtypeID == nil {
+				continue
+			}
+			transtype, err := api.auditTrans(state, target.transID, target.transtypeID)
+			if err != nil {
+				return err
+			}
+			target.transtype = transtype
+		}
+		right, err := api.auditRight(state, target.nervatype, target.transtype)
+		if err != nil {
+			return err
+		}
+		required := SL{"update", "all"}
+		switch {
+		case operation == "read":
+			required = SL{"readonly", "update", "all"}
+		case (operation == "insert" || operation == "delete") && target.nervatype == nervatype:
+			required = SL{"all"}
+		}
+		if !ut.Contains(required, right) {
+			return permissionError(nervatype)
+		}
+	}
+	return nil
+}
+
+// auditRow - the current values of a record
+func (api *API) auditRow(nervatype string, id int64) (IM, error) {
+	rows, err := api.NStore.ds.Query([]Query{{
+		Fields: []string{"*"}, From: nervatype, Filters: []Filter{
+			{Field: "id", Comp: "==", Value: id}}}}, nil)
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+// auditUpdate - check the access rights of the Update rows
+func (api *API) auditUpdate(nervatype string, data []IM) error {
+	state, err := api.getAuditState()
+	if err != nil || state == nil {
+		return err
+	}
+	for _, values := range data {
+		id := ut.ToInteger(values["id"], 0)
+		if id <= 0 {
+			if err := api.auditRecord(state, nervatype, values, "insert"); err != nil {
+				return err
+			}
+			continue
+		}
+		current, err := api.auditRow(nervatype, id)
+		if err != nil || current == nil {
+			return err
+		}
+		if err := api.auditRecord(state, nervatype, current, "update"); err != nil {
+			return err
+		}
+		// the new references of the record
+		for fieldname, value := range values {
+			if _, found := current[fieldname]; found {
+				current[fieldname] = value
+			}
+		}
+		if err := api.auditRecord(state, nervatype, current, "update"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// auditDelete - check the access rights of the Delete
+func (api *API) auditDelete(nervatype string, refID int64, refnumber string) error {
+	state, err := api.getAuditState()
+	if err != nil || state == nil {
+		return err
+	}
+	if refID == 0 && refnumber != "" {
+		info, err := api.NStore.GetInfofromRefnumber(IM{"nervatype": nervatype, "refnumber": refnumber})
+		if err != nil {
+			return err
+		}
+		refID = ut.ToInteger(info["id"], 0)
+	}
+	current, err := api.auditRow(nervatype, refID)
+	if err != nil || current == nil {
+		return err
+	}
+	return api.auditRecord(state, nervatype, current, "delete")
+}
+
+// auditBulk - check the object right of the nervatype before the Export (read) and the Import (update) rows
+func (api *API) auditBulk(nervatype, operation string) error {
+	state, err := api.getAuditState()
+	if err != nil || state == nil || nervatype == "fieldvalue" {
+		return err
+	}
+	access := api.NStore.models[nervatype].(IM)["_access"].(SL)
+	if len(access) > 1 {
+		// the rows are checked by the reference nervatypes
+		return nil
+	}
+	target := access[0]
+	if target == "transtype" {
+		target = "trans"
+	}
+	right, err := api.auditRight(state, target, "")
+	if err != nil {
+		return err
+	}
+	required := SL{"update", "all"}
+	if operation == "read" {
+		required = SL{"readonly", "update", "all"}
+	}
+	if !ut.Contains(required, right) {
+		return permissionError(nervatype)
+	}
+	return nil
+}
+
+// auditView - the raw SQL queries can bypass the row filters, they are enabled only without restricted rights
+func (api *API) auditView() error {
+	state, err := api.getAuditState()
+	if err != nil || state == nil {
+		return err
+	}
+	if state.disabled || state.transfilter != "all" {
+		return permissionError("view")
+	}
+	return nil
+}
+
+// auditReport - check the report object right and the read access of the report record
+func (api *API) auditReport(options IM) error {
+	state, err := api.getAuditState()
+	if err != nil || state == nil {
+		return err
+	}
+	report, err := api.NStore.getReportHead(options)
+	if err != nil {
+		return err
+	}
+	audit, err := api.NStore.GetObjectAudit(IM{"nervatype": "report", "transtype_id": ut.ToInteger(report["id"], 0)})
+	if err != nil {
+		return err
+	}
+	if audit[0] == "disabled" {
+		return permissionError("report")
+	}
+	if err = api.auditReportFunctions(ut.ToString(options["template"], ut.ToString(report["report"], ""))); err != nil {
+		return err
+	}
+	options["report"] = report
+
+	nervatype := ut.ToString(options["nervatype"], "")
+	refID := ut.ToInteger(report["ref_id"], 0)
+	if filters, valid := options["filters"].(IM); valid && refID == 0 {
+		refID = ut.ToInteger(filters["@id"], 0)
+	}
+	if _, found := api.NStore.models[nervatype]; !found || refID == 0 {
+		return nil
+	}
+	current, err := api.auditRow(nervatype, refID)
+	if err != nil || current == nil {
+		return err
+	}
+	return api.auditRecord(state, nervatype, current, "read")
+}
+
+// auditReportFunctions - check the object rights of the server function datasources of a report template
+func (api *API) auditReportFunctions(jsonTemplate string) error {
+	reportTemplate := IM{}
+	if err := ut.ConvertFromByte([]byte(jsonTemplate), &reportTemplate); err != nil {
+		// the invalid template error is returned by the report generation
+		return nil
+	}
+	sources, _ := reportTemplate["sources"].(IM)
+	for _, ds := range sources {
+		source, valid := ds.(IM)
+		if !valid {
+			continue
+		}
+		if fn, found := GetFunction(ut.ToString(source["function"], "")); found {
+			if err := api.checkFunctionAudit(&fn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,368 @@
+package nervatura
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+const (
+	backupFormat  string = "nervatura-backup"
+	backupVersion int64  = 1
+)
+
+// backupModels - the DataModel tables in the order of their references
+func backupModels() []string {
+	models := DataModel()["model"].(IM)
+	names := []string{}
+	for name := range models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := []string{}
+	added := map[string]bool{}
+	for len(result) < len(names) {
+		count := len(result)
+		for _, name := range names {
+			if added[name] {
+				continue
+			}
+			ready := true
+			for _, fieldname := range models[name].(IM)["_fields"].(SL) {
+				field := models[name].(IM)[fieldname].(MF)
+				if field.References != nil && field.References[0] != name && !added[field.References[0]] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				result = append(result, name)
+				added[name] = true
+			}
+		}
+		if count == len(result) {
+			// circular references
+			for _, name := range names {
+				if !added[name] {
+					result = append(result, name)
+					added[name] = true
+				}
+			}
+		}
+	}
+	return result
+}
+
+// backupValue - engine neutral field value
+func backupValue(field MF, value interface{}) interface{} {
+	switch field.Type {
+	case "date":
+		return bulkDate(value)
+	case "datetime":
+		if tm, valid := value.(time.Time); valid {
+			return tm.Format(datetimeISOFmt)
+		}
+	}
+	if bvalue, valid := value.([]byte); valid {
+		return string(bvalue)
+	}
+	return value
+}
+
+// restoreValue - convert the archive value to the type of the model field
+func restoreValue(field MF, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	if field.References != nil || field.Type == "id" || field.Type == "integer" {
+		return ut.ToInteger(value, 0)
+	}
+	if field.Type == "float" {
+		return ut.ToFloat(value, 0)
+	}
+	return ut.ToString(value, "")
+}
+
+func (api *API) databaseConnect(database string) error {
+	if database == "" {
+		return errors.New(ut.GetMessage("missing_required_field") + ": database")
+	}
+	alias := ut.ToString(api.NStore.config["NT_ALIAS_"+strings.ToUpper(database)], os.Getenv("NT_ALIAS_"+strings.ToUpper(database)))
+	if err := api.NStore.ds.CreateConnection(database, alias); err != nil {
+		return errors.New(ut.GetMessage("not_connect"))
+	}
+	return nil
+}
+
+func backupWrite(writer io.Writer, value IM) error {
+	line, err := ut.ConvertToByte(value)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(append(line, '\n'))
+	return err
+}
+
+/*
+DatabaseBackup - write all tables (DataModel), the password hashtable and the report templates
+of the database to a gzip compressed, engine neutral archive (JSON Lines).
+
+Example:
+
+  file, err := os.Create("demo.backup")
+  result, err := getAPI().DatabaseBackup(map[string]interface{}{"database": "demo"}, file)
+
+*/
+func (api *API) DatabaseBackup(options IM, writer io.Writer) (result IM, err error) {
+	result = IM{"database": ut.ToString(options["database"], ""), "rows": int64(0), "models": IM{}}
+	if err = api.databaseConnect(result["database"].(string)); err != nil {
+		return result, err
+	}
+	result["engine"] = api.NStore.ds.Connection().Engine
+	batch := ut.ToInteger(options["batch"], 1000)
+	if batch <= 0 {
+		batch = 1000
+	}
+	hashtable := ut.ToString(api.NStore.config["NT_HASHTABLE"], "")
+	if err = api.NStore.ds.CheckHashtable(hashtable); err != nil {
+		return result, err
+	}
+
+	// all tables are read in a single transaction (consistent snapshot)
+	trans, err := api.NStore.ds.BeginReadTransaction()
+	if err != nil {
+		return result, err
+	}
+	defer api.NStore.ds.RollbackTransaction(trans)
+
+	zw := gzip.NewWriter(writer)
+	defer func() {
+		if cerr := zw.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	if err = backupWrite(zw, IM{"format": backupFormat, "version": backupVersion,
+		"engine": result["engine"], "created": time.Now().Format(datetimeISOFmt)}); err != nil {
+		return result, err
+	}
+
+	for _, model := range backupModels() {
+		fields := api.NStore.models[model].(IM)["_fields"].(SL)
+		cursor, count := int64(0), int64(0)
+		for {
+			rows, err := api.NStore.ds.Query([]Query{{
+				Fields: fields, From: model, Filters: []Filter{{Field: "id", Comp: ">", Value: cursor}},
+				OrderBy: []string{"id"}, Limit: batch}}, trans)
+			if err != nil {
+				return result, err
+			}
+			for _, row := range rows {
+				values := IM{}
+				for _, fieldname := range fields {
+					if row[fieldname] != nil {
+						values[fieldname] = backupValue(api.NStore.models[model].(IM)[fieldname].(MF), row[fieldname])
+					}
+				}
+				if err = backupWrite(zw, IM{"model": model, "values": values}); err != nil {
+					return result, err
+				}
+				cursor = ut.ToInteger(row["id"], 0)
+				count++
+			}
+			if int64(len(rows)) < batch {
+				break
+			}
+		}
+		result["models"].(IM)[model] = count
+		result["rows"] = result["rows"].(int64) + count
+	}
+
+	rows, err := api.NStore.ds.Query([]Query{{Fields: []string{"refname", "value"}, From: hashtable}}, trans)
+	if err != nil {
+		return result, err
+	}
+	for _, row := range rows {
+		if err = backupWrite(zw, IM{"hashtable": IM{
+			"refname": ut.ToString(row["refname"], ""), "value": ut.ToString(row["value"], "")}}); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// restoreEmpty - the database contains only the initial data of the DatabaseCreate
+func (api *API) restoreEmpty() (bool, error) {
+	initData := DataModel()["data"].(map[string][]IM)
+	for _, model := range backupModels() {
+		rows, err := api.NStore.ds.Query([]Query{{Fields: []string{"count(*) as qty"}, From: model}}, nil)
+		if err != nil {
+			return false, err
+		}
+		if len(rows) > 0 && ut.ToInteger(rows[0]["qty"], 0) > int64(len(initData[model])) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+/*
+DatabaseRestore - load a DatabaseBackup archive into a new, empty database
+(created by the DatabaseCreate, without demo data). The archive can be restored to any database engine.
+
+Example:
+
+  file, err := os.Open("demo.backup")
+  _, err := getAPI().DatabaseRestore(map[string]interface{}{"database": "demo2"}, file)
+
+*/
+func (api *API) DatabaseRestore(options IM, reader io.Reader) (logData []SM, err error) {
+	logData = []SM{}
+	logErr := func(err error) ([]SM, error) {
+		logData = append(logData, SM{
+			"stamp":   time.Now().Format(TimeLayout),
+			"state":   "err",
+			"message": err.Error()})
+		return logData, err
+	}
+	database := ut.ToString(options["database"], "")
+	if err = api.databaseConnect(database); err != nil {
+		return logErr(err)
+	}
+	logData = append(logData, SM{
+		"database": database,
+		"stamp":    time.Now().Format(TimeLayout),
+		"state":    "restore",
+		"message":  ut.GetMessage("log_start_process")})
+
+	zr, err := gzip.NewReader(reader)
+	if err != nil {
+		return logErr(errors.New(ut.GetMessage("invalid_backup") + ": " + err.Error()))
+	}
+	defer zr.Close()
+	decoder := json.NewDecoder(zr)
+	header := IM{}
+	if err = decoder.Decode(&header); err != nil || header["format"] != backupFormat {
+		return logErr(errors.New(ut.GetMessage("invalid_backup")))
+	}
+	if ut.ToInteger(header["version"], 0) > backupVersion {
+		return logErr(errors.New(ut.GetMessage("invalid_backup") + ": version " + ut.ToString(header["version"], "")))
+	}
+
+	empty, err := api.restoreEmpty()
+	if err != nil {
+		return logErr(err)
+	}
+	if !empty {
+		return logErr(errors.New(ut.GetMessage("restore_not_empty")))
+	}
+
+	trans, err := api.NStore.ds.BeginTransaction()
+	if err != nil {
+		return logErr(err)
+	}
+	defer func() {
+		if err != nil {
+			api.NStore.ds.RollbackTransaction(trans)
+		}
+	}()
+
+	models := backupModels()
+	for index := len(models) - 1; index >= 0; index-- {
+		if err = api.NStore.ds.ClearData(models[index], trans); err != nil {
+			return logErr(err)
+		}
+	}
+	logData = append(logData, SM{
+		"stamp":   time.Now().Format(TimeLayout),
+		"state":   "restore",
+		"message": ut.GetMessage("log_restore_data")})
+
+	batch := int(ut.ToInteger(options["batch"], 500))
+	if batch <= 0 {
+		batch = 500
+	}
+	model, rows, count := "", []IM{}, 0
+	flush := func() error {
+		if len(rows) > 0 {
+			if err := api.NStore.ds.RestoreData(model, rows, trans); err != nil {
+				return errors.New(model + ": " + err.Error())
+			}
+			count += len(rows)
+			rows = []IM{}
+		}
+		return nil
+	}
+	logModel := func() {
+		if model != "" {
+			logData = append(logData, SM{
+				"stamp":    time.Now().Format(TimeLayout),
+				"state":    "restore",
+				"datatype": model,
+				"result":   strconv.Itoa(count)})
+		}
+	}
+	hashtable := []IM{}
+	for {
+		line := IM{}
+		if err = decoder.Decode(&line); err == io.EOF {
+			break
+		}
+		if err != nil {
+			return logErr(errors.New(ut.GetMessage("invalid_backup") + ": " + err.Error()))
+		}
+		if hvalues, found := line["hashtable"].(IM); found {
+			hashtable = append(hashtable, hvalues)
+			continue
+		}
+		lmodel := ut.ToString(line["model"], "")
+		if _, found := api.NStore.models[lmodel]; !found {
+			return logErr(errors.New(ut.GetMessage("invalid_nervatype") + " " + lmodel))
+		}
+		if lmodel != model || len(rows) >= batch {
+			if err = flush(); err != nil {
+				return logErr(err)
+			}
+		}
+		if lmodel != model {
+			logModel()
+			model, count = lmodel, 0
+		}
+		values := IM{}
+		lvalues, _ := line["values"].(IM)
+		for _, fieldname := range api.NStore.models[model].(IM)["_fields"].(SL) {
+			if value, found := lvalues[fieldname]; found {
+				values[fieldname] = restoreValue(api.NStore.models[model].(IM)[fieldname].(MF), value)
+			}
+		}
+		rows = append(rows, values)
+	}
+	if err = flush(); err != nil {
+		return logErr(err)
+	}
+	logModel()
+	if err = api.NStore.ds.CommitTransaction(trans); err != nil {
+		return logErr(err)
+	}
+
+	hashName := ut.ToString(api.NStore.config["NT_HASHTABLE"], "")
+	for _, hvalues := range hashtable {
+		if err = api.NStore.ds.UpdateHashtable(hashName,
+			ut.ToString(hvalues["refname"], ""), ut.ToString(hvalues["value"], "")); err != nil {
+			return logErr(err)
+		}
+	}
+
+	logData = append(logData, SM{
+		"stamp":   time.Now().Format(TimeLayout),
+		"state":   "log",
+		"message": ut.GetMessage("info_restore_ok")})
+	return logData, nil
+}
@@ -0,0 +1,699 @@
+package nervatura
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+const (
+	bankFormatCAMT  string = "camt053"
+	bankFormatMT940 string = "mt940"
+)
+
+// bankLine - a booked entry of a bank statement (the amount of a debit entry is negative)
+type bankLine struct {
+	paiddate  string
+	amount    float64
+	reference string
+	name      string
+}
+
+// bankStatement - a parsed CAMT.053 or MT940 bank statement
+type bankStatement struct {
+	id, account, curr, date string
+	lines                   []bankLine
+}
+
+// bankInvoice - an open (not paid) invoice of the payment reconciliation
+type bankInvoice struct {
+	id                                          int64
+	transnumber, refnumber, custname, direction string
+	open                                        float64
+}
+
+// bankMatch - the allocated amount of a statement line to an invoice
+type bankMatch struct {
+	invoice *bankInvoice
+	amount  float64
+}
+
+type camtAmount struct {
+	Value string `xml:",chardata"`
+	Ccy   string `xml:"Ccy,attr"`
+}
+
+type camtParty struct {
+	Nm    string `xml:"Nm"`
+	PtyNm string `xml:"Pty>Nm"`
+}
+
+type camtTxDetails struct {
+	Amt        camtAmount `xml:"Amt"`
+	TxAmt      camtAmount `xml:"AmtDtls>TxAmt>Amt"`
+	EndToEndID string     `xml:"Refs>EndToEndId"`
+	Ustrd      []string   `xml:"RmtInf>Ustrd"`
+	Ref        []string   `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	Dbtr       camtParty  `xml:"RltdPties>Dbtr"`
+	Cdtr       camtParty  `xml:"RltdPties>Cdtr"`
+	AddtlTxInf string     `xml:"AddtlTxInf"`
+}
+
+type camtEntry struct {
+	Amt       camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Sts       struct {
+		Value string `xml:",chardata"`
+		Cd    string `xml:"Cd"`
+	} `xml:"Sts"`
+	BookgDt      string          `xml:"BookgDt>Dt"`
+	BookgDtTm    string          `xml:"BookgDt>DtTm"`
+	AcctSvcrRef  string          `xml:"AcctSvcrRef"`
+	Details      []camtTxDetails `xml:"NtryDtls>TxDtls"`
+	AddtlNtryInf string          `xml:"AddtlNtryInf"`
+}
+
+type camtStatement struct {
+	ID      string      `xml:"Id"`
+	CreDtTm string      `xml:"CreDtTm"`
+	IBAN    string      `xml:"Acct>Id>IBAN"`
+	Othr    string      `xml:"Acct>Id>Othr>Id"`
+	Ccy     string      `xml:"Acct>Ccy"`
+	Entries []camtEntry `xml:"Ntry"`
+}
+
+type camtDocument struct {
+	Statements []camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+var mt940Tag = regexp.MustCompile(`^:(\d{2}[A-Z]?):(.*)$`)
+var mt940Entry = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+(?:,\d*)?)([NSF][A-Z0-9]{3})?([^/]*)(?://(.*))?$`)
+var mt940Subfield = regexp.MustCompile(`\?(\d{2})`)
+
+// bankText - the not empty values separated by space
+func bankText(values ...string) string {
+	texts := []string{}
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && value != "NOTPROVIDED" && value != "NONREF" {
+			texts = append(texts, value)
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+// bankDate - the date part of an ISO date or datetime value
+func bankDate(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) > len(dateFmt) {
+		return value[:len(dateFmt)]
+	}
+	return value
+}
+
+func (party camtParty) name() string {
+	if party.Nm != "" {
+		return strings.TrimSpace(party.Nm)
+	}
+	return strings.TrimSpace(party.PtyNm)
+}
+
+func (tx camtTxDetails) amount() string {
+	if tx.Amt.Value != "" {
+		return tx.Amt.Value
+	}
+	return tx.TxAmt.Value
+}
+
+func (tx camtTxDetails) reference() string {
+	return bankText(append(append(tx.Ref, tx.Ustrd...), tx.EndToEndID, tx.AddtlTxInf)...)
+}
+
+/*
+bankParseCAMT - the booked entries of the ISO 20022 CAMT.053 statements. A batch booked entry with more
+transaction details (and amounts) is split into more lines. The counterparty of a credit entry is the debtor,
+and the creditor of a debit entry.
+*/
+func bankParseCAMT(data []byte) ([]bankStatement, error) {
+	doc := camtDocument{}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Statements) == 0 {
+		return nil, errors.New(ut.GetMessage("invalid_value") + ": " + bankFormatCAMT)
+	}
+	statements := []bankStatement{}
+	for _, stmt := range doc.Statements {
+		statement := bankStatement{id: strings.TrimSpace(stmt.ID), account: strings.TrimSpace(stmt.IBAN + stmt.Othr),
+			curr: strings.TrimSpace(stmt.Ccy), date: bankDate(stmt.CreDtTm)}
+		for _, entry := range stmt.Entries {
+			if strings.TrimSpace(entry.Sts.Value) == "PDNG" || entry.Sts.Cd == "PDNG" {
+				continue
+			}
+			if statement.curr == "" {
+				statement.curr = entry.Amt.Ccy
+			}
+			sign := 1.0
+			if strings.TrimSpace(entry.CdtDbtInd) == "DBIT" {
+				sign = -1
+			}
+			paiddate := bankDate(entry.BookgDt + entry.BookgDtTm)
+			details := entry.Details
+			split := len(details) > 1
+			for _, tx := range details {
+				split = split && tx.amount() != ""
+			}
+			if !split {
+				// one line with all the references of the entry
+				line := camtTxDetails{Amt: entry.Amt}
+				for _, tx := range details {
+					line.Ustrd = append(line.Ustrd, tx.reference())
+					if line.Dbtr.name() == "" {
+						line.Dbtr, line.Cdtr = tx.Dbtr, tx.Cdtr
+					}
+				}
+				details = []camtTxDetails{line}
+			}
+			for _, tx := range details {
+				amount, err := strconv.ParseFloat(strings.TrimSpace(tx.amount()), 64)
+				if err != nil {
+					return nil, errors.New(ut.GetMessage("invalid_value") + ": " + tx.amount())
+				}
+				party := tx.Dbtr
+				if sign < 0 {
+					party = tx.Cdtr
+				}
+				statement.lines = append(statement.lines, bankLine{paiddate: paiddate, amount: sign * amount,
+					reference: bankText(tx.reference(), entry.AddtlNtryInf, entry.AcctSvcrRef), name: party.name()})
+			}
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
+
+// mt940Date - the ISO date of a YYMMDD value
+func mt940Date(value string) (string, error) {
+	date, err := time.Parse("060102", value)
+	if err != nil {
+		return "", errors.New(ut.GetMessage("invalid_value") + ": " + value)
+	}
+	return date.Format(dateFmt), nil
+}
+
+/*
+mt940Info - the reference text and the counterparty name of a :86: field. The structured (?20-?29, ?60-?63
+remittance and ?32-?33 name subfields) information is parsed, an unstructured text is the reference.
+*/
+func mt940Info(info string) (reference, name string) {
+	indexes := mt940Subfield.FindAllStringSubmatchIndex(info, -1)
+	if len(indexes) == 0 {
+		return strings.TrimSpace(info), ""
+	}
+	for i, index := range indexes {
+		end := len(info)
+		if i < len(indexes)-1 {
+			end = indexes[i+1][0]
+		}
+		code, _ := strconv.Atoi(info[index[2]:index[3]])
+		switch value := info[index[1]:end]; {
+		case (code >= 20 && code <= 29) || (code >= 60 && code <= 63):
+			reference += value
+		case code == 32 || code == 33:
+			name += value
+		}
+	}
+	return strings.TrimSpace(reference), strings.TrimSpace(name)
+}
+
+/*
+bankParseMT940 - the statement lines (:61:) and the information fields (:86:) of the SWIFT MT940 statements.
+The continuation lines of a field are joined, a new statement starts with the :20: field.
+*/
+func bankParseMT940(data []byte) ([]bankStatement, error) {
+	fields := [][2]string{}
+	for _, row := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		row = strings.TrimRight(row, "\r")
+		if match := mt940Tag.FindStringSubmatch(row); match != nil {
+			fields = append(fields, [2]string{match[1], match[2]})
+		} else if len(fields) > 0 && !strings.HasPrefix(row, "-") && !strings.HasPrefix(row, "{") {
+			fields[len(fields)-1][1] += "\n" + row
+		}
+	}
+	statements := []bankStatement{}
+	var statement *bankStatement
+	var line *bankLine
+	for _, field := range fields {
+		tag, value := field[0], field[1]
+		if tag == "20" {
+			statements = append(statements, bankStatement{id: strings.TrimSpace(value)})
+			statement, line = &statements[len(statements)-1], nil
+			continue
+		}
+		if statement == nil {
+			return nil, errors.New(ut.GetMessage("invalid_value") + ": " + bankFormatMT940)
+		}
+		switch tag {
+		case "25":
+			statement.account = strings.TrimSpace(value)
+		case "28C":
+			statement.id = bankText(statement.id, value)
+		case "60F", "60M":
+			if len(value) >= 10 {
+				statement.curr = value[7:10]
+			}
+		case "62F":
+			if len(value) >= 7 {
+				date, err := mt940Date(value[1:7])
+				if err != nil {
+					return nil, err
+				}
+				statement.date = date
+			}
+		case "61":
+			rows := strings.SplitN(value, "\n", 2)
+			match := mt940Entry.FindStringSubmatch(strings.TrimSpace(rows[0]))
+			if match == nil {
+				return nil, errors.New(ut.GetMessage("invalid_value") + ": :61:" + rows[0])
+			}
+			paiddate, err := mt940Date(match[1])
+			if err != nil {
+				return nil, err
+			}
+			amount, err := strconv.ParseFloat(strings.Replace(match[5], ",", ".", 1), 64)
+			if err != nil {
+				return nil, errors.New(ut.GetMessage("invalid_value") + ": " + match[5])
+			}
+			if match[3] == "D" || match[3] == "RC" {
+				amount = -amount
+			}
+			reference := bankText(match[7], match[8])
+			if len(rows) > 1 {
+				reference = bankText(reference, rows[1])
+			}
+			statement.lines = append(statement.lines, bankLine{paiddate: paiddate, amount: amount, reference: reference})
+			line = &statement.lines[len(statement.lines)-1]
+		case "86":
+			if line != nil {
+				reference, name := mt940Info(strings.ReplaceAll(value, "\n", ""))
+				line.reference, line.name = bankText(reference, line.reference), name
+				line = nil
+			}
+		}
+	}
+	if len(statements) == 0 {
+		return nil, errors.New(ut.GetMessage("invalid_value") + ": " + bankFormatMT940)
+	}
+	return statements, nil
+}
+
+// bankParse - the statements of a CAMT.053 or MT940 file (default format: XML content is CAMT.053)
+func bankParse(format string, data []byte) ([]bankStatement, error) {
+	data = bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")))
+	if format == "" {
+		format = bankFormatMT940
+		if bytes.HasPrefix(data, []byte("<")) {
+			format = bankFormatCAMT
+		}
+	}
+	switch format {
+	case bankFormatCAMT:
+		return bankParseCAMT(data)
+	case bankFormatMT940:
+		return bankParseMT940(data)
+	default:
+		return nil, errors.New(ut.GetMessage("invalid_value") + "- format: " + format)
+	}
+}
+
+// bankRefIndex - the position of a document number in the reference text (-1: not found)
+func bankRefIndex(text, ref string) int {
+	isAlnum := func(c byte) bool {
+		return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+	}
+	for offset := 0; ref != "" && offset < len(text); {
+		index := strings.Index(text[offset:], ref)
+		if index < 0 {
+			return -1
+		}
+		index += offset
+		end := index + len(ref)
+		if (index == 0 || !isAlnum(text[index-1])) && (end == len(text) || !isAlnum(text[end])) {
+			return index
+		}
+		offset = index + 1
+	}
+	return -1
+}
+
+/*
+bankMatchLine - the invoice allocations of a statement line. A credit line pays the outgoing, a debit line
+the incoming invoices. The invoices with the document number (or the supplier invoice number of an incoming
+invoice) in the reference text are paid in the order of the references. Without reference, the line is
+matched to the only invoice with the same open amount and customer name.
+*/
+func bankMatchLine(line bankLine, invoices []*bankInvoice) []bankMatch {
+	matches := []bankMatch{}
+	direction := "out"
+	if line.amount < 0 {
+		direction = "in"
+	}
+	amount := math.Abs(line.amount)
+	text := strings.ToUpper(line.reference)
+	type refIndex struct {
+		index   int
+		invoice *bankInvoice
+	}
+	refs := []refIndex{}
+	for _, invoice := range invoices {
+		if invoice.direction != direction || invoice.open < stockEpsilon {
+			continue
+		}
+		index := bankRefIndex(text, strings.ToUpper(invoice.transnumber))
+		if index < 0 && direction == "in" && len(invoice.refnumber) > 3 {
+			index = bankRefIndex(text, strings.ToUpper(invoice.refnumber))
+		}
+		if index >= 0 {
+			refs = append(refs, refIndex{index: index, invoice: invoice})
+		}
+	}
+	sort.SliceStable(refs, func(i, j int) bool { return refs[i].index < refs[j].index })
+	for _, ref := range refs {
+		if amount < stockEpsilon {
+			break
+		}
+		matches = append(matches, bankMatch{invoice: ref.invoice, amount: math.Min(amount, ref.invoice.open)})
+		amount -= matches[len(matches)-1].amount
+	}
+	if len(refs) > 0 {
+		return matches
+	}
+
+	name := strings.ToUpper(line.name)
+	for _, invoice := range invoices {
+		custname := strings.ToUpper(invoice.custname)
+		if invoice.direction != direction || math.Abs(invoice.open-amount) >= stockEpsilon || custname == "" {
+			continue
+		}
+		if (name != "" && (strings.Contains(name, custname) || strings.Contains(custname, name))) ||
+			bankRefIndex(text, custname) >= 0 {
+			matches = append(matches, bankMatch{invoice: invoice, amount: amount})
+		}
+	}
+	if len(matches) > 1 {
+		return []bankMatch{}
+	}
+	return matches
+}
+
+// bankOpenInvoices - the not paid invoices of a currency with the not allocated amounts
+func (nstore *NervaStore) bankOpenInvoices(curr string, trans interface{}) ([]*bankInvoice, error) {
+	invoices := []*bankInvoice{}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"t.id", "t.transnumber", "t.ref_transnumber", "dir.groupvalue as direction", "c.custname"},
+		From: `trans t inner join groups tt on t.transtype = tt.id inner join groups dir on t.direction = dir.id
+			left join customer c on t.customer_id = c.id`,
+		Filters: []Filter{
+			{Field: "tt.groupvalue", Comp: "==", Value: "invoice"},
+			{Field: "t.deleted", Comp: "==", Value: 0},
+			{Field: "t.paid", Comp: "==", Value: 0},
+			{Field: "t.curr", Comp: "==", Value: curr}},
+		OrderBy: []string{"t.transdate", "t.id"}}}, trans)
+	if err != nil || len(rows) == 0 {
+		return invoices, err
+	}
+	ids := IL{}
+	for _, row := range rows {
+		ids = append(ids, row["id"])
+	}
+	items, err := nstore.ds.Query([]Query{{
+		Fields: []string{"trans_id", "amount"}, From: "item",
+		Filters: []Filter{
+			{Field: "trans_id", Comp: "in", Value: ids},
+			{Field: "deleted", Comp: "==", Value: 0}}}}, trans)
+	if err != nil {
+		return invoices, err
+	}
+	amounts := map[int64]float64{}
+	for _, item := range items {
+		amounts[ut.ToInteger(item["trans_id"], 0)] += ut.ToFloat(item["amount"], 0)
+	}
+	paid, err := nstore.fxOpenLinks("ref_id_2", "", trans)
+	if err != nil {
+		return invoices, err
+	}
+	for _, row := range rows {
+		id := ut.ToInteger(row["id"], 0)
+		if open := amounts[id] - paid[id]; open >= stockEpsilon {
+			invoices = append(invoices, &bankInvoice{id: id, transnumber: ut.ToString(row["transnumber"], ""),
+				refnumber: ut.ToString(row["ref_transnumber"], ""), custname: ut.ToString(row["custname"], ""),
+				direction: ut.ToString(row["direction"], ""), open: open})
+		}
+	}
+	return invoices, nil
+}
+
+// bankPlace - the bank account (place) of the statements (default: the default_bank setting)
+func (nstore *NervaStore) bankPlace(planumber string, trans interface{}) (IM, error) {
+	if planumber == "" {
+		value, err := nstore.settingValue("default_bank", trans)
+		if err != nil {
+			return nil, err
+		}
+		planumber = value
+	}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"p.id", "p.planumber", "p.curr"}, From: "place p inner join groups g on p.placetype = g.id",
+		Filters: []Filter{
+			{Field: "p.planumber", Comp: "==", Value: planumber},
+			{Field: "g.groupvalue", Comp: "==", Value: "bank"},
+			{Field: "p.deleted", Comp: "==", Value: 0}}}}, trans)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New(ut.GetMessage("invalid_value") + ": planumber " + planumber)
+	}
+	return rows[0], nil
+}
+
+// bankStatementExists - the statement is already imported to the bank account
+func (nstore *NervaStore) bankStatementExists(placeID interface{}, statementID string, trans interface{}) (bool, error) {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"t.id"}, From: "trans t inner join groups tt on t.transtype = tt.id",
+		Filters: []Filter{
+			{Field: "tt.groupvalue", Comp: "==", Value: "bank"},
+			{Field: "t.place_id", Comp: "==", Value: placeID},
+			{Field: "t.ref_transnumber", Comp: "==", Value: statementID},
+			{Field: "t.deleted", Comp: "==", Value: 0}}}}, trans)
+	return len(rows) > 0, err
+}
+
+/*
+BankImport - import ISO 20022 CAMT.053 or SWIFT MT940 bank statements and reconcile the payments
+
+Every statement is saved as a bank document (the ref_transnumber is the statement ID) with the payment rows
+of the statement lines. The lines are matched to the open invoices by the document number in the reference
+text, or by the amount and the customer name. The matches are saved as payment-invoice links (link_qty value)
+and the fully paid invoices are marked as paid. The not matched lines are returned for manual review.
+In dry run mode only the matching result is returned. The import fails, if a statement ID is repeated in the input.
+Options:
+
+  format - camt053 or mt940. Default value: XML content is camt053, other is mt940
+  planumber - the bank account (place) of the statements. Default value: default_bank setting
+  dry_run - match the lines without saving. Default value: false
+
+Example:
+
+  file, err := os.Open("statement.xml")
+  result, err := api.BankImport(map[string]interface{}{"planumber": "bank"}, file)
+
+*/
+func (api *API) BankImport(options IM, reader io.Reader) (result IM, err error) {
+	dryRun := ut.ToBoolean(options["dry_run"], false)
+	result = IM{"dry_run": dryRun, "rows": 0, "matched": 0,
+		"statements": []IM{}, "matches": []IM{}, "unmatched": []IM{}}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return result, err
+	}
+	statements, err := bankParse(ut.ToString(options["format"], ""), data)
+	if err != nil {
+		return result, err
+	}
+	statementIDs := map[string]bool{}
+	for _, statement := range statements {
+		if statement.id == "" {
+			return result, errors.New(ut.GetMessage("missing_required_field") + ": statement id")
+		}
+		if statementIDs[statement.id] {
+			return result, errors.New(ut.GetMessage("bank_statement_double") + " " + statement.id)
+		}
+		statementIDs[statement.id] = true
+	}
+
+	// the dry run is rolled back
+	var trans interface{}
+	if api.NStore.ds.Properties().Transaction {
+		trans, err = api.NStore.ds.BeginTransaction()
+		if err != nil {
+			return result, err
+		}
+	}
+	defer func() {
+		pe := recover()
+		if trans != nil {
+			if err != nil || pe != nil || dryRun {
+				api.NStore.rollbackTrans(trans)
+			} else {
+				err = api.NStore.commitTrans(trans)
+			}
+		}
+		if pe != nil {
+			panic(pe)
+		}
+	}()
+
+	place, err := api.NStore.bankPlace(ut.ToString(options["planumber"], ""), trans)
+	if err != nil {
+		return result, err
+	}
+	groups, err := api.NStore.groupIDs(SL{"transtype", "direction", "transtate", "nervatype"}, trans)
+	if err != nil {
+		return result, err
+	}
+	if err = api.auditUpdate("trans", []IM{{"transtype": groups["transtype/bank"]}}); err != nil {
+		return result, err
+	}
+	defaultCurr, err := api.NStore.settingValue("default_currency", trans)
+	if err != nil {
+		return result, err
+	}
+	// the document numbers are reserved first: the numberdef row update locks the concurrent imports
+	// until the commit, so the statement and the open invoice checks read the committed state
+	transnumbers := make([]string, len(statements))
+	if !dryRun {
+		for si := range statements {
+			if transnumbers[si], err = api.NStore.nextNumber(IM{"numberkey": "bank_transfer", "step": true, "trans": trans}); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	invoices := map[string][]*bankInvoice{}
+	matches := make([][][]bankMatch, len(statements))
+	paid := []IM{}
+	for si, statement := range statements {
+		if exists, err := api.NStore.bankStatementExists(place["id"], statement.id, trans); exists || err != nil {
+			if err != nil {
+				return result, err
+			}
+			return result, errors.New(ut.GetMessage("bank_statement_exists") + " " + statement.id)
+		}
+		curr := ut.ToString(place["curr"], defaultCurr)
+		if statement.curr != "" && statement.curr != curr {
+			return result, errors.New(ut.GetMessage("invalid_value") + ": curr " + statement.curr)
+		}
+		if _, found := invoices[curr]; !found {
+			if invoices[curr], err = api.NStore.bankOpenInvoices(curr, trans); err != nil {
+				return result, err
+			}
+		}
+		matches[si] = make([][]bankMatch, len(statement.lines))
+		for li, line := range statement.lines {
+			matches[si][li] = bankMatchLine(line, invoices[curr])
+			for _, match := range matches[si][li] {
+				match.invoice.open -= match.amount
+				result["matches"] = append(result["matches"].([]IM), IM{"statement": statement.id, "row": li + 1,
+					"transnumber": match.invoice.transnumber, "amount": match.amount})
+			}
+			if len(matches[si][li]) > 0 {
+				result["matched"] = result["matched"].(int) + 1
+			} else {
+				result["unmatched"] = append(result["unmatched"].([]IM), IM{"statement": statement.id, "row": li + 1,
+					"paiddate": line.paiddate, "amount": line.amount, "reference": line.reference, "name": line.name})
+			}
+		}
+		result["rows"] = result["rows"].(int) + len(statement.lines)
+	}
+	paidNumbers := map[string]bool{}
+	for _, currInvoices := range invoices {
+		for _, invoice := range currInvoices {
+			if invoice.open < stockEpsilon {
+				paid = append(paid, IM{"id": invoice.id, "paid": true})
+				paidNumbers[invoice.transnumber] = true
+			}
+		}
+	}
+	for _, match := range result["matches"].([]IM) {
+		match["paid"] = paidNumbers[match["transnumber"].(string)]
+	}
+	if len(paid) > 0 {
+		if err = api.auditUpdate("trans", paid); err != nil {
+			return result, err
+		}
+	}
+	if dryRun {
+		for _, statement := range statements {
+			result["statements"] = append(result["statements"].([]IM), IM{"id": statement.id,
+				"account": statement.account, "rows": len(statement.lines)})
+		}
+		return result, nil
+	}
+
+	for si, statement := range statements {
+		transnumber := transnumbers[si]
+		transdate := statement.date
+		for _, line := range statement.lines {
+			if line.paiddate > transdate {
+				transdate = line.paiddate
+			}
+		}
+		if transdate == "" {
+			transdate = time.Now().Format(dateFmt)
+		}
+		values := IM{"transnumber": transnumber, "transtype": groups["transtype/bank"],
+			"direction": groups["direction/transfer"], "ref_transnumber": statement.id,
+			"crdate": time.Now().Format(dateFmt), "transdate": transdate, "place_id": place["id"],
+			"transtate": groups["transtate/ok"], "paid": 0, "closed": 0, "deleted": 0}
+		if api.NStore.User != nil {
+			values["cruser_id"] = api.NStore.User.Id
+		}
+		transID, err := api.NStore.transFromInsert("trans", values, trans)
+		if err != nil {
+			return result, err
+		}
+		for li, line := range statement.lines {
+			paymentID, err := api.NStore.transFromInsert("payment", IM{"trans_id": transID, "paiddate": line.paiddate,
+				"amount": line.amount, "notes": bankText(line.reference, line.name)}, trans)
+			if err != nil {
+				return result, err
+			}
+			for _, match := range matches[si][li] {
+				if _, err = api.NStore.transFromInsert("link", IM{
+					"nervatype_1": groups["nervatype/payment"], "ref_id_1": paymentID,
+					"nervatype_2": groups["nervatype/trans"], "ref_id_2": match.invoice.id,
+					"link_qty": match.amount, "link_rate": 1}, trans); err != nil {
+					return result, err
+				}
+			}
+		}
+		result["statements"] = append(result["statements"].([]IM), IM{"id": statement.id,
+			"account": statement.account, "rows": len(statement.lines), "trans_id": transID, "transnumber": transnumber})
+	}
+	for _, values := range paid {
+		if _, err = api.NStore.UpdateData(IM{"nervatype": "trans", "values": values, "trans": trans}); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
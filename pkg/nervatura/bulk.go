@@ -0,0 +1,492 @@
+package nervatura
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+const (
+	bulkFormatCSV   string = "csv"
+	bulkFormatJSONL string = "jsonl"
+	bulkKeyPrefix   string = "keys."
+)
+
+func bulkFormat(options IM) (string, error) {
+	format := ut.ToString(options["format"], bulkFormatCSV)
+	if format != bulkFormatCSV && format != bulkFormatJSONL {
+		return format, errors.New(ut.GetMessage("invalid_value") + "- format: " + format)
+	}
+	return format, nil
+}
+
+// bulkOwnKey - the key field of the nervatype (the table key or the "id" refnumber key)
+func (api *API) bulkOwnKey(nervatype string) string {
+	if tableKey := api.NStore.getTableKey(nervatype); tableKey != "" {
+		return tableKey
+	}
+	return "id"
+}
+
+// bulkFields - returns the value, key and metadata columns of the nervatype
+func (api *API) bulkFields(nervatype string, metadata bool) (values, keys, meta []string, err error) {
+	model := api.NStore.models[nervatype].(IM)
+	keys = []string{api.bulkOwnKey(nervatype)}
+	for _, fieldname := range model["_fields"].(SL) {
+		field := model[fieldname].(MF)
+		switch {
+		case fieldname == "id" || fieldname == "deleted":
+		case field.References != nil || strings.HasPrefix(fieldname, "ref_id"):
+			keys = append(keys, fieldname)
+		default:
+			values = append(values, fieldname)
+		}
+	}
+	if metadata {
+		rows, err := api.NStore.ds.Query([]Query{{
+			Fields: []string{"df.fieldname"}, From: "deffield df inner join groups nt on df.nervatype = nt.id",
+			Filters: []Filter{
+				{Field: "df.deleted", Comp: "==", Value: 0},
+				{Field: "nt.groupvalue", Comp: "==", Value: nervatype}},
+			OrderBy: []string{"df.id"}}}, nil)
+		if err != nil {
+			return values, keys, meta, err
+		}
+		for _, row := range rows {
+			meta = append(meta, ut.ToString(row["fieldname"], ""))
+		}
+	}
+	return values, keys, meta, nil
+}
+
+// bulkRefnumber - returns the public key of the record (with a cache of the previous results)
+func (api *API) bulkRefnumber(cache IM, nervatype string, id interface{}) (IM, error) {
+	cacheKey := nervatype + "/" + ut.ToString(id, "")
+	if info, found := cache[cacheKey].(IM); found {
+		return info, nil
+	}
+	info, err := api.NStore.GetRefnumber(IM{"nervatype": nervatype, "ref_id": id, "use_deleted": true})
+	if err != nil {
+		return info, err
+	}
+	cache[cacheKey] = info
+	return info, nil
+}
+
+// bulkKeyValue - the Update key value of a reference field
+func (api *API) bulkKeyValue(cache IM, nervatype, fieldname string, row IM) (interface{}, error) {
+	field := api.NStore.models[nervatype].(IM)[fieldname].(MF)
+	if field.References != nil {
+		if field.References[0] != "groups" {
+			info, err := api.bulkRefnumber(cache, field.References[0], row[fieldname])
+			if err != nil {
+				return nil, err
+			}
+			return info["refnumber"], nil
+		}
+		info, err := api.bulkRefnumber(cache, "groups", row[fieldname])
+		if err != nil {
+			return nil, err
+		}
+		groupname := fieldname
+		if fieldname == "nervatype_1" || fieldname == "nervatype_2" {
+			groupname = "nervatype"
+		}
+		if !strings.HasPrefix(ut.ToString(info["refnumber"], ""), groupname+"~") {
+			return nil, errors.New(ut.GetMessage("invalid_refnumber"))
+		}
+		return strings.TrimPrefix(ut.ToString(info["refnumber"], ""), groupname+"~"), nil
+	}
+
+	// ref_id, ref_id_1, ref_id_2: ref_nervatype/refnumber
+	var refType string
+	if typeField := strings.Replace(fieldname, "ref_id", "nervatype", 1); row[typeField] != nil {
+		info, err := api.bulkRefnumber(cache, "groups", row[typeField])
+		if err != nil {
+			return nil, err
+		}
+		refType = strings.TrimPrefix(ut.ToString(info["refnumber"], ""), "nervatype~")
+	} else {
+		info, err := api.bulkRefnumber(cache, nervatype, row["id"])
+		if err != nil {
+			return nil, err
+		}
+		refType = ut.ToString(info["headNervatype"], "")
+	}
+	info, err := api.bulkRefnumber(cache, refType, row[fieldname])
+	if err != nil {
+		return nil, err
+	}
+	return refType + "/" + ut.ToString(info["refnumber"], ""), nil
+}
+
+// bulkDate - the date field values are exported without the time part
+func bulkDate(value interface{}) interface{} {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(dateFmt)
+	case string:
+		if len(v) > len(dateFmt) {
+			if _, err := time.Parse(dateFmt, v[:len(dateFmt)]); err == nil {
+				return v[:len(dateFmt)]
+			}
+		}
+	}
+	return value
+}
+
+/*
+bulkRecord - convert a database row to an Update item (the references are replaced by key values).
+A not found reference returns an error, the internal id values are not valid in another database.
+*/
+func (api *API) bulkRecord(cache IM, nervatype string, values, keys []string, row IM) (IM, error) {
+	record := IM{}
+	recordKeys := IM{}
+	for _, fieldname := range values {
+		if row[fieldname] != nil {
+			record[fieldname] = row[fieldname]
+			if api.NStore.models[nervatype].(IM)[fieldname].(MF).Type == "date" {
+				record[fieldname] = bulkDate(row[fieldname])
+			}
+		}
+	}
+	for _, fieldname := range keys {
+		if fieldname == "id" {
+			info, err := api.bulkRefnumber(cache, nervatype, row["id"])
+			if err != nil {
+				return nil, bulkRecordError(nervatype, row, fieldname, err)
+			}
+			recordKeys["id"] = info["refnumber"]
+			continue
+		}
+		if row[fieldname] == nil {
+			continue
+		}
+		if _, found := record[fieldname]; found {
+			recordKeys[fieldname] = record[fieldname]
+			continue
+		}
+		value, err := api.bulkKeyValue(cache, nervatype, fieldname, row)
+		if err != nil {
+			return nil, bulkRecordError(nervatype, row, fieldname, err)
+		}
+		recordKeys[fieldname] = value
+	}
+	if metadata, found := row["metadata"].([]IM); found {
+		for _, mrow := range metadata {
+			value := ut.ToString(mrow["value"], "")
+			switch mrow["fieldtype"] {
+			case "customer", "tool", "product", "project", "employee", "place", "transitem", "transmovement", "transpayment":
+				refType := ut.ToString(mrow["fieldtype"], "")
+				if strings.HasPrefix(refType, "trans") {
+					refType = "trans"
+				}
+				info, err := api.bulkRefnumber(cache, refType, ut.ToInteger(value, 0))
+				if err != nil {
+					return nil, bulkRecordError(nervatype, row, ut.ToString(mrow["fieldname"], ""), err)
+				}
+				value = ut.ToString(info["refnumber"], value)
+			}
+			if notes := ut.ToString(mrow["notes"], ""); notes != "" {
+				value += "~" + notes
+			}
+			record[ut.ToString(mrow["fieldname"], "")] = value
+		}
+	}
+	record["keys"] = recordKeys
+	return record, nil
+}
+
+// bulkRecordError - the reference error of an exported record field
+func bulkRecordError(nervatype string, row IM, fieldname string, err error) error {
+	return errors.New(nervatype + " " + ut.ToString(row["id"], "") + " " + fieldname + ": " + err.Error())
+}
+
+/*
+Export - write the records of a nervatype to CSV or JSON Lines format
+
+The reference field values are replaced by public keys, so the exported file can be imported to another database.
+The export stops with an error, if a reference of a record is not found.
+Options:
+
+  nervatype - required
+  format - csv (default) or jsonl
+  filter, ids - optional Get filter. Default value: all records
+  metadata - export the metadata (deffield) values. Default value: false
+  batch - the number of the records per database query. Default value: 1000
+
+Example:
+
+  file, err := os.Create("products.csv")
+  rows, err := api.Export(map[string]interface{}{"nervatype": "product", "metadata": true}, file)
+
+*/
+func (api *API) Export(options IM, writer io.Writer) (rows int64, err error) {
+	nervatype := ut.ToString(options["nervatype"], "")
+	if _, found := api.NStore.models[nervatype]; !found {
+		return rows, errors.New(ut.GetMessage("invalid_nervatype") + " " + nervatype)
+	}
+	if err = api.auditBulk(nervatype, "read"); err != nil {
+		return rows, err
+	}
+	format, err := bulkFormat(options)
+	if err != nil {
+		return rows, err
+	}
+	metadata := ut.ToBoolean(options["metadata"], false)
+	values, keys, meta, err := api.bulkFields(nervatype, metadata)
+	if err != nil {
+		return rows, err
+	}
+
+	var csvWriter *csv.Writer
+	if format == bulkFormatCSV {
+		csvWriter = csv.NewWriter(writer)
+		header := append([]string{}, values...)
+		for _, fieldname := range keys {
+			header = append(header, bulkKeyPrefix+fieldname)
+		}
+		if err = csvWriter.Write(append(header, meta...)); err != nil {
+			return rows, err
+		}
+	}
+
+	params := IM{"nervatype": nervatype, "metadata": metadata, "cursor": 0,
+		"limit": ut.ToInteger(options["batch"], 1000)}
+	if params["limit"].(int64) <= 0 {
+		params["limit"] = int64(1000)
+	}
+	if ut.ToString(options["ids"], "") != "" {
+		params["ids"] = options["ids"]
+	} else if filter, found := options["filter"]; found && filter != nil && filter != "" {
+		params["filter"] = filter
+	} else {
+		// all records
+		params["filter"] = "id;>;0"
+	}
+
+	cache := IM{}
+	for {
+		results, err := api.Get(params)
+		if err != nil || len(results) == 0 {
+			if csvWriter != nil && err == nil {
+				csvWriter.Flush()
+				err = csvWriter.Error()
+			}
+			return rows, err
+		}
+		for _, row := range results {
+			record, err := api.bulkRecord(cache, nervatype, values, keys, row)
+			if err != nil {
+				return rows, err
+			}
+			if format == bulkFormatJSONL {
+				line, err := ut.ConvertToByte(record)
+				if err != nil {
+					return rows, err
+				}
+				if _, err = writer.Write(append(line, '\n')); err != nil {
+					return rows, err
+				}
+			} else {
+				line := []string{}
+				for _, fieldname := range values {
+					line = append(line, ut.ToString(record[fieldname], ""))
+				}
+				for _, fieldname := range keys {
+					line = append(line, ut.ToString(record["keys"].(IM)[fieldname], ""))
+				}
+				for _, fieldname := range meta {
+					line = append(line, ut.ToString(record[fieldname], ""))
+				}
+				if err = csvWriter.Write(line); err != nil {
+					return rows, err
+				}
+			}
+			rows++
+		}
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err = csvWriter.Error(); err != nil {
+				return rows, err
+			}
+		}
+		params["cursor"] = results[len(results)-1]["id"]
+	}
+}
+
+// bulkReadRows - returns the Update items of a CSV or JSON Lines file (and the row errors)
+func bulkReadRows(format string, reader io.Reader) (rows []IM, rowErrors []IM, err error) {
+	if format == bulkFormatJSONL {
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		for index := 1; scanner.Scan(); index++ {
+			line := strings.TrimSpace(scanner.Text())
+			row := IM{}
+			if line != "" {
+				if err := ut.ConvertFromByte([]byte(line), &row); err != nil {
+					rowErrors = append(rowErrors, IM{"row": index, "message": ut.GetMessage("invalid_json") + ": " + err.Error()})
+				}
+			}
+			rows = append(rows, row)
+		}
+		return rows, rowErrors, scanner.Err()
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	header, err := csvReader.Read()
+	if err != nil {
+		return rows, rowErrors, err
+	}
+	for index := 1; ; index++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		row := IM{}
+		if err != nil {
+			rowErrors = append(rowErrors, IM{"row": index, "message": err.Error()})
+		} else {
+			keys := IM{}
+			for col := 0; col < len(header) && col < len(record); col++ {
+				if record[col] == "" {
+					continue
+				}
+				if strings.HasPrefix(header[col], bulkKeyPrefix) {
+					keys[strings.TrimPrefix(header[col], bulkKeyPrefix)] = record[col]
+				} else {
+					row[header[col]] = record[col]
+				}
+			}
+			if len(keys) > 0 {
+				row["keys"] = keys
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, rowErrors, nil
+}
+
+// bulkCopyRow - the Update changes the item values (key resolution)
+func bulkCopyRow(row IM) IM {
+	result := IM{}
+	for key, value := range row {
+		if keys, valid := value.(IM); valid && key == "keys" {
+			value = IM{}
+			for kkey, kvalue := range keys {
+				value.(IM)[kkey] = kvalue
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+/*
+Import - add or update the records of a CSV or JSON Lines file (see Export)
+
+The rows are saved by the Update function in batches. If a batch is failed, the rows of the batch are saved one by one,
+and the errors are returned in the result report. In dry run mode all changes are rolled back.
+If the key of a row is not found in the database, it creates a new item.
+Options:
+
+  nervatype - required
+  format - csv (default) or jsonl
+  batch - the number of the rows per transaction. Default value: 500
+  dry_run - validate the rows without saving (the database driver needs transaction support). Default value: false
+
+Example:
+
+  file, err := os.Open("products.csv")
+  result, err := api.Import(map[string]interface{}{"nervatype": "product", "dry_run": true}, file)
+
+*/
+func (api *API) Import(options IM, reader io.Reader) (result IM, err error) {
+	result = IM{"rows": 0, "success": 0, "failed": 0, "errors": []IM{}}
+	nervatype := ut.ToString(options["nervatype"], "")
+	if _, found := api.NStore.models[nervatype]; !found {
+		return result, errors.New(ut.GetMessage("invalid_nervatype") + " " + nervatype)
+	}
+	if err = api.auditBulk(nervatype, "update"); err != nil {
+		return result, err
+	}
+	format, err := bulkFormat(options)
+	if err != nil {
+		return result, err
+	}
+	batch := int(ut.ToInteger(options["batch"], 500))
+	if batch <= 0 {
+		batch = 500
+	}
+	dryRun := ut.ToBoolean(options["dry_run"], false)
+	result["dry_run"] = dryRun
+	if dryRun && !api.NStore.ds.Properties().Transaction {
+		return result, errors.New(ut.GetMessage("dry_run_transaction"))
+	}
+
+	rows, rowErrors, err := bulkReadRows(format, reader)
+	if err != nil {
+		return result, err
+	}
+	result["rows"] = len(rows)
+	invalid := map[int]bool{}
+	for _, rowError := range rowErrors {
+		invalid[rowError["row"].(int)] = true
+	}
+	addError := func(index int, message string) {
+		result["failed"] = result["failed"].(int) + 1
+		result["errors"] = append(result["errors"].([]IM), IM{"row": index + 1, "message": message})
+	}
+
+	// new items: the key of the row is not found
+	ownKey := api.bulkOwnKey(nervatype)
+	for index, row := range rows {
+		if keys, found := row["keys"].(IM); found && keys[ownKey] != nil {
+			if _, err := api.NStore.GetInfofromRefnumber(IM{"nervatype": nervatype, "refnumber": keys[ownKey]}); err != nil {
+				delete(rows[index]["keys"].(IM), ownKey)
+			}
+		}
+	}
+
+	for start := 0; start < len(rows); start += batch {
+		items := []IM{}
+		indexes := []int{}
+		for index := start; index < start+batch && index < len(rows); index++ {
+			if invalid[index+1] {
+				continue
+			}
+			items = append(items, rows[index])
+			indexes = append(indexes, index)
+		}
+		if len(items) == 0 {
+			continue
+		}
+		if !dryRun {
+			data := []IM{}
+			for _, item := range items {
+				data = append(data, bulkCopyRow(item))
+			}
+			if _, err := api.updateItems(nervatype, data, false); err == nil {
+				result["success"] = result["success"].(int) + len(items)
+				continue
+			}
+		}
+		for ii, item := range items {
+			if _, err := api.updateItems(nervatype, []IM{bulkCopyRow(item)}, dryRun); err != nil {
+				addError(indexes[ii], err.Error())
+			} else {
+				result["success"] = result["success"].(int) + 1
+			}
+		}
+	}
+	for _, rowError := range rowErrors {
+		addError(rowError["row"].(int)-1, ut.ToString(rowError["message"], ""))
+	}
+	return result, nil
+}
@@ -0,0 +1,263 @@
+package nervatura
+
+import (
+	"errors"
+	"math"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+/*
+calcItemAmounts - derive the netamount, vatamount and amount values of an item (rounded to the currency digits).
+The net mode calculates the amounts from the (net) fxprice, discount and qty values. In the gross mode
+the amount is the gross (VAT-inclusive) value of the row, and the netamount, vatamount and (net) fxprice
+values are calculated from the amount, discount and qty values.
+*/
+func calcItemAmounts(values IM, rate float64, digit int, calc string) error {
+	qty := ut.ToFloat(values["qty"], 0)
+	discount := 1 - ut.ToFloat(values["discount"], 0)/100
+	switch calc {
+	case "net":
+		netamount := roundFloat(ut.ToFloat(values["fxprice"], 0)*discount*qty, digit)
+		vatamount := roundFloat(netamount*rate, digit)
+		values["netamount"] = netamount
+		values["vatamount"] = vatamount
+		values["amount"] = roundFloat(netamount+vatamount, digit)
+	case "gross":
+		if _, found := values["amount"]; !found {
+			return errors.New(ut.GetMessage("missing_required_field") + ": amount")
+		}
+		amount := roundFloat(ut.ToFloat(values["amount"], 0), digit)
+		netamount := roundFloat(amount/(1+rate), digit)
+		values["netamount"] = netamount
+		values["vatamount"] = roundFloat(amount-netamount, digit)
+		values["amount"] = amount
+		if qty*discount != 0 {
+			values["fxprice"] = roundFloat(netamount/(qty*discount), digit+2)
+		}
+	default:
+		return errors.New(ut.GetMessage("invalid_value") + ": calc")
+	}
+	return nil
+}
+
+// calcCashRound - round a document total to the currency cround value (e.g. the smallest banknote)
+func calcCashRound(amount float64, cround int64, digit int) float64 {
+	if cround <= 0 {
+		return roundFloat(amount, digit)
+	}
+	return math.Round(amount/float64(cround)) * float64(cround)
+}
+
+// calcItemRate - the tax rate and the document currency digits of an item
+func (nstore *NervaStore) calcItemRate(transID, taxID interface{}, trans interface{}) (rate float64, digit int, err error) {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"rate"}, From: "tax",
+		Filters: []Filter{{Field: "id", Comp: "==", Value: taxID}}}}, trans)
+	if err != nil {
+		return rate, digit, err
+	}
+	if len(rows) == 0 {
+		return rate, digit, errors.New(ut.GetMessage("invalid_value") + ": tax_id")
+	}
+	rate = ut.ToFloat(rows[0]["rate"], 0)
+	rows, err = nstore.ds.Query([]Query{{
+		Fields: []string{"cur.digit"}, From: "trans t inner join currency cur on t.curr = cur.curr",
+		Filters: []Filter{{Field: "t.id", Comp: "==", Value: transID}}}}, trans)
+	if err != nil {
+		return rate, digit, err
+	}
+	digit = 2
+	if len(rows) > 0 {
+		digit = int(ut.ToInteger(rows[0]["digit"], 2))
+	}
+	return rate, digit, nil
+}
+
+/*
+calcItem - the recalculation mode of the item update. The calc (net or gross) value of the row
+sets the calculated amounts, the missing qty, fxprice, discount, tax_id and amount values of an
+existing item are taken from the database row.
+*/
+func (nstore *NervaStore) calcItem(values IM, trans interface{}) error {
+	calc := ut.ToString(values["calc"], "")
+	delete(values, "calc")
+	if calc == "" {
+		return nil
+	}
+	row := IM{}
+	if id := ut.ToInteger(values["id"], 0); id > 0 {
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"trans_id", "qty", "fxprice", "discount", "tax_id", "amount"}, From: "item",
+			Filters: []Filter{{Field: "id", Comp: "==", Value: id}}}}, trans)
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			row = rows[0]
+		}
+	}
+	for fieldname, value := range values {
+		row[fieldname] = value
+	}
+	rate, digit, err := nstore.calcItemRate(row["trans_id"], row["tax_id"], trans)
+	if err != nil {
+		return err
+	}
+	if err = calcItemAmounts(row, rate, digit, calc); err != nil {
+		return err
+	}
+	for _, fieldname := range []string{"fxprice", "netamount", "vatamount", "amount"} {
+		values[fieldname] = row[fieldname]
+	}
+	return nil
+}
+
+// calcItemValues - the calcItemAmounts server function: the amounts of an item row without saving
+func (nstore *NervaStore) calcItemValues(options IM) (result IM, err error) {
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return result, err
+		}
+		return result, errors.New(ut.GetMessage("not_connect"))
+	}
+	rate, digit := ut.ToFloat(options["rate"], 0), 2
+	if taxcode := ut.ToString(options["taxcode"], ""); taxcode != "" {
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"rate"}, From: "tax",
+			Filters: []Filter{{Field: "taxcode", Comp: "==", Value: taxcode}}}}, nil)
+		if err != nil {
+			return result, err
+		}
+		if len(rows) == 0 {
+			return result, errors.New(ut.GetMessage("invalid_value") + ": taxcode")
+		}
+		rate = ut.ToFloat(rows[0]["rate"], 0)
+	}
+	if curr := ut.ToString(options["curr"], ""); curr != "" {
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"digit"}, From: "currency",
+			Filters: []Filter{{Field: "curr", Comp: "==", Value: curr}}}}, nil)
+		if err != nil {
+			return result, err
+		}
+		if len(rows) == 0 {
+			return result, errors.New(ut.GetMessage("invalid_value") + ": curr")
+		}
+		digit = int(ut.ToInteger(rows[0]["digit"], 2))
+	}
+	result = IM{"qty": ut.ToFloat(options["qty"], 0), "fxprice": ut.ToFloat(options["fxprice"], 0),
+		"discount": ut.ToFloat(options["discount"], 0)}
+	if _, found := options["amount"]; found {
+		result["amount"] = ut.ToFloat(options["amount"], 0)
+	}
+	err = calcItemAmounts(result, rate, digit, ut.ToString(options["calc"], "net"))
+	return result, err
+}
+
+/*
+checkTransAmounts - compare the stored item amounts of a document with the calculated values.
+The calc option is the pricing mode of the items: the net mode (default) calculates the amounts
+from the fxprice, discount and qty values, the gross mode from the stored (gross) amount values.
+Returns the stored and calculated document totals, the cash rounded total (currency cround value)
+and the items with different amounts.
+*/
+func (nstore *NervaStore) checkTransAmounts(options IM) (result IM, err error) {
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return result, err
+		}
+		return result, errors.New(ut.GetMessage("not_connect"))
+	}
+	calc := ut.ToString(options["calc"], "net")
+	if calc != "net" && calc != "gross" {
+		return result, errors.New(ut.GetMessage("invalid_value") + ": calc")
+	}
+	source, err := nstore.transFromSource(options, options["trans"])
+	if err != nil {
+		return result, err
+	}
+	items, err := nstore.ds.Query([]Query{{
+		Fields: []string{"i.id", "i.qty", "i.fxprice", "i.discount", "i.netamount", "i.vatamount", "i.amount", "tx.rate"},
+		From:   "item i inner join tax tx on i.tax_id = tx.id",
+		Filters: []Filter{
+			{Field: "i.trans_id", Comp: "==", Value: source["id"]},
+			{Field: "i.deleted", Comp: "==", Value: 0}},
+		OrderBy: []string{"i.id"}}}, options["trans"])
+	if err != nil {
+		return result, err
+	}
+	digit := int(ut.ToInteger(source["digit"], 2))
+	cround := int64(0)
+	if source["curr"] != nil {
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"cround"}, From: "currency",
+			Filters: []Filter{{Field: "curr", Comp: "==", Value: source["curr"]}}}}, options["trans"])
+		if err != nil {
+			return result, err
+		}
+		if len(rows) > 0 {
+			cround = ut.ToInteger(rows[0]["cround"], 0)
+		}
+	}
+
+	result = IM{"transnumber": source["transnumber"], "curr": source["curr"], "items": []IM{}}
+	totals := map[string]float64{}
+	for _, item := range items {
+		values := IM{"qty": item["qty"], "fxprice": item["fxprice"], "discount": item["discount"], "amount": item["amount"]}
+		if err = calcItemAmounts(values, ut.ToFloat(item["rate"], 0), digit, calc); err != nil {
+			return result, err
+		}
+		diff := false
+		for _, fieldname := range []string{"netamount", "vatamount", "amount"} {
+			totals[fieldname] += ut.ToFloat(item[fieldname], 0)
+			totals["calc_"+fieldname] += values[fieldname].(float64)
+			diff = diff || roundFloat(ut.ToFloat(item[fieldname], 0), digit) != values[fieldname].(float64)
+		}
+		if diff {
+			result["items"] = append(result["items"].([]IM), IM{"id": item["id"],
+				"netamount": item["netamount"], "vatamount": item["vatamount"], "amount": item["amount"],
+				"calc_netamount": values["netamount"], "calc_vatamount": values["vatamount"], "calc_amount": values["amount"]})
+		}
+	}
+	for _, fieldname := range []string{"netamount", "vatamount", "amount", "calc_netamount", "calc_vatamount", "calc_amount"} {
+		result[fieldname] = roundFloat(totals[fieldname], digit)
+	}
+	result["cash_amount"] = calcCashRound(totals["calc_amount"], cround, digit)
+	result["valid"] = len(result["items"].([]IM)) == 0
+	return result, nil
+}
+
+func init() {
+	functions := []ServerFunction{
+		{Name: "calcItemAmounts",
+			Description: "Calculate the netamount, vatamount and amount values of an item row (net or gross pricing)",
+			Args: []FunctionArg{
+				{Name: "qty", Type: "number", Required: true, Description: "Quantity"},
+				{Name: "fxprice", Type: "number", Description: "Net unit price (calc: net)"},
+				{Name: "amount", Type: "number", Description: "Gross row amount (calc: gross)"},
+				{Name: "discount", Type: "number", Description: "Discount percent"},
+				{Name: "taxcode", Type: "string", Description: "Tax code"},
+				{Name: "rate", Type: "number", Description: "Tax rate (without taxcode)"},
+				{Name: "curr", Type: "string", Description: "Currency code of the rounding (default: 2 digits)"},
+				{Name: "calc", Type: "string", Description: "net or gross (default: net)"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.calcItemValues(options)
+			}},
+		{Name: "checkTransAmounts",
+			Description: "Compare the item amounts and the totals of a document with the calculated values",
+			Args: []FunctionArg{
+				{Name: "transnumber", Type: "string", Description: "Document number"},
+				{Name: "trans_id", Type: "integer", Description: "Document ID"},
+				{Name: "calc", Type: "string", Description: "Pricing mode of the items: net or gross (default: net)"}},
+			Audit: []FunctionAudit{{Nervatype: "trans", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.checkTransAmounts(options)
+			}},
+	}
+	for _, fn := range functions {
+		if err := RegisterFunction(fn); err != nil {
+			panic(err)
+		}
+	}
+}
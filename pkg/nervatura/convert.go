@@ -0,0 +1,723 @@
+package nervatura
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// transItemTypes - the document types with item rows
+var transItemTypes = SL{"offer", "order", "worksheet", "rent", "invoice", "receipt"}
+
+// transPaymentTypes - the document types with payment rows
+var transPaymentTypes = SL{"bank", "cash"}
+
+// transMovementTypes - the document types with movement rows
+var transMovementTypes = SL{"delivery", "inventory", "waybill", "production", "formula"}
+
+// settingValue - a database setting (fieldvalue row without ref_id)
+func (nstore *NervaStore) settingValue(fieldname string, trans interface{}) (string, error) {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"value"}, From: "fieldvalue", Filters: []Filter{
+			{Field: "fieldname", Comp: "==", Value: fieldname},
+			{Field: "ref_id", Comp: "is", Value: "null"},
+			{Field: "deleted", Comp: "==", Value: 0}}}}, trans)
+	if err != nil || len(rows) == 0 {
+		return "", err
+	}
+	return ut.ToString(rows[0]["value"], ""), nil
+}
+
+// groupIDs - the IDs of the groups values by groupname/groupvalue keys (e.g. "transtype/invoice")
+func (nstore *NervaStore) groupIDs(groupnames SL, trans interface{}) (map[string]int64, error) {
+	ids := map[string]int64{}
+	values := IL{}
+	for _, groupname := range groupnames {
+		values = append(values, groupname)
+	}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"id", "groupname", "groupvalue"}, From: "groups", Filters: []Filter{
+			{Field: "groupname", Comp: "in", Value: values},
+			{Field: "deleted", Comp: "==", Value: 0}}}}, trans)
+	if err != nil {
+		return ids, err
+	}
+	for _, row := range rows {
+		ids[ut.ToString(row["groupname"], "")+"/"+ut.ToString(row["groupvalue"], "")] = ut.ToInteger(row["id"], 0)
+	}
+	return ids, nil
+}
+
+// transCustInvoice - the company and customer name, address and tax number values of an invoice
+func (nstore *NervaStore) transCustInvoice(customerID interface{}, trans interface{}) (IM, error) {
+	values := IM{}
+	own, err := nstore.ds.Query([]Query{{
+		Fields: []string{"min(c.id) as id"}, From: "customer c inner join groups g on c.custtype = g.id",
+		Filters: []Filter{{Field: "g.groupvalue", Comp: "==", Value: "own"}}}}, trans)
+	if err != nil {
+		return values, err
+	}
+	for prefix, id := range (IM{"comp": own[0]["id"], "cust": customerID}) {
+		values["trans_custinvoice_"+prefix+"name"] = ""
+		values["trans_custinvoice_"+prefix+"tax"] = ""
+		values["trans_custinvoice_"+prefix+"address"] = ""
+		if id == nil {
+			continue
+		}
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"custname", "taxnumber"}, From: "customer",
+			Filters: []Filter{{Field: "id", Comp: "==", Value: id}}}}, trans)
+		if err != nil {
+			return values, err
+		}
+		if len(rows) > 0 {
+			values["trans_custinvoice_"+prefix+"name"] = ut.ToString(rows[0]["custname"], "")
+			values["trans_custinvoice_"+prefix+"tax"] = ut.ToString(rows[0]["taxnumber"], "")
+		}
+		rows, err = nstore.ds.Query([]Query{{
+			Fields: []string{"a.zipcode", "a.city", "a.street"},
+			From:   "address a inner join groups nt on a.nervatype = nt.id",
+			Filters: []Filter{
+				{Field: "nt.groupvalue", Comp: "==", Value: "customer"},
+				{Field: "a.ref_id", Comp: "==", Value: id},
+				{Field: "a.deleted", Comp: "==", Value: 0}},
+			OrderBy: []string{"a.id"}, Limit: 1}}, trans)
+		if err != nil {
+			return values, err
+		}
+		if len(rows) > 0 {
+			values["trans_custinvoice_"+prefix+"address"] = strings.TrimSpace(ut.ToString(rows[0]["zipcode"], "") + " " +
+				ut.ToString(rows[0]["city"], "") + " " + ut.ToString(rows[0]["street"], ""))
+		}
+	}
+	return values, nil
+}
+
+// transFromInsert - insert a new row of the created document
+func (nstore *NervaStore) transFromInsert(nervatype string, values IM, trans interface{}) (int64, error) {
+	return nstore.UpdateData(IM{"nervatype": nervatype, "values": values, "validate": true,
+		"insert_row": true, "insert_field": true, "trans": trans})
+}
+
+// transFromLink - link a new row (ref_id_1) to the source row (ref_id_2)
+func (nstore *NervaStore) transFromLink(groups map[string]int64, nervatype1 string, refID1 int64,
+	nervatype2 string, refID2 interface{}, trans interface{}) error {
+	_, err := nstore.transFromInsert("link", IM{
+		"nervatype_1": groups["nervatype/"+nervatype1], "ref_id_1": refID1,
+		"nervatype_2": groups["nervatype/"+nervatype2], "ref_id_2": refID2}, trans)
+	return err
+}
+
+// transFromSource - the source document with the currency digits and the trans_transcast value
+func (nstore *NervaStore) transFromSource(options IM, trans interface{}) (IM, error) {
+	filters := []Filter{}
+	if transnumber := ut.ToString(options["transnumber"], ""); transnumber != "" {
+		filters = append(filters, Filter{Field: "t.transnumber", Comp: "==", Value: transnumber})
+	} else if transID := ut.ToInteger(options["trans_id"], 0); transID > 0 {
+		filters = append(filters, Filter{Field: "t.id", Comp: "==", Value: transID})
+	} else {
+		return nil, errors.New(ut.GetMessage("missing_required_field") + ": transnumber")
+	}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"t.*", "tt.groupvalue as transtype_key", "dir.groupvalue as direction_key", "cur.digit",
+			"tc.value as transcast"},
+		From: `trans t inner join groups tt on t.transtype = tt.id inner join groups dir on t.direction = dir.id
+			left join currency cur on t.curr = cur.curr
+			left join fieldvalue tc on tc.ref_id = t.id and tc.fieldname = 'trans_transcast' and tc.deleted = 0`,
+		Filters: filters}}, trans)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New(ut.GetMessage("invalid_value") + ": transnumber")
+	}
+	return rows[0], nil
+}
+
+// transFromCheck - the conversion rules of the transtypes, directions and transcasts
+func (nstore *NervaStore) transFromCheck(source IM, transtype, direction, transcast string, trans interface{}) error {
+	sourceType := ut.ToString(source["transtype_key"], "")
+	if (transtype == "receipt" || transtype == "worksheet") && direction == "in" {
+		return errors.New(ut.GetMessage("invalid_value") + ": direction")
+	}
+	if ut.ToString(source["transcast"], "normal") == "cancellation" {
+		return errors.New(ut.GetMessage("trans_cancellation"))
+	}
+	switch transcast {
+	case "normal":
+		if ut.ToInteger(source["deleted"], 0) == 1 {
+			return errors.New(ut.GetMessage("trans_deleted"))
+		}
+	case "cancellation", "amendment":
+		if transtype != sourceType || direction != ut.ToString(source["direction_key"], "") {
+			return errors.New(ut.GetMessage("invalid_conversion") + ": " + transcast)
+		}
+		deleted := ut.ToInteger(source["deleted"], 0)
+		if transcast == "amendment" && deleted == 1 {
+			return errors.New(ut.GetMessage("trans_deleted"))
+		}
+		if transcast == "cancellation" && (transtype == "invoice" || transtype == "receipt") && deleted == 0 {
+			return errors.New(ut.GetMessage("trans_not_deleted"))
+		}
+		if transcast == "cancellation" {
+			rows, err := nstore.ds.Query([]Query{{
+				Fields: []string{"t.transnumber"},
+				From: `link l inner join groups nt1 on l.nervatype_1 = nt1.id inner join groups nt2 on l.nervatype_2 = nt2.id
+					inner join trans t on l.ref_id_1 = t.id
+					inner join fieldvalue tc on tc.ref_id = t.id and tc.fieldname = 'trans_transcast'`,
+				Filters: []Filter{
+					{Field: "l.deleted", Comp: "==", Value: 0},
+					{Field: "nt1.groupvalue", Comp: "==", Value: "trans"},
+					{Field: "nt2.groupvalue", Comp: "==", Value: "trans"},
+					{Field: "l.ref_id_2", Comp: "==", Value: source["id"]},
+					{Field: "tc.value", Comp: "==", Value: "cancellation"}}}}, trans)
+			if err != nil {
+				return err
+			}
+			if len(rows) > 0 {
+				return errors.New(ut.GetMessage("trans_cancelled") + ": " + ut.ToString(rows[0]["transnumber"], ""))
+			}
+		}
+	default:
+		return errors.New(ut.GetMessage("invalid_value") + ": transcast")
+	}
+	switch {
+	case ut.Contains(transItemTypes, sourceType) && ut.Contains(transItemTypes, transtype):
+	case ut.Contains(transItemTypes, sourceType) && transtype == "delivery" && direction != "transfer" &&
+		transcast == "normal":
+	case ut.Contains(transPaymentTypes, sourceType) && ut.Contains(transPaymentTypes, transtype):
+	case ut.Contains(transMovementTypes, sourceType) && transtype == sourceType:
+	default:
+		return errors.New(ut.GetMessage("invalid_conversion") + ": " + sourceType + " - " + transtype)
+	}
+	return nil
+}
+
+// transFromAudit - the read right of the source document and the insert right (all) of the new document transtype
+func (nstore *NervaStore) transFromAudit(source IM, transtypeID int64) error {
+	api := &API{NStore: nstore}
+	state, err := api.getAuditState()
+	if err != nil || state == nil {
+		return err
+	}
+	if err = api.auditRecord(state, "trans", source, "read"); err != nil {
+		return err
+	}
+	return api.auditRecord(state, "trans", IM{"id": 0, "transtype": transtypeID}, "insert")
+}
+
+// transFromHead - the trans values and the metadata (fieldvalue) of the new document
+func (nstore *NervaStore) transFromHead(source IM, groups map[string]int64, options IM, trans interface{}) (IM, error) {
+	transtype := ut.ToString(options["transtype"], "")
+	direction := ut.ToString(options["direction"], "")
+	transcast := ut.ToString(options["transcast"], "normal")
+	transdate := ut.ToString(options["transdate"], time.Now().Format(dateFmt))
+	if len(transdate) > len(dateFmt) {
+		transdate = transdate[:len(dateFmt)]
+	}
+	values := IM{
+		"transtype": groups["transtype/"+transtype], "direction": groups["direction/"+direction],
+		"ref_transnumber": source["ref_transnumber"], "crdate": time.Now().Format(dateFmt),
+		"transdate": transdate, "duedate": nil, "transtate": groups["transtate/ok"],
+		"paid": 0, "closed": 0, "deleted": 0, "trans_transcast": transcast}
+	for _, fieldname := range []string{"customer_id", "employee_id", "department", "project_id", "place_id",
+		"paidtype", "curr", "notax", "acrate", "notes", "intnotes", "fnote"} {
+		values[fieldname] = source[fieldname]
+	}
+	if nstore.User != nil {
+		values["cruser_id"] = nstore.User.Id
+	}
+	if source["duedate"] != nil {
+		values["duedate"] = transdate + "T00:00:00"
+	}
+	switch {
+	case transtype == "invoice" && direction == "out":
+		deadline, err := nstore.settingValue("default_deadline", trans)
+		if err != nil {
+			return values, err
+		}
+		date, _ := time.Parse(dateFmt, transdate)
+		values["duedate"] = date.AddDate(0, 0, int(ut.ToInteger(deadline, 0))).Format(dateFmt) + "T00:00:00"
+	case transtype == "receipt":
+		values["customer_id"] = nil
+	}
+	if !options["copy"].(bool) {
+		values["ref_transnumber"] = source["transnumber"]
+	}
+
+	numberkey := transtype + "_" + direction
+	if transtype == "waybill" || transtype == "cash" {
+		numberkey = transtype
+	}
+	transnumber, err := nstore.nextNumber(IM{"numberkey": numberkey, "step": true, "trans": trans})
+	if err != nil {
+		return values, err
+	}
+	switch transcast {
+	case "cancellation":
+		values["transnumber"] = transnumber + "/C"
+		if transtype != "delivery" && transtype != "inventory" {
+			values["deleted"] = 1
+		}
+		values["transdate"] = bulkDate(source["transdate"])
+		values["duedate"] = source["duedate"]
+	case "amendment":
+		values["transnumber"] = transnumber + "/A"
+	default:
+		values["transnumber"] = transnumber
+	}
+
+	// the visible metadata of the source (all values of a copy)
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"fv.fieldname", "fv.value", "fv.notes", "df.visible", "df.subtype"},
+		From: `fieldvalue fv inner join deffield df on fv.fieldname = df.fieldname
+			inner join groups nt on df.nervatype = nt.id`,
+		Filters: []Filter{
+			{Field: "nt.groupvalue", Comp: "==", Value: "trans"},
+			{Field: "fv.ref_id", Comp: "==", Value: source["id"]},
+			{Field: "fv.deleted", Comp: "==", Value: 0},
+			{Field: "fv.fieldname", Comp: "!=", Value: "trans_transcast"}},
+		OrderBy: []string{"fv.id"}}}, trans)
+	if err != nil {
+		return values, err
+	}
+	index := map[string]int{}
+	for _, row := range rows {
+		if (row["subtype"] != nil && ut.ToInteger(row["subtype"], 0) != groups["transtype/"+transtype]) ||
+			(ut.ToInteger(row["visible"], 0) == 0 && !options["copy"].(bool)) {
+			continue
+		}
+		fieldname := ut.ToString(row["fieldname"], "")
+		index[fieldname]++
+		value := ut.ToString(row["value"], "")
+		if notes := ut.ToString(row["notes"], ""); notes != "" {
+			value += "~" + notes
+		}
+		if index[fieldname] > 1 {
+			fieldname += "~" + ut.ToString(index[fieldname], "")
+		}
+		values[fieldname] = value
+	}
+	if transtype == "invoice" {
+		info, err := nstore.transCustInvoice(values["customer_id"], trans)
+		if err != nil {
+			return values, err
+		}
+		for fieldname, value := range info {
+			values[fieldname] = value
+		}
+	}
+	return values, nil
+}
+
+// transFromItemQty - the invoiced quantities of the products and the shipped quantities of the items
+func (nstore *NervaStore) transFromItemQty(source IM, trans interface{}) (invoiced, shipped map[int64]float64, err error) {
+	invoiced, shipped = map[int64]float64{}, map[int64]float64{}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"i.product_id", "i.qty"},
+		From: `link l inner join groups nt1 on l.nervatype_1 = nt1.id inner join groups nt2 on l.nervatype_2 = nt2.id
+			inner join trans t on l.ref_id_1 = t.id inner join groups tt on t.transtype = tt.id
+			inner join item i on i.trans_id = t.id`,
+		Filters: []Filter{
+			{Field: "l.deleted", Comp: "==", Value: 0},
+			{Field: "nt1.groupvalue", Comp: "==", Value: "trans"},
+			{Field: "nt2.groupvalue", Comp: "==", Value: "trans"},
+			{Field: "l.ref_id_2", Comp: "==", Value: source["id"]},
+			{Field: "t.deleted", Comp: "==", Value: 0},
+			{Field: "tt.groupvalue", Comp: "in", Value: IL{"invoice", "receipt"}},
+			{Field: "i.deleted", Comp: "==", Value: 0},
+			{Field: "i.deposit", Comp: "==", Value: 0}}}}, trans)
+	if err != nil {
+		return invoiced, shipped, err
+	}
+	for _, row := range rows {
+		invoiced[ut.ToInteger(row["product_id"], 0)] += ut.ToFloat(row["qty"], 0)
+	}
+
+	rows, err = nstore.ds.Query([]Query{{
+		Fields: []string{"l.ref_id_2 as item_id", "m.qty"},
+		From: `link l inner join groups nt1 on l.nervatype_1 = nt1.id inner join groups nt2 on l.nervatype_2 = nt2.id
+			inner join movement m on l.ref_id_1 = m.id inner join trans t on m.trans_id = t.id
+			inner join item i on l.ref_id_2 = i.id`,
+		Filters: []Filter{
+			{Field: "l.deleted", Comp: "==", Value: 0},
+			{Field: "nt1.groupvalue", Comp: "==", Value: "movement"},
+			{Field: "nt2.groupvalue", Comp: "==", Value: "item"},
+			{Field: "i.trans_id", Comp: "==", Value: source["id"]},
+			{Field: "m.deleted", Comp: "==", Value: 0},
+			{Field: "t.deleted", Comp: "==", Value: 0}}}}, trans)
+	if err != nil {
+		return invoiced, shipped, err
+	}
+	sign := float64(1)
+	if source["direction_key"] == "out" {
+		sign = -1
+	}
+	for _, row := range rows {
+		shipped[ut.ToInteger(row["item_id"], 0)] += sign * ut.ToFloat(row["qty"], 0)
+	}
+	return invoiced, shipped, nil
+}
+
+/*
+transFromItems - the item rows of the new document or the shipping (delivery) movements of the source items.
+The quantities: the items option (partial quantities), the shipped (from_inventory) or the not invoiced (netto)
+quantities of the invoice and receipt, the not shipped quantities of the delivery or the source quantities.
+*/
+func (nstore *NervaStore) transFromItems(source IM, transID int64, groups map[string]int64, options IM,
+	trans interface{}) error {
+	transtype := ut.ToString(options["transtype"], "")
+	transcast := ut.ToString(options["transcast"], "normal")
+	items, err := nstore.ds.Query([]Query{{
+		Fields: []string{"i.*", "tx.rate", "pt.groupvalue as protype"},
+		From: `item i inner join tax tx on i.tax_id = tx.id inner join product p on i.product_id = p.id
+			inner join groups pt on p.protype = pt.id`,
+		Filters: []Filter{
+			{Field: "i.trans_id", Comp: "==", Value: source["id"]},
+			{Field: "i.deleted", Comp: "==", Value: 0}},
+		OrderBy: []string{"i.id"}}}, trans)
+	if err != nil {
+		return err
+	}
+	invoiced, shipped, err := nstore.transFromItemQty(source, trans)
+	if err != nil {
+		return err
+	}
+
+	qty := map[int64]float64{}
+	if list, found := options["items"].([]interface{}); found && len(list) > 0 {
+		for _, row := range list {
+			values, valid := row.(IM)
+			itemID := ut.ToInteger(values["id"], 0)
+			found := false
+			for _, item := range items {
+				found = found || (ut.ToInteger(item["id"], 0) == itemID)
+			}
+			if !valid || !found {
+				return errors.New(ut.GetMessage("invalid_value") + ": items")
+			}
+			qty[itemID] = ut.ToFloat(values["qty"], 0)
+		}
+	} else {
+		invoicedProduct := map[int64]bool{}
+		for _, item := range items {
+			itemID, productID := ut.ToInteger(item["id"], 0), ut.ToInteger(item["product_id"], 0)
+			qty[itemID] = ut.ToFloat(item["qty"], 0)
+			switch {
+			case transtype == "delivery":
+				qty[itemID] -= shipped[itemID]
+			case (transtype == "invoice" || transtype == "receipt") &&
+				(ut.ToBoolean(options["from_inventory"], false) || ut.ToBoolean(options["netto"], false)):
+				if ut.ToBoolean(options["from_inventory"], false) {
+					qty[itemID] = shipped[itemID]
+				}
+				if !invoicedProduct[productID] && ut.ToInteger(item["deposit"], 0) == 0 {
+					qty[itemID] -= invoiced[productID]
+					invoicedProduct[productID] = true
+				}
+			}
+			// the overshipped or overinvoiced items
+			if qty[itemID]*ut.ToFloat(item["qty"], 0) < 0 {
+				qty[itemID] = 0
+			}
+		}
+	}
+
+	if transtype == "delivery" {
+		return nstore.transFromShipping(source, transID, items, qty, groups, options, trans)
+	}
+	digit := int(ut.ToInteger(source["digit"], 2))
+	for _, item := range items {
+		itemID := ut.ToInteger(item["id"], 0)
+		if roundFloat(qty[itemID], 4) == 0 {
+			continue
+		}
+		values := IM{"trans_id": transID, "qty": qty[itemID], "ownstock": 0}
+		for _, fieldname := range []string{"product_id", "unit", "fxprice", "discount", "tax_id", "description",
+			"deposit", "actionprice"} {
+			values[fieldname] = item[fieldname]
+		}
+		if transtype != "invoice" && transtype != "receipt" {
+			values["deposit"] = 0
+		}
+		if transcast == "cancellation" {
+			values["qty"] = -qty[itemID]
+		}
+		rows := []IM{values}
+		if transcast == "amendment" {
+			reverse := IM{}
+			for fieldname, value := range values {
+				reverse[fieldname] = value
+			}
+			reverse["qty"] = -qty[itemID]
+			rows = append(rows, reverse)
+		}
+		for _, row := range rows {
+			if err = calcItemAmounts(row, ut.ToFloat(item["rate"], 0), digit, "net"); err != nil {
+				return err
+			}
+			if _, err = nstore.transFromInsert("item", row, trans); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// transFromShipping - the delivery movements of the source items (product type: item) with the movement-item links
+func (nstore *NervaStore) transFromShipping(source IM, transID int64, items []IM, qty map[int64]float64,
+	groups map[string]int64, options IM, trans interface{}) error {
+	planumber := ut.ToString(options["planumber"], "")
+	placeID := source["place_id"]
+	if planumber == "" && placeID == nil {
+		value, err := nstore.settingValue("default_warehouse", trans)
+		if err != nil {
+			return err
+		}
+		planumber = value
+	}
+	if planumber != "" {
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"id"}, From: "place", Filters: []Filter{
+				{Field: "planumber", Comp: "==", Value: planumber},
+				{Field: "deleted", Comp: "==", Value: 0}}}}, trans)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return errors.New(ut.GetMessage("invalid_value") + ": planumber")
+		}
+		placeID = rows[0]["id"]
+	}
+	sign := float64(1)
+	if ut.ToString(options["direction"], "") == "out" {
+		sign = -1
+	}
+	shippingdate := ut.ToString(options["transdate"], time.Now().Format(dateFmt))
+	if len(shippingdate) > len(dateFmt) {
+		shippingdate = shippingdate[:len(dateFmt)]
+	}
+	for _, item := range items {
+		itemID := ut.ToInteger(item["id"], 0)
+		if item["protype"] != "item" || roundFloat(qty[itemID], 4) == 0 {
+			continue
+		}
+		id, err := nstore.transFromInsert("movement", IM{
+			"trans_id": transID, "shippingdate": shippingdate + "T00:00:00", "movetype": groups["movetype/inventory"],
+			"product_id": item["product_id"], "place_id": placeID, "qty": sign * qty[itemID]}, trans)
+		if err != nil {
+			return err
+		}
+		if err = nstore.transFromLink(groups, "movement", id, "item", itemID, trans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transFromPayments - the payment rows of the new bank or cash document
+func (nstore *NervaStore) transFromPayments(source IM, transID int64, options IM, trans interface{}) error {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"paiddate", "amount", "notes"}, From: "payment", Filters: []Filter{
+			{Field: "trans_id", Comp: "==", Value: source["id"]},
+			{Field: "deleted", Comp: "==", Value: 0}},
+		OrderBy: []string{"id"}}}, trans)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		row["trans_id"] = transID
+		row["paiddate"] = bulkDate(row["paiddate"])
+		if options["transcast"] == "cancellation" {
+			row["amount"] = -ut.ToFloat(row["amount"], 0)
+		}
+		if _, err = nstore.transFromInsert("payment", row, trans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transFromMovements - the movement rows of the new document with the movement-item and movement-movement links
+func (nstore *NervaStore) transFromMovements(source IM, transID int64, groups map[string]int64, options IM,
+	trans interface{}) error {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"id", "shippingdate", "movetype", "product_id", "tool_id", "place_id", "qty", "notes", "shared"},
+		From:   "movement", Filters: []Filter{
+			{Field: "trans_id", Comp: "==", Value: source["id"]},
+			{Field: "deleted", Comp: "==", Value: 0}},
+		OrderBy: []string{"id"}}}, trans)
+	if err != nil {
+		return err
+	}
+	ids := map[int64]int64{}
+	sourceIDs := IL{}
+	for _, row := range rows {
+		sourceID := ut.ToInteger(row["id"], 0)
+		delete(row, "id")
+		row["trans_id"] = transID
+		if options["transcast"] == "cancellation" {
+			row["qty"] = -ut.ToFloat(row["qty"], 0)
+		}
+		if ids[sourceID], err = nstore.transFromInsert("movement", row, trans); err != nil {
+			return err
+		}
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+	if len(sourceIDs) == 0 {
+		return nil
+	}
+	links, err := nstore.ds.Query([]Query{{
+		Fields: []string{"l.ref_id_1", "nt2.groupvalue as nervatype_2", "l.ref_id_2"},
+		From:   "link l inner join groups nt1 on l.nervatype_1 = nt1.id inner join groups nt2 on l.nervatype_2 = nt2.id",
+		Filters: []Filter{
+			{Field: "l.deleted", Comp: "==", Value: 0},
+			{Field: "nt1.groupvalue", Comp: "==", Value: "movement"},
+			{Field: "l.ref_id_1", Comp: "in", Value: sourceIDs}},
+		OrderBy: []string{"l.id"}}}, trans)
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		refID1, refID2 := ids[ut.ToInteger(link["ref_id_1"], 0)], link["ref_id_2"]
+		switch link["nervatype_2"] {
+		case "item":
+		case "movement":
+			if refID2 = ids[ut.ToInteger(link["ref_id_2"], 0)]; refID2 == int64(0) {
+				continue
+			}
+		default:
+			continue
+		}
+		if err = nstore.transFromLink(groups, "movement", refID1, ut.ToString(link["nervatype_2"], ""), refID2, trans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+createTransFrom - create a new document from an existing one (e.g. order -> invoice or delivery, offer -> order,
+invoice -> cancellation). The new document gets the nextNumber transnumber, the metadata, the item, payment or
+movement rows of the source with the recalculated item amounts, and a link to the source document.
+*/
+func (nstore *NervaStore) createTransFrom(options IM) (result IM, err error) {
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return result, err
+		}
+		return result, errors.New(ut.GetMessage("not_connect"))
+	}
+
+	var trans interface{}
+	if _, found := options["trans"]; found {
+		trans = options["trans"]
+	} else if nstore.ds.Properties().Transaction {
+		trans, err = nstore.ds.BeginTransaction()
+		if err != nil {
+			return result, err
+		}
+	}
+	defer func() {
+		pe := recover()
+		if trans != nil {
+			if _, found := options["trans"]; !found {
+				if err != nil || pe != nil {
+					nstore.rollbackTrans(trans)
+				} else {
+					err = nstore.commitTrans(trans)
+				}
+			}
+		}
+		if pe != nil {
+			panic(pe)
+		}
+	}()
+
+	source, err := nstore.transFromSource(options, trans)
+	if err != nil {
+		return result, err
+	}
+	values := IM{}
+	for key, value := range options {
+		values[key] = value
+	}
+	values["transtype"] = strings.ToLower(ut.ToString(options["transtype"], ut.ToString(source["transtype_key"], "")))
+	values["direction"] = strings.ToLower(ut.ToString(options["direction"], ut.ToString(source["direction_key"], "")))
+	values["transcast"] = ut.ToString(options["transcast"], "normal")
+	if err = nstore.transFromCheck(source, values["transtype"].(string), values["direction"].(string),
+		values["transcast"].(string), trans); err != nil {
+		return result, err
+	}
+	values["copy"] = values["transcast"] == "normal" && values["transtype"] == source["transtype_key"] &&
+		values["direction"] == source["direction_key"] && !ut.ToBoolean(options["refno"], false)
+
+	groups, err := nstore.groupIDs(SL{"transtype", "direction", "transtate", "nervatype", "movetype"}, trans)
+	if err != nil {
+		return result, err
+	}
+	if _, found := groups["transtype/"+values["transtype"].(string)]; !found {
+		return result, errors.New(ut.GetMessage("invalid_value") + ": transtype")
+	}
+	if _, found := groups["direction/"+values["direction"].(string)]; !found {
+		return result, errors.New(ut.GetMessage("invalid_value") + ": direction")
+	}
+	if err = nstore.transFromAudit(source, groups["transtype/"+values["transtype"].(string)]); err != nil {
+		return result, err
+	}
+
+	head, err := nstore.transFromHead(source, groups, values, trans)
+	if err != nil {
+		return result, err
+	}
+	transID, err := nstore.transFromInsert("trans", head, trans)
+	if err != nil {
+		return result, err
+	}
+	if !values["copy"].(bool) {
+		if err = nstore.transFromLink(groups, "trans", transID, "trans", source["id"], trans); err != nil {
+			return result, err
+		}
+	}
+
+	switch sourceType := ut.ToString(source["transtype_key"], ""); {
+	case ut.Contains(transItemTypes, sourceType):
+		err = nstore.transFromItems(source, transID, groups, values, trans)
+	case ut.Contains(transPaymentTypes, sourceType):
+		err = nstore.transFromPayments(source, transID, values, trans)
+	default:
+		err = nstore.transFromMovements(source, transID, groups, values, trans)
+	}
+	if err != nil {
+		return result, err
+	}
+	return IM{"id": transID, "transnumber": head["transnumber"]}, nil
+}
+
+func init() {
+	err := RegisterFunction(ServerFunction{Name: "createTransFrom", Transaction: true,
+		Description: "Create a new document (invoice, delivery, order, cancellation etc.) from an existing one",
+		Args: []FunctionArg{
+			{Name: "transnumber", Type: "string", Description: "Source document number"},
+			{Name: "trans_id", Type: "integer", Description: "Source document ID"},
+			{Name: "transtype", Type: "string", Description: "New document type (default: the source transtype)"},
+			{Name: "direction", Type: "string", Description: "New document direction (default: the source direction)"},
+			{Name: "transcast", Type: "string", Description: "normal, cancellation or amendment (default: normal)"},
+			{Name: "transdate", Type: "date", Description: "Document date (default: today)"},
+			{Name: "refno", Type: "boolean", Description: "Reference and link to the source of a document copy"},
+			{Name: "netto", Type: "boolean", Description: "Invoice or receipt: the not invoiced quantities of the source"},
+			{Name: "from_inventory", Type: "boolean",
+				Description: "Invoice or receipt: the shipped and not invoiced quantities of the source"},
+			{Name: "items", Type: "list", Description: "Partial quantities: the source item id and qty values"},
+			{Name: "planumber", Type: "string",
+				Description: "Delivery warehouse (default: the source place or the default_warehouse)"}},
+		Audit: []FunctionAudit{{Nervatype: "trans", Right: "update"}},
+		Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+			return nstore.createTransFrom(options)
+		}})
+	if err != nil {
+		panic(err)
+	}
+}
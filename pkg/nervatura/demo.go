@@ -218,6 +218,34 @@ func demoData() IM {
 		"place": []IM{
 			{"planumber": "material", "description": "Raw material",
 				"keys": IM{"placetype": "warehouse"}}},
+		"ledger": IM{
+			"account": []IM{
+				{"accnumber": "1000", "description": "Cash", "acctype": "asset"},
+				{"accnumber": "1100", "description": "Bank", "acctype": "asset"},
+				{"accnumber": "1200", "description": "Accounts receivable", "acctype": "asset"},
+				{"accnumber": "1300", "description": "VAT receivable", "acctype": "asset"},
+				{"accnumber": "2000", "description": "Accounts payable", "acctype": "liability"},
+				{"accnumber": "2300", "description": "VAT payable", "acctype": "liability"},
+				{"accnumber": "3000", "description": "Share capital", "acctype": "equity"},
+				{"accnumber": "4000", "description": "Sales", "acctype": "income"},
+				{"accnumber": "5000", "description": "Purchases", "acctype": "expense"}},
+			"postrule": []IM{
+				{"source": "netamount", "description": "Sales invoice",
+					"keys": IM{"transtype": "invoice", "direction": "out", "debit_id": "1200", "credit_id": "4000"}},
+				{"source": "vatamount", "description": "Sales invoice VAT",
+					"keys": IM{"transtype": "invoice", "direction": "out", "debit_id": "1200", "credit_id": "2300"}},
+				{"source": "netamount", "description": "Purchase invoice",
+					"keys": IM{"transtype": "invoice", "direction": "in", "debit_id": "5000", "credit_id": "2000"}},
+				{"source": "vatamount", "description": "Purchase invoice VAT",
+					"keys": IM{"transtype": "invoice", "direction": "in", "debit_id": "1300", "credit_id": "2000"}},
+				{"source": "netamount", "description": "Cash sale",
+					"keys": IM{"transtype": "receipt", "direction": "out", "debit_id": "1000", "credit_id": "4000"}},
+				{"source": "vatamount", "description": "Cash sale VAT",
+					"keys": IM{"transtype": "receipt", "direction": "out", "debit_id": "1000", "credit_id": "2300"}},
+				{"source": "amount", "description": "Bank statement",
+					"keys": IM{"transtype": "bank", "debit_id": "1100", "credit_id": "1200"}},
+				{"source": "amount", "description": "Petty cash",
+					"keys": IM{"transtype": "cash", "debit_id": "1000", "credit_id": "2000"}}}},
 		"trans_item": IM{
 			"trans": []IM{
 				{"transnumber": "DMORD/00001", "transdate": time.Now().AddDate(-1, 0, 0).Format("2006") + "-11-01",
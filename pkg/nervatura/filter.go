@@ -0,0 +1,302 @@
+package nervatura
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// getFilterList - split a comma separated value list or returns the array values
+func getFilterList(value interface{}) IL {
+	switch v := value.(type) {
+	case IL:
+		return v
+	case []string:
+		values := IL{}
+		for _, item := range v {
+			values = append(values, item)
+		}
+		return values
+	}
+	values := IL{}
+	for _, item := range strings.Split(ut.ToString(value, ""), ",") {
+		values = append(values, item)
+	}
+	return values
+}
+
+// checkFilterValue - convert the filter value to the type of the model field
+func checkFilterValue(fieldname string, field MF, value interface{}) (interface{}, error) {
+	fieldType := field.Type
+	if field.References != nil {
+		fieldType = "id"
+	}
+	invalidErr := errors.New(ut.GetMessage("invalid_value") + "- " + fieldname + " (" + fieldType + "): " + ut.ToString(value, ""))
+	switch fieldType {
+	case "id", "integer":
+		switch v := value.(type) {
+		case string:
+			ivalue, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return nil, invalidErr
+			}
+			return ivalue, nil
+		case int, int64, float64:
+			return ut.ToInteger(v, 0), nil
+		}
+		return nil, invalidErr
+
+	case "float":
+		switch v := value.(type) {
+		case string:
+			fvalue, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, invalidErr
+			}
+			return fvalue, nil
+		case int, int64, float64:
+			return ut.ToFloat(v, 0), nil
+		}
+		return nil, invalidErr
+
+	case "date":
+		svalue, valid := value.(string)
+		if !valid {
+			return nil, invalidErr
+		}
+		if _, err := time.Parse(dateFmt, svalue); err != nil {
+			return nil, invalidErr
+		}
+		return svalue, nil
+
+	case "datetime":
+		svalue, valid := value.(string)
+		if !valid {
+			return nil, invalidErr
+		}
+		if _, err := ut.StringToDateTime(svalue); err != nil {
+			return nil, invalidErr
+		}
+		return svalue, nil
+	}
+	return ut.ToString(value, ""), nil
+}
+
+func checkFilterIs(filter *Filter, value interface{}) error {
+	switch strings.ToLower(strings.TrimSpace(ut.ToString(value, "null"))) {
+	case "null":
+		filter.Value = "null"
+	case "notnull", "not null":
+		filter.Value = "not null"
+	default:
+		return errors.New(ut.GetMessage("invalid_value") + "- is: " + ut.ToString(value, ""))
+	}
+	return nil
+}
+
+// checkMetaFilterValue - convert the filter value to the deffield fieldtype
+func (api *API) checkMetaFilterValue(fieldname, fieldtype string, value interface{}) (interface{}, error) {
+	switch fieldtype {
+	case "bool":
+		return checkFieldvalueBool(value)
+	case "integer":
+		return checkFilterValue(fieldname, MF{Type: "integer"}, value)
+	case "float":
+		return checkFilterValue(fieldname, MF{Type: "float"}, value)
+	case "date":
+		return checkFieldvalueDate(value, fieldname, fieldtype)
+	case "time":
+		return checkFieldvalueTime(value, fieldname, fieldtype)
+	case "customer", "tool", "product", "project", "employee", "place", "transitem", "transmovement", "transpayment":
+		return api.NStore.checkFieldvalueNervatype(value, fieldname, fieldtype, nil)
+	}
+	return ut.ToString(value, ""), nil
+}
+
+// checkMetaFilter - metadata (deffield) filter condition
+func (api *API) checkMetaFilter(nervatype, fieldname, comp string, value interface{}) (Filter, error) {
+	rows, err := api.NStore.ds.QueryKey(IM{"qkey": "metadata_fieldtype", "nervatype": nervatype, "fieldname": fieldname}, nil)
+	if err != nil {
+		return Filter{}, err
+	}
+	if len(rows) == 0 {
+		return Filter{}, errors.New(ut.GetMessage("invalid_value") + "- fieldname: " + fieldname)
+	}
+	fieldtype := ut.ToString(rows[0]["fieldtype"], "")
+	filter := Filter{Field: fieldname, Comp: comp, Meta: fieldtype}
+	switch comp {
+	case "==", "!=", "<", "<=", ">", ">=", "in", "between":
+		values := IL{value}
+		if comp == "in" || comp == "between" {
+			values = getFilterList(value)
+			if len(values) == 0 || (comp == "between" && len(values) != 2) {
+				return filter, errors.New(ut.GetMessage("invalid_value") + "- " + comp + ": " + ut.ToString(value, ""))
+			}
+		}
+		fvalues := IL{}
+		for _, item := range values {
+			fvalue, err := api.checkMetaFilterValue(fieldname, fieldtype, item)
+			if err != nil {
+				return filter, err
+			}
+			fvalues = append(fvalues, fvalue)
+		}
+		filter.Value = fvalues
+		if comp != "in" && comp != "between" {
+			filter.Value = fvalues[0]
+		}
+		return filter, nil
+
+	case "like":
+		switch fieldtype {
+		case "string", "notes", "urlink", "valuelist":
+			filter.Value = ut.ToString(value, "")
+			return filter, nil
+		}
+		return filter, errors.New(ut.GetMessage("invalid_value") + "- " + fieldname + " (" + fieldtype + "): like")
+
+	case "is":
+		return filter, checkFilterIs(&filter, value)
+	}
+	return filter, errors.New(ut.GetMessage("invalid_value") + "- comparison: " + comp)
+}
+
+// checkFilter - validate the fieldname, comparison and value of a filter condition
+func (api *API) checkFilter(nervatype, fieldname, comp string, value interface{}) (Filter, error) {
+	field, found := api.NStore.models[nervatype].(IM)[fieldname].(MF)
+	if !found && fieldname != "" {
+		return api.checkMetaFilter(nervatype, fieldname, comp, value)
+	}
+	if !found {
+		return Filter{}, errors.New(ut.GetMessage("invalid_value") + "- fieldname: " + fieldname)
+	}
+	filter := Filter{Field: fieldname, Comp: comp}
+	switch comp {
+	case "==", "!=", "<", "<=", ">", ">=":
+		fvalue, err := checkFilterValue(fieldname, field, value)
+		if err != nil {
+			return filter, err
+		}
+		filter.Value = fvalue
+
+	case "like":
+		if field.Type != "string" && field.Type != "text" {
+			return filter, errors.New(ut.GetMessage("invalid_value") + "- " + fieldname + " (" + field.Type + "): like")
+		}
+		filter.Value = ut.ToString(value, "")
+
+	case "is":
+		if err := checkFilterIs(&filter, value); err != nil {
+			return filter, err
+		}
+
+	case "in", "between":
+		values := getFilterList(value)
+		if len(values) == 0 || (comp == "between" && len(values) != 2) {
+			return filter, errors.New(ut.GetMessage("invalid_value") + "- " + comp + ": " + ut.ToString(value, ""))
+		}
+		fvalues := IL{}
+		for _, item := range values {
+			fvalue, err := checkFilterValue(fieldname, field, item)
+			if err != nil {
+				return filter, err
+			}
+			fvalues = append(fvalues, fvalue)
+		}
+		filter.Value = fvalues
+
+	default:
+		return filter, errors.New(ut.GetMessage("invalid_value") + "- comparison: " + comp)
+	}
+	return filter, nil
+}
+
+/*
+parseFilterString - the compact filter form: fieldname;comparison;value conditions
+
+The "|" separated conditions are joined with AND, the "||" separated condition groups are joined with OR.
+Example: custname;like;%Co.%|deleted;==;0||custtype;in;116,117
+*/
+func (api *API) parseFilterString(nervatype, filter string) (Filter, error) {
+	result := Filter{Group: []Filter{}}
+	for bi, branch := range strings.Split(filter, "||") {
+		group := Filter{Or: (bi > 0), Group: []Filter{}}
+		for _, cond := range strings.Split(branch, "|") {
+			fields := strings.Split(cond, ";")
+			if len(fields) != 3 {
+				return result, errors.New(ut.GetMessage("invalid_value") + "- filter: " + cond)
+			}
+			filter, err := api.checkFilter(nervatype, fields[0], fields[1], fields[2])
+			if err != nil {
+				return result, err
+			}
+			group.Group = append(group.Group, filter)
+		}
+		result.Group = append(result.Group, group)
+	}
+	return result, nil
+}
+
+/*
+parseFilterTree - the JSON filter tree form
+
+The nodes are condition objects ({"field": "custname", "comp": "like", "value": "%Co.%"})
+or {"and": [nodes]} / {"or": [nodes]} groups. The nodes of an array are joined with AND.
+*/
+func (api *API) parseFilterTree(nervatype string, node interface{}) (Filter, error) {
+	group := func(items interface{}, or bool) (Filter, error) {
+		result := Filter{Group: []Filter{}}
+		nodes, valid := items.(IL)
+		if imNodes, found := items.([]IM); found {
+			nodes, valid = IL{}, true
+			for _, item := range imNodes {
+				nodes = append(nodes, item)
+			}
+		}
+		if !valid || len(nodes) == 0 {
+			return result, errors.New(ut.GetMessage("invalid_value") + "- filter group")
+		}
+		for index, item := range nodes {
+			filter, err := api.parseFilterTree(nervatype, item)
+			if err != nil {
+				return result, err
+			}
+			filter.Or = (or && index > 0)
+			result.Group = append(result.Group, filter)
+		}
+		return result, nil
+	}
+
+	switch v := node.(type) {
+	case IL, []IM:
+		return group(v, false)
+	case IM:
+		if items, found := v["and"]; found {
+			return group(items, false)
+		}
+		if items, found := v["or"]; found {
+			return group(items, true)
+		}
+		return api.checkFilter(nervatype, ut.ToString(v["field"], ""), ut.ToString(v["comp"], ""), v["value"])
+	}
+	return Filter{}, errors.New(ut.GetMessage("invalid_value") + "- filter")
+}
+
+// getFilter - returns the filter group of a compact string or a JSON tree filter value
+func (api *API) getFilter(nervatype string, value interface{}) (Filter, error) {
+	if filter, valid := value.(string); valid {
+		filter = strings.TrimSpace(filter)
+		if !strings.HasPrefix(filter, "{") && !strings.HasPrefix(filter, "[") {
+			return api.parseFilterString(nervatype, filter)
+		}
+		var tree interface{}
+		if err := ut.ConvertFromByte([]byte(filter), &tree); err != nil {
+			return Filter{}, errors.New(ut.GetMessage("invalid_value") + "- filter: " + err.Error())
+		}
+		value = tree
+	}
+	return api.parseFilterTree(nervatype, value)
+}
@@ -0,0 +1,281 @@
+package nervatura
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// FunctionArg - an argument of a server function
+type FunctionArg struct {
+	Name string `json:"name"`
+	// Type: string, integer, number, boolean, date, object or list
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Description string `json:"description"`
+}
+
+// FunctionAudit - the minimal ui_audit object right (readonly, update or all) of a server function
+type FunctionAudit struct {
+	Nervatype string `json:"nervatype"`
+	Transtype string `json:"transtype,omitempty"`
+	Right     string `json:"right"`
+}
+
+// ServerFunction - a named server function of the API.Function
+type ServerFunction struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Args        []FunctionArg   `json:"args"`
+	Audit       []FunctionAudit `json:"audit"`
+	// Transaction - the function is called in a database transaction (options["trans"])
+	Transaction bool `json:"transaction"`
+	// Call - the function implementation. The options are the checked argument values.
+	Call func(nstore *NervaStore, options IM) (interface{}, error) `json:"-"`
+}
+
+var functionRegistry = struct {
+	sync.RWMutex
+	items map[string]ServerFunction
+}{items: map[string]ServerFunction{}}
+
+/*
+RegisterFunction - add a new server function to the API.Function. The function name must be unique.
+
+Example:
+
+  err := nervatura.RegisterFunction(nervatura.ServerFunction{
+    Name: "productInfo", Description: "Product name and unit",
+    Args: []nervatura.FunctionArg{{Name: "partnumber", Type: "string", Required: true}},
+    Audit: []nervatura.FunctionAudit{{Nervatype: "product", Right: "readonly"}},
+    Call: func(nstore *nervatura.NervaStore, options nervatura.IM) (interface{}, error) {
+      ...
+    },
+  })
+
+*/
+func RegisterFunction(fn ServerFunction) error {
+	if fn.Name == "" {
+		return errors.New(ut.GetMessage("missing_required_field") + ": name")
+	}
+	if fn.Call == nil {
+		return errors.New(ut.GetMessage("missing_required_field") + ": call")
+	}
+	for _, audit := range fn.Audit {
+		if !ut.Contains(SL{"readonly", "update", "all"}, audit.Right) {
+			return errors.New(ut.GetMessage("invalid_value") + ": " + audit.Right)
+		}
+	}
+	functionRegistry.Lock()
+	defer functionRegistry.Unlock()
+	if _, found := functionRegistry.items[fn.Name]; found {
+		return errors.New(ut.GetMessage("function_exists") + ": " + fn.Name)
+	}
+	functionRegistry.items[fn.Name] = fn
+	return nil
+}
+
+// GetFunction - returns a registered server function
+func GetFunction(name string) (ServerFunction, bool) {
+	functionRegistry.RLock()
+	defer functionRegistry.RUnlock()
+	fn, found := functionRegistry.items[name]
+	return fn, found
+}
+
+// Functions - the registered server functions in name order
+func Functions() []ServerFunction {
+	functionRegistry.RLock()
+	defer functionRegistry.RUnlock()
+	result := []ServerFunction{}
+	for _, fn := range functionRegistry.items {
+		result = append(result, fn)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// checkArgs - the required arguments and the argument value types of a function call
+func (fn *ServerFunction) checkArgs(options IM) error {
+	for _, arg := range fn.Args {
+		value, found := options[arg.Name]
+		if !found || value == nil {
+			if arg.Required {
+				return errors.New(ut.GetMessage("missing_required_field") + ": " + arg.Name)
+			}
+			continue
+		}
+		valid := true
+		switch arg.Type {
+		case "integer", "number":
+			switch v := value.(type) {
+			case int, int32, int64, float32, float64:
+			case string:
+				_, err := strconv.ParseFloat(v, 64)
+				valid = (err == nil)
+			default:
+				valid = false
+			}
+		case "boolean":
+			switch v := value.(type) {
+			case bool:
+			case string:
+				_, err := strconv.ParseBool(v)
+				valid = (err == nil)
+			default:
+				valid = false
+			}
+		case "date":
+			date := ut.ToString(value, "")
+			if len(date) > 10 {
+				date = date[:10]
+			}
+			_, err := time.Parse(dateFmt, date)
+			valid = (err == nil)
+		case "object":
+			_, valid = value.(IM)
+		case "list":
+			_, valid = value.([]interface{})
+		}
+		if !valid {
+			return errors.New(ut.GetMessage("invalid_value") + ": " + arg.Name)
+		}
+	}
+	return nil
+}
+
+// checkFunctionAudit - the ui_audit object rights of the logged in user
+func (api *API) checkFunctionAudit(fn *ServerFunction) error {
+	state, err := api.getAuditState()
+	if err != nil || state == nil {
+		return err
+	}
+	rights := map[string]int{"disabled": 0, "readonly": 1, "update": 2, "all": 3}
+	for _, audit := range fn.Audit {
+		right, err := api.auditRight(state, audit.Nervatype, audit.Transtype)
+		if err != nil {
+			return err
+		}
+		if rights[right] < rights[audit.Right] {
+			return permissionError(fn.Name)
+		}
+	}
+	return nil
+}
+
+/*
+GetService - call a registered Nervatura server side function.
+The function is called in a new database transaction, if it needs a transaction and
+the options do not contain an existing one (options["trans"]).
+*/
+func (nstore *NervaStore) GetService(key string, options IM) (result interface{}, err error) {
+	fn, found := GetFunction(key)
+	if !found {
+		return nil, errors.New(ut.GetMessage("unknown_method") + ": " + key)
+	}
+	if err = fn.checkArgs(options); err != nil {
+		return nil, err
+	}
+	if _, found := options["trans"]; found || !fn.Transaction {
+		return fn.Call(nstore, options)
+	}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New(ut.GetMessage("not_connect"))
+	}
+	if !nstore.ds.Properties().Transaction {
+		return fn.Call(nstore, options)
+	}
+	trans, err := nstore.ds.BeginTransaction()
+	if err != nil {
+		return nil, err
+	}
+	options["trans"] = trans
+	defer func() {
+		delete(options, "trans")
+		pe := recover()
+		if err != nil || pe != nil {
+			nstore.rollbackTrans(trans)
+		} else {
+			err = nstore.commitTrans(trans)
+		}
+		if pe != nil {
+			panic(pe)
+		}
+	}()
+	return fn.Call(nstore, options)
+}
+
+func init() {
+	functions := []ServerFunction{
+		{Name: "nextNumber", Transaction: true,
+			Description: "Get the next value from the numberdef table (transnumber, custnumber, partnumber etc.)",
+			Args: []FunctionArg{
+				{Name: "numberkey", Type: "string", Required: true, Description: "The numberdef key"},
+				{Name: "step", Type: "boolean", Description: "Increase the current value (default: true)"},
+				{Name: "insert_key", Type: "boolean", Description: "Create the missing numberdef key (default: true)"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.nextNumber(options)
+			}},
+		{Name: "getPriceValue", Description: "Get the best product price and discount",
+			Args: []FunctionArg{
+				{Name: "curr", Type: "string", Required: true, Description: "Currency code"},
+				{Name: "product_id", Type: "integer", Required: true, Description: "Product ID"},
+				{Name: "customer_id", Type: "integer", Description: "Customer ID (customer and group prices)"},
+				{Name: "vendorprice", Type: "integer", Description: "Supplier price (0 or 1)"},
+				{Name: "posdate", Type: "date", Description: "Price date (default: today)"},
+				{Name: "qty", Type: "number", Description: "Quantity"}},
+			Audit: []FunctionAudit{{Nervatype: "product", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.getPriceValue(options)
+			}},
+		{Name: "sendEmail", Description: "Email sending with attached reports",
+			Args: []FunctionArg{
+				{Name: "provider", Type: "string", Description: "Email provider (default: smtp)"},
+				{Name: "email", Type: "object", Required: true,
+					Description: "from, name, recipients, subject, text, html and attachments values"}},
+			Audit: []FunctionAudit{{Nervatype: "report", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.sendEmail(options)
+			}},
+		{Name: "postJournal", Transaction: true,
+			Description: "Create again the journal entries of a document or all invoice, receipt, bank and cash documents",
+			Args: []FunctionArg{
+				{Name: "transnumber", Type: "string", Description: "Document number"},
+				{Name: "trans_id", Type: "integer", Description: "Document ID"}},
+			Audit: []FunctionAudit{{Nervatype: "trans", Right: "update"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.postJournal(options)
+			}},
+		{Name: "trialBalance", Description: "Opening balance, debit and credit turnover and closing balance of the accounts",
+			Args: []FunctionArg{
+				{Name: "date_from", Type: "date", Description: "Start date (default: 1900-01-01)"},
+				{Name: "date_to", Type: "date", Description: "End date (default: today)"},
+				{Name: "curr", Type: "string", Description: "Currency code"}},
+			Audit: []FunctionAudit{{Nervatype: "setting", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.trialBalance(options)
+			}},
+		{Name: "accountStatement", Description: "The journal entries of an account with the running balance",
+			Args: []FunctionArg{
+				{Name: "accnumber", Type: "string", Required: true, Description: "Account number"},
+				{Name: "date_from", Type: "date", Description: "Start date (default: 1900-01-01)"},
+				{Name: "date_to", Type: "date", Description: "End date (default: today)"},
+				{Name: "curr", Type: "string", Description: "Currency code"}},
+			Audit: []FunctionAudit{{Nervatype: "setting", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.accountStatement(options)
+			}},
+	}
+	for _, fn := range functions {
+		if err := RegisterFunction(fn); err != nil {
+			panic(err)
+		}
+	}
+}
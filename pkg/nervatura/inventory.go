@@ -0,0 +1,412 @@
+package nervatura
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// stockLayer - a cost layer of the stock valuation (received quantity and unit cost)
+type stockLayer struct {
+	qty  float64
+	cost float64
+}
+
+/*
+stockCost - the cost layers of a product. The fifo method keeps the layers in receipt order,
+the average method has a single layer with the weighted-average unit cost.
+A negative stock is valued at the last unit cost.
+*/
+type stockCost struct {
+	method   string
+	layers   []stockLayer
+	lastCost float64
+}
+
+const stockEpsilon = 0.000001
+
+// roundFloat - round a calculated quantity or amount to the digits
+func roundFloat(value float64, digits int) float64 {
+	p := math.Pow(10, float64(digits))
+	return math.Round(value*p) / p
+}
+
+func (sc *stockCost) qty() (qty float64) {
+	for _, layer := range sc.layers {
+		qty += layer.qty
+	}
+	return qty
+}
+
+func (sc *stockCost) value() (value float64) {
+	for _, layer := range sc.layers {
+		value += layer.qty * layer.cost
+	}
+	return value
+}
+
+// unitCost - the average unit cost of the stock
+func (sc *stockCost) unitCost() float64 {
+	if qty := sc.qty(); math.Abs(qty) > stockEpsilon {
+		return sc.value() / qty
+	}
+	return sc.lastCost
+}
+
+// receive - add a new layer (fifo) or recalculate the average cost
+func (sc *stockCost) receive(qty, cost float64) {
+	sc.lastCost = cost
+	if sc.method == "average" {
+		total, value := sc.qty()+qty, sc.value()+qty*cost
+		sc.layers = []stockLayer{}
+		if math.Abs(total) > stockEpsilon {
+			sc.layers = append(sc.layers, stockLayer{qty: total, cost: value / total})
+		}
+		return
+	}
+	// the negative stock is settled first
+	for qty > stockEpsilon && len(sc.layers) > 0 && sc.layers[0].qty < 0 {
+		settled := math.Min(qty, -sc.layers[0].qty)
+		sc.layers[0].qty += settled
+		qty -= settled
+		if math.Abs(sc.layers[0].qty) <= stockEpsilon {
+			sc.layers = sc.layers[1:]
+		}
+	}
+	if qty > stockEpsilon {
+		sc.layers = append(sc.layers, stockLayer{qty: qty, cost: cost})
+	}
+}
+
+// issue - remove a quantity from the stock. Returns the cost value of the issued quantity.
+func (sc *stockCost) issue(qty float64) (value float64) {
+	if sc.method == "average" {
+		cost := sc.unitCost()
+		total := sc.qty() - qty
+		sc.layers = []stockLayer{}
+		if math.Abs(total) > stockEpsilon {
+			sc.layers = append(sc.layers, stockLayer{qty: total, cost: cost})
+		}
+		return qty * cost
+	}
+	for qty > stockEpsilon && len(sc.layers) > 0 && sc.layers[0].qty > 0 {
+		used := math.Min(qty, sc.layers[0].qty)
+		value += used * sc.layers[0].cost
+		sc.layers[0].qty -= used
+		qty -= used
+		if sc.layers[0].qty <= stockEpsilon {
+			sc.layers = sc.layers[1:]
+		}
+	}
+	if qty > stockEpsilon {
+		value += qty * sc.lastCost
+		if len(sc.layers) > 0 && sc.layers[len(sc.layers)-1].qty < 0 {
+			sc.layers[len(sc.layers)-1].qty -= qty
+		} else {
+			sc.layers = append(sc.layers, stockLayer{qty: -qty, cost: sc.lastCost})
+		}
+	}
+	return value
+}
+
+// stockPosdate - the posdate option of the stock functions (default: today)
+func stockPosdate(options IM) (string, error) {
+	posdate := ut.ToString(options["posdate"], time.Now().Format(dateFmt))
+	if len(posdate) > len(dateFmt) {
+		posdate = posdate[:len(dateFmt)]
+	}
+	if _, err := time.Parse(dateFmt, posdate); err != nil {
+		return posdate, errors.New(ut.GetMessage("invalid_value") + ": posdate")
+	}
+	return posdate, nil
+}
+
+// stockMovements - the inventory movements of the not deleted documents until the end of the posdate
+func (nstore *NervaStore) stockMovements(posdate string, filters []Filter, trans interface{}) ([]IM, error) {
+	date, _ := time.Parse(dateFmt, posdate)
+	return nstore.ds.Query([]Query{{
+		Fields: []string{"m.id", "m.trans_id", "m.shippingdate", "m.qty", "m.product_id", "p.partnumber",
+			"p.description as product", "p.unit", "m.place_id", "pl.planumber", "pl.description as place",
+			"tt.groupvalue as transtype", "dir.groupvalue as direction"},
+		From: `movement m inner join trans t on m.trans_id = t.id inner join groups tt on t.transtype = tt.id
+			inner join groups dir on t.direction = dir.id inner join groups mt on m.movetype = mt.id
+			inner join product p on m.product_id = p.id left join place pl on m.place_id = pl.id`,
+		Filters: append([]Filter{
+			{Field: "m.deleted", Comp: "==", Value: 0},
+			{Field: "t.deleted", Comp: "==", Value: 0},
+			{Field: "mt.groupvalue", Comp: "==", Value: "inventory"},
+			{Field: "m.shippingdate", Comp: "<", Value: date.AddDate(0, 0, 1).Format(dateFmt)}}, filters...),
+		OrderBy: []string{"m.shippingdate", "m.trans_id", "m.id"}}}, trans)
+}
+
+// stockPlaceRows - the summarized quantities of the movements by product and place (without the zero quantities)
+func stockPlaceRows(movements []IM, partnumber, planumber string) []IM {
+	rows := []IM{}
+	index := map[string]IM{}
+	for _, mv := range movements {
+		if (partnumber != "" && ut.ToString(mv["partnumber"], "") != partnumber) ||
+			(planumber != "" && ut.ToString(mv["planumber"], "") != planumber) {
+			continue
+		}
+		key := ut.ToString(mv["product_id"], "") + "/" + ut.ToString(mv["place_id"], "")
+		row, found := index[key]
+		if !found {
+			row = IM{"product_id": mv["product_id"], "partnumber": mv["partnumber"], "product": mv["product"],
+				"unit": mv["unit"], "place_id": mv["place_id"], "planumber": mv["planumber"], "place": mv["place"],
+				"qty": float64(0)}
+			index[key] = row
+			rows = append(rows, row)
+		}
+		row["qty"] = row["qty"].(float64) + ut.ToFloat(mv["qty"], 0)
+	}
+	results := []IM{}
+	for _, row := range rows {
+		if row["qty"] = roundFloat(row["qty"].(float64), 4); row["qty"].(float64) != 0 {
+			results = append(results, row)
+		}
+	}
+	return results
+}
+
+// stockQuantity - the stock quantity of the products by warehouses (place) at the end of the posdate
+func (nstore *NervaStore) stockQuantity(options IM) (results []IM, err error) {
+	posdate, err := stockPosdate(options)
+	if err != nil {
+		return results, err
+	}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return results, err
+		}
+		return results, errors.New(ut.GetMessage("not_connect"))
+	}
+	partnumber := ut.ToString(options["partnumber"], "")
+	planumber := ut.ToString(options["planumber"], "")
+	filters := []Filter{}
+	if partnumber != "" {
+		filters = append(filters, Filter{Field: "p.partnumber", Comp: "==", Value: partnumber})
+	}
+	if planumber != "" {
+		filters = append(filters, Filter{Field: "pl.planumber", Comp: "==", Value: planumber})
+	}
+	movements, err := nstore.stockMovements(posdate, filters, options["trans"])
+	if err != nil {
+		return results, err
+	}
+	results = stockPlaceRows(movements, partnumber, planumber)
+	for _, row := range results {
+		row["posdate"] = posdate
+	}
+	return results, nil
+}
+
+// stockPurchasePrices - the unit net prices (netamount/qty) of the purchase invoice items by products
+func (nstore *NervaStore) stockPurchasePrices(curr string, trans interface{}) (map[int64][]IM, error) {
+	prices := map[int64][]IM{}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"i.product_id", "t.transdate", "i.qty", "i.netamount"},
+		From: `item i inner join trans t on i.trans_id = t.id inner join groups tt on t.transtype = tt.id
+			inner join groups dir on t.direction = dir.id`,
+		Filters: []Filter{
+			{Field: "i.deleted", Comp: "==", Value: 0},
+			{Field: "t.deleted", Comp: "==", Value: 0},
+			{Field: "tt.groupvalue", Comp: "==", Value: "invoice"},
+			{Field: "dir.groupvalue", Comp: "==", Value: "in"},
+			{Field: "t.curr", Comp: "==", Value: curr},
+			{Field: "i.qty", Comp: "!=", Value: 0}},
+		OrderBy: []string{"t.transdate", "t.id", "i.id"}}}, trans)
+	if err != nil {
+		return prices, err
+	}
+	for _, row := range rows {
+		productID := ut.ToInteger(row["product_id"], 0)
+		prices[productID] = append(prices[productID], IM{"transdate": ut.ToString(bulkDate(row["transdate"]), ""),
+			"price": ut.ToFloat(row["netamount"], 0) / ut.ToFloat(row["qty"], 1)})
+	}
+	return prices, nil
+}
+
+/*
+stockValuation - the stock quantity, unit cost and value of the products by warehouses (place) at the end of the posdate.
+The cost is calculated by the fifo (default) or the weighted-average method from all inventory movements of the product:
+  - delivery (direction in): the last purchase invoice price of the product until the shipping date (or the first
+    later one), otherwise the supplier price (price, vendorprice = 1)
+  - production: the cost of the issued (component) products, shared by the value of the produced products
+  - other receipts (inventory correction, return): the current unit cost of the product
+
+The transfers between the warehouses do not change the cost.
+*/
+func (nstore *NervaStore) stockValuation(options IM) (results []IM, err error) {
+	posdate, err := stockPosdate(options)
+	if err != nil {
+		return results, err
+	}
+	method := ut.ToString(options["method"], "fifo")
+	if method != "fifo" && method != "average" {
+		return results, errors.New(ut.GetMessage("invalid_value") + ": method")
+	}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return results, err
+		}
+		return results, errors.New(ut.GetMessage("not_connect"))
+	}
+	curr := ut.ToString(options["curr"], "")
+	if curr == "" {
+		if curr, err = nstore.settingValue("default_currency", options["trans"]); err != nil {
+			return results, err
+		}
+	}
+
+	// all products (the production cost depends on the component costs)
+	movements, err := nstore.stockMovements(posdate, []Filter{}, options["trans"])
+	if err != nil {
+		return results, err
+	}
+	prices, err := nstore.stockPurchasePrices(curr, options["trans"])
+	if err != nil {
+		return results, err
+	}
+	vendorPrices := map[string]float64{}
+	purchasePrice := func(productID int64, shippingdate string) (float64, error) {
+		if items := prices[productID]; len(items) > 0 {
+			price := items[0]["price"].(float64)
+			for _, item := range items {
+				if item["transdate"].(string) > shippingdate {
+					break
+				}
+				price = item["price"].(float64)
+			}
+			return price, nil
+		}
+		// the supplier price is valid at the shipping date
+		key := ut.ToString(productID, "") + "/" + shippingdate
+		if price, found := vendorPrices[key]; found {
+			return price, nil
+		}
+		value, err := nstore.getPriceValue(IM{"curr": curr, "product_id": productID, "vendorprice": 1, "posdate": shippingdate})
+		if err != nil {
+			return 0, err
+		}
+		vendorPrices[key] = value["price"].(float64)
+		return vendorPrices[key], nil
+	}
+
+	costs := map[int64]*stockCost{}
+	productCost := func(productID int64) *stockCost {
+		if _, found := costs[productID]; !found {
+			costs[productID] = &stockCost{method: method, layers: []stockLayer{}}
+		}
+		return costs[productID]
+	}
+	for start := 0; start < len(movements); {
+		// the net quantities of the products in a document
+		transID := movements[start]["trans_id"]
+		transtype := ut.ToString(movements[start]["transtype"], "")
+		direction := ut.ToString(movements[start]["direction"], "")
+		shippingdate := ut.ToString(bulkDate(movements[start]["shippingdate"]), "")
+		products, qty := []int64{}, map[int64]float64{}
+		end := start
+		for ; end < len(movements) && movements[end]["trans_id"] == transID; end++ {
+			productID := ut.ToInteger(movements[end]["product_id"], 0)
+			if _, found := qty[productID]; !found {
+				products = append(products, productID)
+			}
+			qty[productID] += ut.ToFloat(movements[end]["qty"], 0)
+		}
+		start = end
+
+		issued, received := float64(0), []int64{}
+		for _, productID := range products {
+			if qty[productID] < -stockEpsilon {
+				issued += productCost(productID).issue(-qty[productID])
+			} else if qty[productID] > stockEpsilon {
+				received = append(received, productID)
+			}
+		}
+		// the production cost is shared by the value (not the mixed unit quantities) of the produced products
+		shares := map[int64]float64{}
+		if transtype == "production" && issued > 0 {
+			total := float64(0)
+			for _, productID := range received {
+				refCost := productCost(productID).unitCost()
+				if refCost == 0 {
+					if refCost, err = purchasePrice(productID, shippingdate); err != nil {
+						return results, err
+					}
+				}
+				shares[productID] = qty[productID] * refCost
+				total += shares[productID]
+			}
+			for _, productID := range received {
+				if total > stockEpsilon {
+					shares[productID] = issued * shares[productID] / total
+				} else {
+					shares[productID] = issued / float64(len(received))
+				}
+			}
+		}
+		for _, productID := range received {
+			sc := productCost(productID)
+			var cost float64
+			switch {
+			case transtype == "production" && issued > 0:
+				cost = shares[productID] / qty[productID]
+			case transtype == "delivery" && direction == "in", len(sc.layers) == 0 && sc.lastCost == 0:
+				if cost, err = purchasePrice(productID, shippingdate); err != nil {
+					return results, err
+				}
+			default:
+				cost = sc.unitCost()
+			}
+			sc.receive(qty[productID], cost)
+		}
+	}
+
+	results = stockPlaceRows(movements, ut.ToString(options["partnumber"], ""), ut.ToString(options["planumber"], ""))
+	for _, row := range results {
+		cost := float64(0)
+		if sc, found := costs[ut.ToInteger(row["product_id"], 0)]; found {
+			cost = sc.unitCost()
+		}
+		row["posdate"] = posdate
+		row["method"] = method
+		row["curr"] = curr
+		row["cost"] = roundFloat(cost, 4)
+		row["value"] = roundFloat(row["qty"].(float64)*cost, 2)
+	}
+	return results, nil
+}
+
+func init() {
+	functions := []ServerFunction{
+		{Name: "stockQuantity", Description: "Stock quantity of the products by warehouses at the end of a date",
+			Args: []FunctionArg{
+				{Name: "posdate", Type: "date", Description: "Stock date (default: today)"},
+				{Name: "partnumber", Type: "string", Description: "Product No."},
+				{Name: "planumber", Type: "string", Description: "Warehouse No."}},
+			Audit: []FunctionAudit{{Nervatype: "product", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.stockQuantity(options)
+			}},
+		{Name: "stockValuation",
+			Description: "Stock quantity, unit cost and value of the products by warehouses (FIFO or weighted-average cost)",
+			Args: []FunctionArg{
+				{Name: "posdate", Type: "date", Description: "Stock date (default: today)"},
+				{Name: "method", Type: "string", Description: "Valuation method: fifo or average (default: fifo)"},
+				{Name: "curr", Type: "string", Description: "Currency code of the purchase prices (default: default_currency)"},
+				{Name: "partnumber", Type: "string", Description: "Product No."},
+				{Name: "planumber", Type: "string", Description: "Warehouse No."}},
+			Audit: []FunctionAudit{{Nervatype: "product", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.stockValuation(options)
+			}},
+	}
+	for _, fn := range functions {
+		if err := RegisterFunction(fn); err != nil {
+			panic(err)
+		}
+	}
+}
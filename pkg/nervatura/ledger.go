@@ -0,0 +1,387 @@
+package nervatura
+
+import (
+	"errors"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// journalTransIDs - the trans records of a trans, item or payment change
+func journalTransIDs(nervatype string, id int64, rows ...IM) []int64 {
+	switch nervatype {
+	case "trans":
+		return []int64{id}
+	case "item", "payment":
+		ids := []int64{}
+		for _, row := range rows {
+			if transID := ut.ToInteger(row["trans_id"], 0); transID > 0 {
+				found := false
+				for _, tid := range ids {
+					found = found || (tid == transID)
+				}
+				if !found {
+					ids = append(ids, transID)
+				}
+			}
+		}
+		return ids
+	}
+	return []int64{}
+}
+
+// journalEnabled - the NT_JOURNAL_POSTING setting. The posting needs the journal table (DatabaseUpgrade).
+func (nstore *NervaStore) journalEnabled() (bool, error) {
+	if !ut.ToBoolean(nstore.config["NT_JOURNAL_POSTING"], false) {
+		return false, nil
+	}
+	if nstore.ledger == nil {
+		_, err := nstore.ds.Query([]Query{{Fields: []string{"count(*) as count"}, From: "journal",
+			Filters: []Filter{{Field: "id", Comp: "==", Value: 0}}}}, nil)
+		ready := (err == nil)
+		nstore.ledger = &ready
+	}
+	if !*nstore.ledger {
+		return false, errors.New(ut.GetMessage("missing_journal"))
+	}
+	return true, nil
+}
+
+/*
+queueJournal - the general ledger posting of the changed trans records. The trans records are posted once
+by the commit of the transaction (commitTrans), or immediately without a transaction.
+*/
+func (nstore *NervaStore) queueJournal(ids []int64, trans interface{}) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if enabled, err := nstore.journalEnabled(); !enabled || err != nil {
+		return err
+	}
+	if trans == nil {
+		for _, transID := range ids {
+			if _, err := nstore.postTrans(transID, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if nstore.journal == nil {
+		nstore.journal = make(map[interface{}][]int64)
+	}
+	for _, transID := range ids {
+		found := false
+		for _, tid := range nstore.journal[trans] {
+			found = found || (tid == transID)
+		}
+		if !found {
+			nstore.journal[trans] = append(nstore.journal[trans], transID)
+		}
+	}
+	return nil
+}
+
+// commitTrans - post the queued trans records to the general ledger and commit the transaction
+func (nstore *NervaStore) commitTrans(trans interface{}) error {
+	ids := nstore.journal[trans]
+	delete(nstore.journal, trans)
+	for _, transID := range ids {
+		if _, err := nstore.postTrans(transID, trans); err != nil {
+			nstore.ds.RollbackTransaction(trans)
+			return err
+		}
+	}
+	return nstore.ds.CommitTransaction(trans)
+}
+
+// rollbackTrans - rollback the transaction and drop the queued journal posting
+func (nstore *NervaStore) rollbackTrans(trans interface{}) error {
+	delete(nstore.journal, trans)
+	return nstore.ds.RollbackTransaction(trans)
+}
+
+// clearJournal - delete the journal entries of a trans
+func (nstore *NervaStore) clearJournal(transID int64, trans interface{}) error {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"id"}, From: "journal", Filters: []Filter{
+			{Field: "trans_id", Comp: "==", Value: transID}}}}, trans)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		_, err = nstore.ds.Update(Update{Model: "journal", IDKey: ut.ToInteger(row["id"], 0), Trans: trans})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journalRules - the posting rules of a transtype and direction
+func (nstore *NervaStore) journalRules(transtype, direction string, trans interface{}) ([]IM, error) {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"p.id", "p.source", "p.debit_id", "p.credit_id", "p.description", "dir.groupvalue as direction"},
+		From:   "postrule p inner join groups tt on p.transtype = tt.id left join groups dir on p.direction = dir.id",
+		Filters: []Filter{
+			{Field: "p.deleted", Comp: "==", Value: 0},
+			{Field: "tt.groupvalue", Comp: "==", Value: transtype}},
+		OrderBy: []string{"p.id"}}}, trans)
+	if err != nil {
+		return nil, err
+	}
+	rules := []IM{}
+	for _, row := range rows {
+		if row["direction"] == nil || ut.ToString(row["direction"], "") == direction {
+			rules = append(rules, row)
+		}
+	}
+	return rules, nil
+}
+
+// journalAmounts - the postable amounts of a trans: item totals of the documents and payment rows of the bank and cash
+func (nstore *NervaStore) journalAmounts(transID int64, transtype string, transdate interface{}, trans interface{}) ([]IM, error) {
+	amounts := []IM{}
+	switch transtype {
+	case "invoice", "receipt":
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"sum(netamount) as netamount", "sum(vatamount) as vatamount", "sum(amount) as amount"},
+			From:   "item", Filters: []Filter{
+				{Field: "trans_id", Comp: "==", Value: transID},
+				{Field: "deleted", Comp: "==", Value: 0}}}}, trans)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) > 0 {
+			for _, source := range []string{"netamount", "vatamount", "amount"} {
+				amounts = append(amounts, IM{"source": source, "entrydate": transdate, "value": ut.ToFloat(rows[0][source], 0)})
+			}
+		}
+	case "bank", "cash":
+		rows, err := nstore.ds.Query([]Query{{
+			Fields: []string{"paiddate", "amount"}, From: "payment", Filters: []Filter{
+				{Field: "trans_id", Comp: "==", Value: transID},
+				{Field: "deleted", Comp: "==", Value: 0}},
+			OrderBy: []string{"id"}}}, trans)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			amounts = append(amounts, IM{"source": "amount", "entrydate": bulkDate(row["paiddate"]), "value": ut.ToFloat(row["amount"], 0)})
+		}
+	}
+	return amounts, nil
+}
+
+// postTrans - create again the journal entries of a trans from the posting rules. Returns the number of new entries.
+func (nstore *NervaStore) postTrans(transID int64, trans interface{}) (count int64, err error) {
+	if err = nstore.clearJournal(transID, trans); err != nil {
+		return count, err
+	}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"t.id", "t.transdate", "t.deleted", "tt.groupvalue as transtype", "dir.groupvalue as direction",
+			"coalesce(t.curr, pl.curr) as curr"},
+		From: `trans t inner join groups tt on t.transtype = tt.id inner join groups dir on t.direction = dir.id
+			left join place pl on t.place_id = pl.id`,
+		Filters: []Filter{{Field: "t.id", Comp: "==", Value: transID}}}}, trans)
+	if err != nil || len(rows) == 0 || ut.ToInteger(rows[0]["deleted"], 0) == 1 {
+		return count, err
+	}
+	transtype := ut.ToString(rows[0]["transtype"], "")
+	rules, err := nstore.journalRules(transtype, ut.ToString(rows[0]["direction"], ""), trans)
+	if err != nil || len(rules) == 0 {
+		return count, err
+	}
+	amounts, err := nstore.journalAmounts(transID, transtype, bulkDate(rows[0]["transdate"]), trans)
+	if err != nil {
+		return count, err
+	}
+	crdate := time.Now().Format(datetimeISOFmt)
+	for _, rule := range rules {
+		for _, amount := range amounts {
+			value := amount["value"].(float64)
+			if amount["source"] != rule["source"] || value == 0 {
+				continue
+			}
+			debitID, creditID := rule["debit_id"], rule["credit_id"]
+			if value < 0 {
+				debitID, creditID, value = creditID, debitID, -value
+			}
+			for _, entry := range []IM{
+				{"account_id": debitID, "debit": value, "credit": float64(0)},
+				{"account_id": creditID, "debit": float64(0), "credit": value}} {
+				entry["trans_id"] = transID
+				entry["postrule_id"] = rule["id"]
+				entry["entrydate"] = amount["entrydate"]
+				entry["curr"] = rows[0]["curr"]
+				entry["description"] = rule["description"]
+				entry["crdate"] = crdate
+				if _, err = nstore.ds.Update(Update{Values: entry, Model: "journal", Trans: trans}); err != nil {
+					return count, err
+				}
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// ledgerPeriod - the date_from and date_to options of the ledger queries
+func ledgerPeriod(options IM) (string, string, error) {
+	dateFrom := ut.ToString(options["date_from"], "1900-01-01")
+	dateTo := ut.ToString(options["date_to"], time.Now().Format(dateFmt))
+	if _, err := time.Parse(dateFmt, dateFrom); err != nil {
+		return dateFrom, dateTo, errors.New(ut.GetMessage("invalid_value") + ": date_from")
+	}
+	if _, err := time.Parse(dateFmt, dateTo); err != nil {
+		return dateFrom, dateTo, errors.New(ut.GetMessage("invalid_value") + ": date_to")
+	}
+	return dateFrom, dateTo, nil
+}
+
+// postJournal - create again the journal entries of a trans (transnumber or trans_id) or all invoice, receipt, bank and cash documents
+func (nstore *NervaStore) postJournal(options IM) (result IM, err error) {
+	result = IM{"trans": int64(0), "rows": int64(0)}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return result, err
+		}
+		return result, errors.New(ut.GetMessage("not_connect"))
+	}
+
+	filters, selected := []Filter{}, true
+	if transnumber := ut.ToString(options["transnumber"], ""); transnumber != "" {
+		filters = append(filters, Filter{Field: "t.transnumber", Comp: "==", Value: transnumber})
+	} else if transID := ut.ToInteger(options["trans_id"], 0); transID > 0 {
+		filters = append(filters, Filter{Field: "t.id", Comp: "==", Value: transID})
+	} else {
+		selected = false
+		filters = append(filters, Filter{Field: "tt.groupvalue", Comp: "in", Value: IL{"invoice", "receipt", "bank", "cash"}})
+	}
+
+	var trans interface{}
+	if _, found := options["trans"]; found {
+		trans = options["trans"]
+	} else if nstore.ds.Properties().Transaction {
+		trans, err = nstore.ds.BeginTransaction()
+		if err != nil {
+			return result, err
+		}
+	}
+	defer func() {
+		pe := recover()
+		if trans != nil {
+			if _, found := options["trans"]; !found {
+				if err != nil || pe != nil {
+					nstore.rollbackTrans(trans)
+				} else {
+					err = nstore.commitTrans(trans)
+				}
+			}
+		}
+		if pe != nil {
+			panic(pe)
+		}
+	}()
+
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"t.id"}, From: "trans t inner join groups tt on t.transtype = tt.id",
+		Filters: filters, OrderBy: []string{"t.id"}}}, trans)
+	if err != nil {
+		return result, err
+	}
+	if len(rows) == 0 && selected {
+		return result, errors.New(ut.GetMessage("invalid_value") + ": transnumber")
+	}
+	for _, row := range rows {
+		count, err := nstore.postTrans(ut.ToInteger(row["id"], 0), trans)
+		if err != nil {
+			return result, err
+		}
+		result["trans"] = result["trans"].(int64) + 1
+		result["rows"] = result["rows"].(int64) + count
+	}
+	return result, nil
+}
+
+// trialBalance - opening balance, debit and credit turnover and closing balance of the accounts
+func (nstore *NervaStore) trialBalance(options IM) (results []IM, err error) {
+	dateFrom, dateTo, err := ledgerPeriod(options)
+	if err != nil {
+		return results, err
+	}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return results, err
+		}
+		return results, errors.New(ut.GetMessage("not_connect"))
+	}
+	params := IM{"qkey": "trial_balance", "date_from": dateFrom, "date_to": dateTo}
+	if curr := ut.ToString(options["curr"], ""); curr != "" {
+		params["curr"] = curr
+	}
+	results, err = nstore.ds.QueryKey(params, options["trans"])
+	if err != nil {
+		return results, err
+	}
+	for _, row := range results {
+		for _, fieldname := range []string{"opening", "debit", "credit", "closing"} {
+			row[fieldname] = ut.ToFloat(row[fieldname], 0)
+		}
+	}
+	return results, nil
+}
+
+// accountStatement - the journal entries of an account with the running balance
+func (nstore *NervaStore) accountStatement(options IM) (result IM, err error) {
+	accnumber := ut.ToString(options["accnumber"], "")
+	if accnumber == "" {
+		return result, errors.New(ut.GetMessage("missing_required_field") + ": accnumber")
+	}
+	dateFrom, dateTo, err := ledgerPeriod(options)
+	if err != nil {
+		return result, err
+	}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return result, err
+		}
+		return result, errors.New(ut.GetMessage("not_connect"))
+	}
+
+	filters := []Filter{{Field: "a.accnumber", Comp: "==", Value: accnumber}}
+	if curr := ut.ToString(options["curr"], ""); curr != "" {
+		filters = append(filters, Filter{Field: "j.curr", Comp: "==", Value: curr})
+	}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"sum(j.debit - j.credit) as balance"}, From: "journal j inner join account a on j.account_id = a.id",
+		Filters: append([]Filter{{Field: "j.entrydate", Comp: "<", Value: dateFrom}}, filters...)}}, options["trans"])
+	if err != nil {
+		return result, err
+	}
+	balance := float64(0)
+	if len(rows) > 0 {
+		balance = ut.ToFloat(rows[0]["balance"], 0)
+	}
+	result = IM{"accnumber": accnumber, "date_from": dateFrom, "date_to": dateTo, "opening": balance}
+
+	rows, err = nstore.ds.Query([]Query{{
+		Fields: []string{"j.id", "j.entrydate", "t.transnumber", "j.curr", "j.debit", "j.credit", "j.description"},
+		From:   "journal j inner join account a on j.account_id = a.id inner join trans t on j.trans_id = t.id",
+		Filters: append([]Filter{
+			{Field: "j.entrydate", Comp: ">=", Value: dateFrom},
+			{Field: "j.entrydate", Comp: "<=", Value: dateTo}}, filters...),
+		OrderBy: []string{"j.entrydate", "j.id"}}}, options["trans"])
+	if err != nil {
+		return result, err
+	}
+	for _, row := range rows {
+		row["entrydate"] = bulkDate(row["entrydate"])
+		row["debit"] = ut.ToFloat(row["debit"], 0)
+		row["credit"] = ut.ToFloat(row["credit"], 0)
+		balance += row["debit"].(float64) - row["credit"].(float64)
+		row["balance"] = balance
+	}
+	result["rows"] = rows
+	result["closing"] = balance
+	return result, nil
+}
@@ -29,6 +29,9 @@ type MI = ModelIndex
 
 const noAction = "NO ACTION"
 
+//DataModelVersion - the schema version of the DataModel. Increase it, if the model gains a table, field or index.
+const DataModelVersion int64 = 3
+
 //DataModel - database table models
 func DataModel() IM {
 	return IM{
@@ -134,6 +137,19 @@ func DataModel() IM {
 				"cfvalue":     MF{Type: "text"},
 				"orderby":     MF{Type: "integer", Default: int64(0), NotNull: true}},
 
+			"ui_script": IM{
+				"_access":     SL{"setting"},
+				"_key":        SL{"scriptkey"},
+				"_fields":     SL{"id", "scriptkey", "description", "nervatype", "hook", "source", "inactive"},
+				"id":          MF{Type: "id"},
+				"scriptkey":   MF{Type: "string", Length: 150, NotNull: true, Unique: true},
+				"description": MF{Type: "string", Length: 255},
+				"nervatype":   MF{Type: "string", Length: 150},
+				"hook": MF{Type: "string", Length: 50, NotNull: true,
+					Requires: IM{"values": SL{"function", "before_update", "after_update", "before_delete", "after_delete"}}},
+				"source":   MF{Type: "text", NotNull: true},
+				"inactive": MF{Type: "integer", Default: int64(0), NotNull: true, Requires: IM{"bool": true}}},
+
 			"link": IM{
 				"_access": SL{"address", "barcode", "contact", "currency", "customer", "employee", "event", "groups", "item",
 					"movement", "payment", "price", "place", "product", "project", "rate", "tax", "tool", "trans", "setting"},
@@ -202,6 +218,24 @@ func DataModel() IM {
 				"ref_id":   MF{Type: "integer", Refname: "refnumber"},
 				"logstate": MF{References: SL{"groups", "CASCADE"}, NotNull: true, Requires: IM{"logstate": SL{}}}},
 
+			"outbox": IM{
+				"_access":   SL{"setting"},
+				"_key":      SL{},
+				"_fields":   SL{"id", "nervatype", "ref_id", "refnumber", "operation", "payload", "username", "crdate", "state", "attempts", "nextdate", "lasterror", "delivered"},
+				"id":        MF{Type: "id"},
+				"nervatype": MF{Type: "string", Length: 150, NotNull: true},
+				"ref_id":    MF{Type: "integer", NotNull: true},
+				"refnumber": MF{Type: "string", Length: 255},
+				"operation": MF{Type: "string", Length: 50, NotNull: true},
+				"payload":   MF{Type: "text"},
+				"username":  MF{Type: "string", Length: 150},
+				"crdate":    MF{Type: "datetime", NotNull: true},
+				"state":     MF{Type: "string", Length: 50, Default: "'pending'", NotNull: true},
+				"attempts":  MF{Type: "integer", Default: int64(0), NotNull: true},
+				"nextdate":  MF{Type: "datetime"},
+				"lasterror": MF{Type: "text"},
+				"delivered": MF{Type: "text"}},
+
 			"numberdef": IM{
 				"_access":     SL{"setting"},
 				"_key":        SL{"numberkey"},
@@ -495,7 +529,50 @@ func DataModel() IM {
 				"transtype":   MF{References: SL{"groups", "RESTRICT", noAction}, NotNull: true, Requires: IM{"transtype": SL{}}},
 				"notes":       MF{Type: "text"},
 				"defpattern":  MF{Type: "integer", Default: int64(0), NotNull: true, Requires: IM{"bool": true}},
-				"deleted":     MF{Type: "integer", Default: int64(0), NotNull: true, Requires: IM{"bool": true}}}},
+				"deleted":     MF{Type: "integer", Default: int64(0), NotNull: true, Requires: IM{"bool": true}}},
+
+			"account": IM{
+				"_access":     SL{"setting"},
+				"_key":        SL{"accnumber"},
+				"_fields":     SL{"id", "accnumber", "description", "acctype", "notes", "inactive", "deleted"},
+				"id":          MF{Type: "id"},
+				"accnumber":   MF{Type: "string", Length: 150, NotNull: true, Unique: true},
+				"description": MF{Type: "string", Length: 255, NotNull: true},
+				"acctype": MF{Type: "string", Length: 50, NotNull: true,
+					Requires: IM{"values": SL{"asset", "liability", "equity", "income", "expense"}}},
+				"notes":    MF{Type: "text"},
+				"inactive": MF{Type: "integer", Default: int64(0), NotNull: true, Requires: IM{"bool": true}},
+				"deleted":  MF{Type: "integer", Default: int64(0), NotNull: true, Requires: IM{"bool": true}}},
+
+			"postrule": IM{
+				"_access": SL{"setting"},
+				"_key":    SL{},
+				"_fields": SL{"id", "transtype", "direction", "source", "debit_id", "credit_id", "description", "deleted"},
+				"id":      MF{Type: "id"},
+				"transtype": MF{References: SL{"groups", "RESTRICT", noAction}, NotNull: true,
+					Requires: IM{"transtype": SL{"invoice", "receipt", "bank", "cash"}}},
+				"direction": MF{References: SL{"groups", "RESTRICT", noAction}, Requires: IM{"direction": SL{}}},
+				"source": MF{Type: "string", Length: 50, NotNull: true,
+					Requires: IM{"values": SL{"netamount", "vatamount", "amount"}}},
+				"debit_id":    MF{References: SL{"account", "RESTRICT", noAction}, NotNull: true, Refname: "accnumber"},
+				"credit_id":   MF{References: SL{"account", "RESTRICT", noAction}, NotNull: true, Refname: "accnumber"},
+				"description": MF{Type: "string", Length: 255},
+				"deleted":     MF{Type: "integer", Default: int64(0), NotNull: true, Requires: IM{"bool": true}}},
+
+			"journal": IM{
+				"_access":     SL{"transtype"},
+				"_key":        SL{},
+				"_fields":     SL{"id", "trans_id", "postrule_id", "account_id", "entrydate", "curr", "debit", "credit", "description", "crdate"},
+				"id":          MF{Type: "id"},
+				"trans_id":    MF{References: SL{"trans", "CASCADE"}, NotNull: true, Refname: "transnumber"},
+				"postrule_id": MF{References: SL{"postrule", "SET NULL", noAction}},
+				"account_id":  MF{References: SL{"account", "RESTRICT", noAction}, NotNull: true, Refname: "accnumber"},
+				"entrydate":   MF{Type: "date", NotNull: true},
+				"curr":        MF{Type: "string", Length: 3},
+				"debit":       MF{Type: "float", Default: float64(0), NotNull: true},
+				"credit":      MF{Type: "float", Default: float64(0), NotNull: true},
+				"description": MF{Type: "string", Length: 255},
+				"crdate":      MF{Type: "datetime", NotNull: true}}},
 
 		"index": map[string]MI{
 			"groups_namevalue_idx":  {Model: "groups", Fields: SL{"groupname", "groupvalue"}, Unique: true},
@@ -549,6 +626,9 @@ func DataModel() IM {
 			"log_logstate_idx":  {Model: "log", Fields: SL{"logstate"}, Unique: false},
 			"log_nervatype_idx": {Model: "log", Fields: SL{"nervatype, ref_id"}, Unique: false},
 
+			"outbox_state_idx":     {Model: "outbox", Fields: SL{"state", "nextdate"}, Unique: false},
+			"outbox_nervatype_idx": {Model: "outbox", Fields: SL{"nervatype", "ref_id"}, Unique: false},
+
 			"numberdef_numberkey_idx": {Model: "numberdef", Fields: SL{"numberkey"}, Unique: true},
 
 			"address_nervatype_idx": {Model: "address", Fields: SL{"nervatype", "ref_id"}, Unique: false},
@@ -649,7 +729,19 @@ func DataModel() IM {
 
 			"pattern_description_idx": {Model: "pattern", Fields: SL{"description"}, Unique: true},
 			"pattern_transtype_idx":   {Model: "pattern", Fields: SL{"transtype"}, Unique: false},
-			"pattern_deleted_idx":     {Model: "rate", Fields: SL{"deleted"}, Unique: false}},
+			"pattern_deleted_idx":     {Model: "rate", Fields: SL{"deleted"}, Unique: false},
+
+			"account_accnumber_idx": {Model: "account", Fields: SL{"accnumber"}, Unique: true},
+			"account_deleted_idx":   {Model: "account", Fields: SL{"deleted"}, Unique: false},
+
+			"postrule_transtype_idx": {Model: "postrule", Fields: SL{"transtype", "direction"}, Unique: false},
+
+			"journal_trans_id_idx":   {Model: "journal", Fields: SL{"trans_id"}, Unique: false},
+			"journal_account_id_idx": {Model: "journal", Fields: SL{"account_id"}, Unique: false},
+			"journal_entrydate_idx":  {Model: "journal", Fields: SL{"entrydate"}, Unique: false},
+
+			"ui_script_scriptkey_idx": {Model: "ui_script", Fields: SL{"scriptkey"}, Unique: true},
+			"ui_script_hook_idx":      {Model: "ui_script", Fields: SL{"nervatype", "hook"}, Unique: false}},
 
 		"data": map[string][]IM{
 			"groups": {
@@ -22,6 +22,8 @@ type NervaStore struct {
 	Customer IM
 	models   IM
 	config   IM
+	journal  map[interface{}][]int64 // the changed trans records of the open transactions (NT_JOURNAL_POSTING)
+	ledger   *bool                   // the journal table exists
 }
 
 // New returns a pointer to a new NervaStore instance.
@@ -30,6 +32,7 @@ func New(driver DataDriver, config IM) (nstore *NervaStore) {
 	nstore.models = DataModel()["model"].(IM)
 	nstore.ds = driver
 	nstore.config = config
+	nstore.journal = make(map[interface{}][]int64)
 	return
 }
 
@@ -340,7 +343,7 @@ func (nstore *NervaStore) updateValidate(nervatype string, checkValues IM, trans
 				checkValues["values"].(IM)[fieldname] = nil
 			} else {
 				checkValues["values"].(IM)[fieldname] = ut.ToString(value, "")
-				if len(checkValues["values"].(IM)[fieldname].(string)) > field.Length {
+				if field.Length > 0 && len(checkValues["values"].(IM)[fieldname].(string)) > field.Length {
 					checkValues["values"].(IM)[fieldname] = checkValues["values"].(IM)[fieldname].(string)[:field.Length]
 				}
 			}
@@ -388,6 +391,10 @@ func (nstore *NervaStore) updateValidate(nervatype string, checkValues IM, trans
 				default:
 					checkValues["values"].(IM)[fieldname] = 0
 				}
+			} else if values, found := field.Requires["values"].(SL); found {
+				if value != nil && !ut.Contains(values, ut.ToString(value, "")) {
+					return nil, errors.New(ut.GetMessage("invalid_value") + ": " + fieldname)
+				}
 			} else if _, found := field.Requires["curr"]; found {
 				if value == "" || value == nil {
 					checkValues["values"].(IM)[fieldname] = nil
@@ -447,6 +454,8 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 	}
 
 	logEnabled := ut.ToBoolean(options["log_enabled"], true)
+	eventEnabled := nstore.eventEnabled(nervatype) && ut.ToBoolean(options["event_enabled"], true)
+	scriptEnabled := nstore.scriptEnabled(nervatype) && ut.ToBoolean(options["script_enabled"], true)
 	validate := ut.ToBoolean(options["validate"], true)
 	insertField := ut.ToBoolean(options["insert_field"], false)
 	insertRow := ut.ToBoolean(options["insert_row"], false)
@@ -461,6 +470,7 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 
 	var trans interface{}
 	var result []IM
+	var current IM
 	if _, found := options["trans"]; found {
 		trans = options["trans"]
 	} else if nstore.ds.Properties().Transaction {
@@ -474,9 +484,9 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 		if trans != nil {
 			if _, found := options["trans"]; !found {
 				if err != nil || pe != nil {
-					nstore.ds.RollbackTransaction(trans)
+					nstore.rollbackTrans(trans)
 				} else {
-					err = nstore.ds.CommitTransaction(trans)
+					err = nstore.commitTrans(trans)
 				}
 			}
 		}
@@ -498,6 +508,7 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 		if len(result) == 0 {
 			return id, errors.New(ut.GetMessage("invalid_id"))
 		}
+		current = result[0]
 		if !updateRow {
 			//readonly record
 			return id, errors.New(ut.GetMessage("disabled_update"))
@@ -509,6 +520,13 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 		return id, errors.New(ut.GetMessage("disabled_insert"))
 	}
 
+	if scriptEnabled {
+		//before_update scripts (the scripts can change the values)
+		if err = nstore.runHooks(nervatype, "before_update", IM{"id": id, "values": values, "current": current}, trans); err != nil {
+			return id, err
+		}
+	}
+
 	//check fieldnames
 	checkValues := IM{"values": IM{}, "fvalues": IM{}, "dvalues": IM{}, "deffield": make([]IM, 0), "fieldvalue": make([]IM, 0)}
 	for fieldname, value := range values {
@@ -597,6 +615,10 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 		if err != nil {
 			return id, err
 		}
+		//general ledger posting
+		if err = nstore.queueJournal(journalTransIDs(nervatype, id, checkValues["values"].(IM), current), trans); err != nil {
+			return id, err
+		}
 	}
 
 	if len(checkValues["fvalues"].(IM)) > 0 {
@@ -669,6 +691,13 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 		}
 	}
 
+	if scriptEnabled {
+		//after_update scripts
+		if err = nstore.runHooks(nervatype, "after_update", IM{"id": id, "values": values, "current": current}, trans); err != nil {
+			return id, err
+		}
+	}
+
 	if logEnabled {
 		err = nstore.insertLog(IM{"trans": trans, "logstate": "update", "nervatype": nervatype, "ref_id": id})
 		if err != nil {
@@ -676,6 +705,25 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 		}
 	}
 
+	if eventEnabled {
+		operation := "update"
+		if current == nil {
+			operation = "insert"
+		}
+		changed := IM{}
+		for fieldname, value := range checkValues["values"].(IM) {
+			changed[fieldname] = value
+		}
+		for fieldname, value := range checkValues["fvalues"].(IM) {
+			changed[fieldname] = value
+		}
+		err = nstore.insertEvent(IM{"trans": trans, "nervatype": nervatype, "ref_id": id, "operation": operation,
+			"refnumber": nstore.eventRefnumber(nervatype, checkValues["values"].(IM), current), "values": changed})
+		if err != nil {
+			return id, err
+		}
+	}
+
 	return
 }
 
@@ -683,9 +731,9 @@ func (nstore *NervaStore) UpdateData(options IM) (id int64, err error) {
 func (nstore *NervaStore) GetInfofromRefnumber(options IM) (IM, error) {
 
 	var md1 = SM{"deffield": "fieldname", "employee": "empnumber",
-		"pattern": "description", "project": "pronumber", "tool": "serial"}
+		"pattern": "description", "project": "pronumber", "tool": "serial", "account": "accnumber"}
 	var md2 = SM{"currency": "curr", "numberdef": "numberkey",
-		"ui_report": "reportkey", "ui_menu": "menukey"}
+		"ui_report": "reportkey", "ui_menu": "menukey", "ui_script": "scriptkey"}
 	var infoData = IM{"qkey": "refnumber->id", "nervatype": "", "refnumber": "",
 		"useDeleted": false, "extraInfo": false}
 	var err error
@@ -1027,6 +1075,8 @@ func (nstore *NervaStore) DeleteData(options IM) (err error) {
 		refID = info["id"].(int64)
 	}
 	logEnabled := ut.ToBoolean(options["log_enabled"], true)
+	eventEnabled := nstore.eventEnabled(nervatype) && ut.ToBoolean(options["event_enabled"], true)
+	scriptEnabled := nstore.scriptEnabled(nervatype) && ut.ToBoolean(options["script_enabled"], true)
 
 	if ok, err := nstore.connected(); !ok || err != nil {
 		if err != nil {
@@ -1037,12 +1087,12 @@ func (nstore *NervaStore) DeleteData(options IM) (err error) {
 
 	//check integrity
 	switch nervatype {
-	case "address", "barcode", "contact", "event", "fieldvalue", "item", "link", "log",
-		"movement", "pattern", "payment", "price", "rate":
+	case "address", "barcode", "contact", "event", "fieldvalue", "item", "journal", "link", "log",
+		"movement", "outbox", "pattern", "payment", "postrule", "price", "rate":
 	case "numberdef":
 		//protected, always false
 		return errors.New(ut.GetMessage("integrity_error"))
-	case "currency", "customer", "deffield", "employee", "groups", "place", "product", "project", "tax", "tool", "trans":
+	case "account", "currency", "customer", "deffield", "employee", "groups", "place", "product", "project", "tax", "tool", "trans":
 		rows, err := nstore.ds.QueryKey(IM{"qkey": "integrity", "nervatype": nervatype, "ref_id": refID}, nil)
 		if err != nil {
 			return err
@@ -1091,9 +1141,9 @@ func (nstore *NervaStore) DeleteData(options IM) (err error) {
 		if trans != nil {
 			if _, found := options["trans"]; !found {
 				if err != nil || pe != nil {
-					nstore.ds.RollbackTransaction(trans)
+					nstore.rollbackTrans(trans)
 				} else {
-					err = nstore.ds.CommitTransaction(trans)
+					err = nstore.commitTrans(trans)
 				}
 			}
 		}
@@ -1102,6 +1152,46 @@ func (nstore *NervaStore) DeleteData(options IM) (err error) {
 		}
 	}()
 
+	deleted := IM{}
+	if eventEnabled {
+		// the event payload of the deleted record
+		query := []Query{{
+			Fields: []string{"*"}, From: nervatype, Filters: []Filter{
+				{Field: "id", Comp: "==", Value: refID}}}}
+		rows, err := nstore.ds.Query(query, trans)
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			deleted = rows[0]
+			if refnumber == "" {
+				refnumber = nstore.eventRefnumber(nervatype, rows[0])
+			}
+		}
+	}
+
+	if scriptEnabled {
+		//before_delete scripts
+		if err = nstore.runHooks(nervatype, "before_delete", IM{"id": refID}, trans); err != nil {
+			return err
+		}
+	}
+
+	var journalTrans []int64
+	switch nervatype {
+	case "trans":
+		journalTrans = []int64{refID}
+	case "item", "payment":
+		query := []Query{{
+			Fields: []string{"trans_id"}, From: nervatype, Filters: []Filter{
+				{Field: "id", Comp: "==", Value: refID}}}}
+		rows, err := nstore.ds.Query(query, trans)
+		if err != nil {
+			return err
+		}
+		journalTrans = journalTransIDs(nervatype, refID, rows...)
+	}
+
 	var data Update
 	if logicalDelete {
 		data = Update{Values: IM{"deleted": 1}, IDKey: refID, Model: nervatype, Trans: trans}
@@ -1113,6 +1203,11 @@ func (nstore *NervaStore) DeleteData(options IM) (err error) {
 		return err
 	}
 
+	//general ledger posting
+	if err = nstore.queueJournal(journalTrans, trans); err != nil {
+		return err
+	}
+
 	if !logicalDelete {
 		//delete all fieldvalue records
 		result, err := nstore.ds.QueryKey(IM{"qkey": "delete_deffields", "nervatype": nervatype, "ref_id": refID}, trans)
@@ -1131,6 +1226,13 @@ func (nstore *NervaStore) DeleteData(options IM) (err error) {
 		}
 	}
 
+	if scriptEnabled {
+		//after_delete scripts
+		if err = nstore.runHooks(nervatype, "after_delete", IM{"id": refID}, trans); err != nil {
+			return err
+		}
+	}
+
 	if logEnabled {
 		//insert log
 		err := nstore.insertLog(IM{"trans": trans, "logstate": "delete", "nervatype": nervatype, "ref_id": refID})
@@ -1139,6 +1241,14 @@ func (nstore *NervaStore) DeleteData(options IM) (err error) {
 		}
 	}
 
+	if eventEnabled {
+		err := nstore.insertEvent(IM{"trans": trans, "nervatype": nervatype, "ref_id": refID,
+			"operation": "delete", "refnumber": refnumber, "values": deleted})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
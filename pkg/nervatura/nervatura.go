@@ -1,5 +1,7 @@
 package nervatura
 
+import "time"
+
 //TimeLayout DateTime format
 const TimeLayout = "2006-01-02 15:04:05"
 
@@ -30,13 +32,20 @@ type DataDriver interface {
 	} //Returns the database connection
 	CreateConnection(string, string) error                                                  //Create a new database connection
 	CreateDatabase(logData []SM) ([]SM, error)                                              //Create a Nervatura Database
+	UpgradeDatabase(logData []SM, dryRun bool) ([]SM, error)                                //Create the missing tables, fields and indexes of the DataModel
+	ClearData(model string, transaction interface{}) error                                  //Delete all rows of a table
+	RestoreData(model string, rows []IM, transaction interface{}) error                     //Insert rows with their id values and update the id sequence
 	CheckHashtable(hashtable string) error                                                  //Check/create a password ref. table
 	UpdateHashtable(hashtable, refname, value string) error                                 //Set a password
 	Query(queries []Query, transaction interface{}) ([]IM, error)                           //Query is a basic nosql friendly queries the database
 	QuerySQL(sqlString string, params []interface{}, transaction interface{}) ([]IM, error) //Executes a SQL query
+	QueryLimit(sqlString string, params []interface{}, maxRows int64, timeout time.Duration,
+		transaction interface{}) ([]IM, error) //Executes a SQL query with a max. row number and a timeout
 	QueryKey(options IM, transaction interface{}) ([]IM, error)                             //Complex data queries
+	PrmString(index int) string                                                             //Returns the query parameter placeholder of the database engine
 	Update(options Update) (int64, error)                                                   //Update is a basic nosql friendly update/insert/delete and returns the update/insert id
 	BeginTransaction() (interface{}, error)                                                 //Begins a transaction and returns an it
+	BeginReadTransaction() (interface{}, error)                                             //Begins a transaction with a consistent view of the database
 	CommitTransaction(trans interface{}) error                                              //Commit a transaction
 	RollbackTransaction(trans interface{}) error                                            //Rollback a transaction
 }
@@ -45,8 +54,10 @@ type DataDriver interface {
 type Filter struct {
 	Or    bool   // and (False) or (True)
 	Field string //Fieldname and alias
-	Comp  string //==,!=,<,<=,>,>=,in,is
+	Comp  string //==,!=,<,<=,>,>=,in,is,like,between
 	Value interface{}
+	Group []Filter //Nested filter group (Field, Comp and Value are not used)
+	Meta  string   //Metadata (deffield) fieldtype. The Field is a deffield fieldname
 }
 
 //Query data desc. type
@@ -56,6 +67,8 @@ type Query struct {
 	Filters []Filter
 	Filter  string //filter string (eg. "id=1 and field='value'")
 	OrderBy []string
+	Limit   int64 //Max. number of rows (0: all rows)
+	Offset  int64 //Number of skipped rows
 }
 
 //Update data desc. type
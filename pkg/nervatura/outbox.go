@@ -0,0 +1,399 @@
+package nervatura
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+const (
+	eventStatePending   string = "pending"
+	eventStateDelivered string = "delivered"
+	eventStateDead      string = "dead"
+)
+
+func (nstore *NervaStore) eventEnabled(nervatype string) bool {
+	switch nervatype {
+	case "outbox", "log":
+		return false
+	}
+	return ut.ToBoolean(nstore.config["NT_EVENT_ENABLED"], false)
+}
+
+// eventRefnumber - the public key value of the record, if the model has a single key field
+func (nstore *NervaStore) eventRefnumber(nervatype string, values ...IM) string {
+	keyField := nstore.getTableKey(nervatype)
+	if keyField == "" {
+		return ""
+	}
+	for index := 0; index < len(values); index++ {
+		if value, found := values[index][keyField]; found && value != nil {
+			return ut.ToString(value, "")
+		}
+	}
+	return ""
+}
+
+// insertEvent - write a change event into the outbox table (in the caller transaction)
+func (nstore *NervaStore) insertEvent(options IM) error {
+	nervatype := ut.ToString(options["nervatype"], "")
+	if nervatype == "" {
+		return errors.New(ut.GetMessage("missing_nervatype"))
+	}
+	operation := ut.ToString(options["operation"], "")
+	if operation == "" {
+		return errors.New(ut.GetMessage("missing_required_field") + ": operation")
+	}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		return errors.New(ut.GetMessage("not_connect"))
+	}
+
+	crdate := time.Now().Format(datetimeISOFmt)
+	values := IM{
+		"nervatype": nervatype, "ref_id": ut.ToInteger(options["ref_id"], 0),
+		"operation": operation, "crdate": crdate, "nextdate": crdate,
+		"state": eventStatePending, "attempts": 0}
+	if refnumber := ut.ToString(options["refnumber"], ""); refnumber != "" {
+		values["refnumber"] = refnumber
+	}
+	if nstore.User != nil {
+		values["username"] = nstore.User.Username
+	}
+	if changed, found := options["values"].(IM); found && len(changed) > 0 {
+		payload, err := ut.ConvertToByte(changed)
+		if err != nil {
+			return err
+		}
+		values["payload"] = string(payload)
+	}
+	_, err := nstore.ds.Update(Update{Values: values, Model: "outbox", Trans: options["trans"]})
+	return err
+}
+
+func eventSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (nstore *NervaStore) eventBody(event IM) ([]byte, error) {
+	body := IM{
+		"id":        event["id"],
+		"database":  nstore.ds.Connection().Alias,
+		"nervatype": event["nervatype"],
+		"ref_id":    event["ref_id"],
+		"refnumber": event["refnumber"],
+		"operation": event["operation"],
+		"username":  event["username"],
+		"crdate":    event["crdate"],
+		"values":    IM{},
+	}
+	if payload := ut.ToString(event["payload"], ""); payload != "" {
+		values := IM{}
+		if err := ut.ConvertFromByte([]byte(payload), &values); err != nil {
+			return nil, err
+		}
+		body["values"] = values
+	}
+	return ut.ConvertToByte(body)
+}
+
+func postEvent(client *http.Client, endpoint, secret string, eventID int64, body []byte) error {
+	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Nervatura-Event", strconv.FormatInt(eventID, 10))
+	if secret != "" {
+		req.Header.Set("X-Nervatura-Signature", eventSignature(secret, body))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.New(endpoint + ": " + resp.Status)
+	}
+	return nil
+}
+
+// dispatchEvents - deliver the pending outbox events to the webhook endpoints
+func (nstore *NervaStore) dispatchEvents(options IM) (IM, error) {
+	result := IM{eventStateDelivered: 0, "retry": 0, eventStateDead: 0}
+
+	webhooks, valid := options["webhooks"].([]string)
+	if !valid {
+		webhooks = []string{}
+		for _, endpoint := range strings.Split(ut.ToString(nstore.config["NT_EVENT_WEBHOOKS"], ""), ",") {
+			if strings.TrimSpace(endpoint) != "" {
+				webhooks = append(webhooks, strings.TrimSpace(endpoint))
+			}
+		}
+	}
+	if len(webhooks) == 0 {
+		return result, errors.New(ut.GetMessage("missing_required_field") + ": webhooks")
+	}
+	secret := ut.ToString(options["secret"], ut.ToString(nstore.config["NT_EVENT_SECRET"], ""))
+	maxAttempts := ut.ToInteger(options["max_attempts"], ut.ToInteger(nstore.config["NT_EVENT_MAX_ATTEMPTS"], 5))
+	retryDelay := ut.ToFloat(options["retry_delay"], ut.ToFloat(nstore.config["NT_EVENT_RETRY_DELAY"], 10))
+	timeout := ut.ToFloat(options["timeout"], ut.ToFloat(nstore.config["NT_EVENT_TIMEOUT"], 10))
+
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return result, err
+		}
+		return result, errors.New(ut.GetMessage("not_connect"))
+	}
+
+	events, err := nstore.ds.QueryKey(IM{"qkey": "outbox_pending",
+		"nextdate": time.Now().Format(datetimeISOFmt), "limit": options["limit"]}, nil)
+	if err != nil {
+		return result, err
+	}
+
+	client := &http.Client{Timeout: time.Duration(timeout * float64(time.Second))}
+	for index := 0; index < len(events); index++ {
+		event := events[index]
+		eventID := ut.ToInteger(event["id"], 0)
+		// the endpoints of the earlier attempts (JSON list) are not posted again by the retry
+		delivered := []string{}
+		if value := ut.ToString(event["delivered"], ""); value != "" {
+			if err := ut.ConvertFromByte([]byte(value), &delivered); err != nil {
+				return result, err
+			}
+		}
+		body, err := nstore.eventBody(event)
+		var sendErr []string
+		if err != nil {
+			sendErr = append(sendErr, err.Error())
+		} else {
+			for _, endpoint := range webhooks {
+				if ut.Contains(delivered, endpoint) {
+					continue
+				}
+				if err := postEvent(client, endpoint, secret, eventID, body); err != nil {
+					sendErr = append(sendErr, err.Error())
+				} else {
+					delivered = append(delivered, endpoint)
+				}
+			}
+		}
+
+		values := IM{}
+		if len(sendErr) == 0 {
+			values["state"] = eventStateDelivered
+			values["lasterror"] = nil
+			result[eventStateDelivered] = result[eventStateDelivered].(int) + 1
+		} else {
+			if len(delivered) > 0 {
+				value, err := ut.ConvertToByte(delivered)
+				if err != nil {
+					return result, err
+				}
+				values["delivered"] = string(value)
+			}
+			attempts := ut.ToInteger(event["attempts"], 0) + 1
+			values["attempts"] = attempts
+			values["lasterror"] = strings.Join(sendErr, "; ")
+			if attempts >= maxAttempts {
+				values["state"] = eventStateDead
+				result[eventStateDead] = result[eventStateDead].(int) + 1
+			} else {
+				delay := time.Duration(retryDelay*math.Pow(2, float64(attempts-1))) * time.Second
+				values["nextdate"] = time.Now().Add(delay).Format(datetimeISOFmt)
+				result["retry"] = result["retry"].(int) + 1
+			}
+		}
+		_, err = nstore.ds.Update(Update{Values: values, Model: "outbox", IDKey: eventID})
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// eventFilterValue - returns the id value of a reference field filter (e.g. transtype=invoice, customer_id=DMCUST/00001)
+func (nstore *NervaStore) eventFilterValue(nervatype, fieldname string, value interface{}) (interface{}, error) {
+	field, found := nstore.models[nervatype].(IM)[fieldname].(MF)
+	svalue, valid := value.(string)
+	if !found || !valid || field.References == nil {
+		return value, nil
+	}
+	if _, err := strconv.ParseInt(svalue, 10, 64); err == nil {
+		return value, nil
+	}
+	info := IM{"nervatype": field.References[0], "refnumber": svalue}
+	if field.References[0] == "groups" {
+		info["refnumber"] = fieldname + "~" + svalue
+	}
+	refValues, err := nstore.GetInfofromRefnumber(info)
+	if err != nil {
+		return value, err
+	}
+	return refValues["id"], nil
+}
+
+// eventMatch - check the filters against the record values
+func (nstore *NervaStore) eventMatch(nervatype string, values IM, filters IM, filterValues IM) (bool, error) {
+	for fieldname, value := range filters {
+		if _, found := nstore.models[nervatype].(IM)[fieldname]; !found {
+			continue
+		}
+		key := nervatype + "." + fieldname
+		if _, found := filterValues[key]; !found {
+			fvalue, err := nstore.eventFilterValue(nervatype, fieldname, value)
+			if err != nil {
+				return false, err
+			}
+			filterValues[key] = fvalue
+		}
+		if rvalue, found := values[fieldname]; !found || ut.ToString(rvalue, "") != ut.ToString(filterValues[key], "") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+/*
+eventRows - the current record values of the not deleted event records by nervatype. The rows are filtered
+by the access rights (ui_audit, transfilter) of the user, the nervatypes without read right are missing.
+*/
+func (api *API) eventRows(state *auditState, events []IM) (map[string]map[int64]IM, error) {
+	ids := map[string][]string{}
+	for _, event := range events {
+		nervatype := ut.ToString(event["nervatype"], "")
+		if _, found := api.NStore.models[nervatype]; found && event["operation"] != "delete" {
+			ids[nervatype] = append(ids[nervatype], ut.ToString(event["ref_id"], "0"))
+		}
+	}
+	results := map[string]map[int64]IM{}
+	for nervatype, values := range ids {
+		filter, err := api.auditFilter(state, nervatype)
+		if errors.Is(err, ErrPermissionDenied) {
+			continue
+		}
+		if err != nil {
+			return results, err
+		}
+		rows, err := api.NStore.ds.Query([]Query{{
+			Fields: []string{"*"}, From: nervatype, Filter: filter, Filters: []Filter{
+				{Field: "id", Comp: "in", Value: strings.Join(values, ",")}}}}, nil)
+		if err != nil {
+			return results, err
+		}
+		results[nervatype] = map[int64]IM{}
+		for _, row := range rows {
+			results[nervatype][ut.ToInteger(row["id"], 0)] = row
+		}
+	}
+	return results, nil
+}
+
+// getEvents - returns the readable outbox events after the cursor id
+func (api *API) getEvents(options IM) (results IM, err error) {
+	nstore := api.NStore
+	results = IM{"cursor": ut.ToInteger(options["cursor"], 0), "events": []IM{}}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return results, err
+		}
+		return results, errors.New(ut.GetMessage("not_connect"))
+	}
+
+	if options["cursor"] == nil {
+		rows, err := nstore.ds.Query([]Query{{Fields: []string{"coalesce(max(id),0) as id"}, From: "outbox"}}, nil)
+		if err != nil {
+			return results, err
+		}
+		if len(rows) > 0 {
+			results["cursor"] = ut.ToInteger(rows[0]["id"], 0)
+		}
+		return results, nil
+	}
+
+	params := IM{"qkey": "outbox_events", "cursor": results["cursor"], "limit": options["limit"]}
+	for _, key := range []string{"nervatype", "operation"} {
+		if values, valid := options[key].([]string); valid && len(values) > 0 {
+			params[key] = strings.Join(values, ",")
+		} else if value := ut.ToString(options[key], ""); value != "" {
+			params[key] = value
+		}
+	}
+	rows, err := nstore.ds.QueryKey(params, nil)
+	if err != nil {
+		return results, err
+	}
+	state, err := api.getAuditState()
+	if err != nil {
+		return results, err
+	}
+	filters, _ := options["filters"].(IM)
+	var records map[string]map[int64]IM
+	if state != nil || len(filters) > 0 {
+		if records, err = api.eventRows(state, rows); err != nil {
+			return results, err
+		}
+	}
+	filterValues := IM{}
+	for index := 0; index < len(rows); index++ {
+		results["cursor"] = ut.ToInteger(rows[index]["id"], 0)
+		nervatype := ut.ToString(rows[index]["nervatype"], "")
+		if _, found := nstore.models[nervatype]; !found {
+			continue
+		}
+		// the insert and update payloads contain only the changed fields, the delete payload is the deleted record
+		values := IM{}
+		if payload := ut.ToString(rows[index]["payload"], ""); payload != "" {
+			if err := ut.ConvertFromByte([]byte(payload), &values); err != nil {
+				return results, err
+			}
+		}
+		if records != nil {
+			if rows[index]["operation"] == "delete" {
+				if err := api.auditRecord(state, nervatype, values, "read"); errors.Is(err, ErrPermissionDenied) {
+					continue
+				} else if err != nil {
+					return results, err
+				}
+			} else if row, found := records[nervatype][ut.ToInteger(rows[index]["ref_id"], 0)]; found {
+				values = row
+			} else if state != nil {
+				continue
+			}
+		}
+		if len(filters) > 0 {
+			match, err := nstore.eventMatch(nervatype, values, filters, filterValues)
+			if err != nil {
+				return results, err
+			}
+			if !match {
+				continue
+			}
+		}
+		event := IM{}
+		for _, fieldname := range []string{"id", "nervatype", "ref_id", "refnumber", "operation", "username", "crdate"} {
+			event[fieldname] = rows[index][fieldname]
+		}
+		event["values"] = IM{}
+		if payload := ut.ToString(rows[index]["payload"], ""); payload != "" {
+			values := IM{}
+			if err := ut.ConvertFromByte([]byte(payload), &values); err != nil {
+				return results, err
+			}
+			event["values"] = values
+		}
+		results["events"] = append(results["events"].([]IM), event)
+	}
+	return results, nil
+}
@@ -0,0 +1,321 @@
+package nervatura
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// rateDate - the ratedate or posdate option value (default: today)
+func rateDate(options IM, key string) (string, error) {
+	ratedate := ut.ToString(options[key], time.Now().Format(dateFmt))
+	if len(ratedate) > len(dateFmt) {
+		ratedate = ratedate[:len(dateFmt)]
+	}
+	if _, err := time.Parse(dateFmt, ratedate); err != nil {
+		return ratedate, errors.New(ut.GetMessage("invalid_value") + ": " + key)
+	}
+	return ratedate, nil
+}
+
+/*
+rateValue - the latest ratevalue (the value of the currency in the default currency) on or before
+the ratedate. The rates without place are used if the planumber is empty. Returns 0 if the rate is missing.
+*/
+func (nstore *NervaStore) rateValue(curr, ratedate, ratetype, planumber string, trans interface{}) (float64, error) {
+	date, _ := time.Parse(dateFmt, ratedate)
+	filters := []Filter{
+		{Field: "r.curr", Comp: "==", Value: curr},
+		{Field: "rt.groupvalue", Comp: "==", Value: ratetype},
+		{Field: "r.ratedate", Comp: "<", Value: date.AddDate(0, 0, 1).Format(dateFmt)},
+		{Field: "r.deleted", Comp: "==", Value: 0}}
+	if planumber != "" {
+		filters = append(filters, Filter{Field: "p.planumber", Comp: "==", Value: planumber})
+	} else {
+		filters = append(filters, Filter{Field: "r.place_id", Comp: "is", Value: "null"})
+	}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields:  []string{"r.ratevalue"},
+		From:    "rate r inner join groups rt on r.ratetype = rt.id left join place p on r.place_id = p.id",
+		Filters: filters, OrderBy: []string{"r.ratedate desc", "r.id desc"}, Limit: 1}}, trans)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+	return ut.ToFloat(rows[0]["ratevalue"], 0), nil
+}
+
+/*
+currencyRate - the exchange rate between two currencies on the ratedate. The rates of the foreign
+currencies are the values in the default currency, so the rate of two foreign currencies is calculated
+via the default currency (triangulation).
+*/
+func (nstore *NervaStore) currencyRate(fromCurr, toCurr, defaultCurr, ratedate, ratetype, planumber string,
+	trans interface{}) (float64, error) {
+	rates := []float64{1, 1}
+	for index, curr := range []string{fromCurr, toCurr} {
+		if curr == defaultCurr {
+			continue
+		}
+		value, err := nstore.rateValue(curr, ratedate, ratetype, planumber, trans)
+		if err != nil {
+			return 0, err
+		}
+		if value == 0 {
+			return 0, errors.New(ut.GetMessage("missing_rate") + " " + curr + " " + ratedate)
+		}
+		rates[index] = value
+	}
+	return rates[0] / rates[1], nil
+}
+
+// currencyDigit - the digit value of a currency
+func (nstore *NervaStore) currencyDigit(curr string, trans interface{}) (int, error) {
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"digit"}, From: "currency",
+		Filters: []Filter{{Field: "curr", Comp: "==", Value: curr}}}}, trans)
+	if err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, errors.New(ut.GetMessage("invalid_value") + ": " + curr)
+	}
+	return int(ut.ToInteger(rows[0]["digit"], 2)), nil
+}
+
+// convertCurrency - convert an amount between two currencies by the latest rates on or before the ratedate
+func (nstore *NervaStore) convertCurrency(options IM) (result IM, err error) {
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return result, err
+		}
+		return result, errors.New(ut.GetMessage("not_connect"))
+	}
+	ratedate, err := rateDate(options, "ratedate")
+	if err != nil {
+		return result, err
+	}
+	defaultCurr, err := nstore.settingValue("default_currency", options["trans"])
+	if err != nil {
+		return result, err
+	}
+	fromCurr := ut.ToString(options["from_curr"], defaultCurr)
+	toCurr := ut.ToString(options["to_curr"], defaultCurr)
+	if _, err = nstore.currencyDigit(fromCurr, options["trans"]); err != nil {
+		return result, err
+	}
+	digit, err := nstore.currencyDigit(toCurr, options["trans"])
+	if err != nil {
+		return result, err
+	}
+	rate, err := nstore.currencyRate(fromCurr, toCurr, defaultCurr, ratedate,
+		ut.ToString(options["ratetype"], "rate"), ut.ToString(options["planumber"], ""), options["trans"])
+	if err != nil {
+		return result, err
+	}
+	amount := ut.ToFloat(options["amount"], 0)
+	return IM{"amount": amount, "from_curr": fromCurr, "to_curr": toCurr, "ratedate": ratedate,
+		"rate": roundFloat(rate, 6), "value": roundFloat(amount*rate, digit)}, nil
+}
+
+// transAcrate - the exchange rate (ratetype: rate) of a foreign currency document on the transdate (0: missing rate)
+func (nstore *NervaStore) transAcrate(curr string, transdate interface{}, trans interface{}) (float64, error) {
+	defaultCurr, err := nstore.settingValue("default_currency", trans)
+	if err != nil || curr == "" || curr == defaultCurr {
+		return 0, err
+	}
+	ratedate, err := rateDate(IM{"transdate": transdate}, "transdate")
+	if err != nil {
+		return 0, err
+	}
+	return nstore.rateValue(curr, ratedate, "rate", "", trans)
+}
+
+// fxOpenLinks - the sum of the link_qty values of the payment-trans links by the link ref_id_1 or ref_id_2 values
+// (the payments until the posdate, or all payments with an empty posdate)
+func (nstore *NervaStore) fxOpenLinks(refField, posdate string, trans interface{}) (map[int64]float64, error) {
+	links := map[int64]float64{}
+	filters := []Filter{
+		{Field: "l.deleted", Comp: "==", Value: 0},
+		{Field: "nt1.groupvalue", Comp: "==", Value: "payment"},
+		{Field: "nt2.groupvalue", Comp: "==", Value: "trans"},
+		{Field: "p.deleted", Comp: "==", Value: 0},
+		{Field: "fv.deleted", Comp: "==", Value: 0}}
+	if posdate != "" {
+		date, _ := time.Parse(dateFmt, posdate)
+		filters = append(filters, Filter{Field: "p.paiddate", Comp: "<", Value: date.AddDate(0, 0, 1).Format(dateFmt)})
+	}
+	rows, err := nstore.ds.Query([]Query{{
+		Fields: []string{"l." + refField + " as ref_id", "fv.value"},
+		From: `link l inner join groups nt1 on l.nervatype_1 = nt1.id inner join groups nt2 on l.nervatype_2 = nt2.id
+			inner join payment p on l.ref_id_1 = p.id
+			inner join fieldvalue fv on fv.ref_id = l.id and fv.fieldname = 'link_qty'`,
+		Filters: filters}}, trans)
+	if err != nil {
+		return links, err
+	}
+	for _, row := range rows {
+		links[ut.ToInteger(row["ref_id"], 0)] += ut.ToFloat(row["value"], 0)
+	}
+	return links, nil
+}
+
+/*
+fxRevaluation - the revaluation of the open (not paid) foreign currency invoices and the not allocated
+foreign currency payments on the posdate. The amounts of the incoming invoices are negative.
+The book value is calculated by the acrate of the document (or the rate on the document date),
+the value by the rate on the posdate, and the difference is the unrealized exchange gain (or loss)
+in the default currency.
+*/
+func (nstore *NervaStore) fxRevaluation(options IM) (results []IM, err error) {
+	results = []IM{}
+	if ok, err := nstore.connected(); !ok || err != nil {
+		if err != nil {
+			return results, err
+		}
+		return results, errors.New(ut.GetMessage("not_connect"))
+	}
+	trans := options["trans"]
+	posdate, err := rateDate(options, "posdate")
+	if err != nil {
+		return results, err
+	}
+	nextdate, _ := time.Parse(dateFmt, posdate)
+	ratetype := ut.ToString(options["ratetype"], "rate")
+	defaultCurr, err := nstore.settingValue("default_currency", trans)
+	if err != nil {
+		return results, err
+	}
+	digit, err := nstore.currencyDigit(defaultCurr, trans)
+	if err != nil {
+		return results, err
+	}
+
+	invoices, err := nstore.ds.Query([]Query{{
+		Fields: []string{"t.id", "tt.groupvalue as transtype", "dir.groupvalue as direction", "t.transnumber",
+			"t.transdate as docdate", "t.curr", "t.acrate"},
+		From: "trans t inner join groups tt on t.transtype = tt.id inner join groups dir on t.direction = dir.id",
+		Filters: []Filter{
+			{Field: "tt.groupvalue", Comp: "==", Value: "invoice"},
+			{Field: "t.deleted", Comp: "==", Value: 0},
+			{Field: "t.paid", Comp: "==", Value: 0},
+			{Field: "t.curr", Comp: "!=", Value: defaultCurr},
+			{Field: "t.transdate", Comp: "<", Value: nextdate.AddDate(0, 0, 1).Format(dateFmt)}},
+		OrderBy: []string{"t.curr", "t.transdate", "t.id"}}}, trans)
+	if err != nil {
+		return results, err
+	}
+	rows := []IM{}
+	if len(invoices) > 0 {
+		ids := IL{}
+		for _, invoice := range invoices {
+			ids = append(ids, invoice["id"])
+		}
+		items, err := nstore.ds.Query([]Query{{
+			Fields: []string{"trans_id", "amount"}, From: "item",
+			Filters: []Filter{
+				{Field: "trans_id", Comp: "in", Value: ids},
+				{Field: "deleted", Comp: "==", Value: 0}}}}, trans)
+		if err != nil {
+			return results, err
+		}
+		amounts := map[int64]float64{}
+		for _, item := range items {
+			amounts[ut.ToInteger(item["trans_id"], 0)] += ut.ToFloat(item["amount"], 0)
+		}
+		paid, err := nstore.fxOpenLinks("ref_id_2", posdate, trans)
+		if err != nil {
+			return results, err
+		}
+		for _, invoice := range invoices {
+			id := ut.ToInteger(invoice["id"], 0)
+			invoice["amount"] = amounts[id] - paid[id]
+			if invoice["direction"] == "in" {
+				invoice["amount"] = -invoice["amount"].(float64)
+			}
+			rows = append(rows, invoice)
+		}
+	}
+
+	payments, err := nstore.ds.Query([]Query{{
+		Fields: []string{"p.id", "tt.groupvalue as transtype", "dir.groupvalue as direction", "t.transnumber",
+			"p.paiddate as docdate", "pl.curr", "t.acrate", "p.amount"},
+		From: `payment p inner join trans t on p.trans_id = t.id inner join groups tt on t.transtype = tt.id
+			inner join groups dir on t.direction = dir.id inner join place pl on t.place_id = pl.id`,
+		Filters: []Filter{
+			{Field: "p.deleted", Comp: "==", Value: 0},
+			{Field: "t.deleted", Comp: "==", Value: 0},
+			{Field: "pl.curr", Comp: "!=", Value: defaultCurr},
+			{Field: "p.paiddate", Comp: "<", Value: nextdate.AddDate(0, 0, 1).Format(dateFmt)}},
+		OrderBy: []string{"pl.curr", "p.paiddate", "p.id"}}}, trans)
+	if err != nil {
+		return results, err
+	}
+	allocated, err := nstore.fxOpenLinks("ref_id_1", posdate, trans)
+	if err != nil {
+		return results, err
+	}
+	for _, payment := range payments {
+		amount := ut.ToFloat(payment["amount"], 0)
+		payment["amount"] = amount - math.Copysign(allocated[ut.ToInteger(payment["id"], 0)], amount)
+		rows = append(rows, payment)
+	}
+
+	for _, row := range rows {
+		amount := row["amount"].(float64)
+		if math.Abs(amount) < stockEpsilon {
+			continue
+		}
+		curr := ut.ToString(row["curr"], "")
+		docdate := ut.ToString(bulkDate(row["docdate"]), "")
+		bookRate := ut.ToFloat(row["acrate"], 0)
+		if bookRate == 0 {
+			if bookRate, err = nstore.currencyRate(curr, defaultCurr, defaultCurr, docdate, ratetype, "", trans); err != nil {
+				return results, err
+			}
+		}
+		rate, err := nstore.currencyRate(curr, defaultCurr, defaultCurr, posdate, ratetype, "", trans)
+		if err != nil {
+			return results, err
+		}
+		bookValue, value := roundFloat(amount*bookRate, digit), roundFloat(amount*rate, digit)
+		results = append(results, IM{
+			"posdate": posdate, "ratetype": ratetype, "transtype": row["transtype"], "direction": row["direction"],
+			"transnumber": row["transnumber"], "docdate": docdate, "curr": curr, "amount": amount,
+			"book_rate": bookRate, "book_value": bookValue, "rate": rate, "value": value,
+			"difference": roundFloat(value-bookValue, digit)})
+	}
+	return results, nil
+}
+
+func init() {
+	functions := []ServerFunction{
+		{Name: "convertCurrency",
+			Description: "Convert an amount between two currencies by the latest rates on or before a date",
+			Args: []FunctionArg{
+				{Name: "amount", Type: "number", Required: true, Description: "Amount"},
+				{Name: "from_curr", Type: "string", Description: "Currency code of the amount (default: default_currency)"},
+				{Name: "to_curr", Type: "string", Description: "Currency code of the result (default: default_currency)"},
+				{Name: "ratedate", Type: "date", Description: "Rate date (default: today)"},
+				{Name: "ratetype", Type: "string", Description: "rate, buy, sell or average (default: rate)"},
+				{Name: "planumber", Type: "string", Description: "Place of the rates (default: rates without place)"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.convertCurrency(options)
+			}},
+		{Name: "fxRevaluation",
+			Description: "Exchange rate revaluation of the open foreign currency invoices and payments",
+			Args: []FunctionArg{
+				{Name: "posdate", Type: "date", Description: "Revaluation date (default: today)"},
+				{Name: "ratetype", Type: "string", Description: "rate, buy, sell or average (default: rate)"}},
+			Audit: []FunctionAudit{{Nervatype: "trans", Transtype: "invoice", Right: "readonly"}},
+			Call: func(nstore *NervaStore, options IM) (interface{}, error) {
+				return nstore.fxRevaluation(options)
+			}},
+	}
+	for _, fn := range functions {
+		if err := RegisterFunction(fn); err != nil {
+			panic(err)
+		}
+	}
+}
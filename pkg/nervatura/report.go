@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"encoding/csv"
 	"errors"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +14,29 @@ import (
 	ut "github.com/nervatura/nervatura-service/pkg/utils"
 )
 
+// mssqlReportSQL - the SQL Server forms of the default datasource expressions
+var mssqlReportSQL = []struct {
+	expr *regexp.Regexp
+	repl string
+}{
+	{regexp.MustCompile(`(?i)substr\(cast\(([\w.]+) as char\(10\)\),\s*1,\s*10\)`), "CONVERT(VARCHAR(10), $1, 120)"},
+	{regexp.MustCompile(`(?i)substr\(cast\(([\w.]+) as char\(16\)\),\s*1,\s*16\)`), "CONVERT(VARCHAR(16), $1, 120)"},
+	{regexp.MustCompile(`(?i)substr\(cast\(([\w.]+) as char\(16\)\),\s*12,\s*5\)`), "CONVERT(VARCHAR(5), $1, 108)"},
+	{regexp.MustCompile(`(?i)cast\(([\w.]+) as date\)\s*-\s*(@\w+)`), "DATEDIFF(day, $2, $1)"},
+	{regexp.MustCompile(`(?i)\bsubstr\(`), "substring("},
+	{regexp.MustCompile(`(?i)\blength\(`), "len("},
+}
+
+// getReportSourceSQL - translate the default datasource of a report template to the database engine
+func getReportSourceSQL(engine, sqlStr string) string {
+	if engine == "mssql" {
+		for _, item := range mssqlReportSQL {
+			sqlStr = item.expr.ReplaceAllString(sqlStr, item.repl)
+		}
+	}
+	return sqlStr
+}
+
 func (nstore *NervaStore) getReportRefValues(options IM) (nervatype string, refValues IM, err error) {
 	nervatype = ut.ToString(options["nervatype"], "")
 	refnumber := ut.ToString(options["refnumber"], "")
@@ -77,70 +102,161 @@ func (nstore *NervaStore) getReportHead(options IM) (report IM, err error) {
 	return data, err
 }
 
-func (nstore *NervaStore) getReportDataWhere(reportTemplate, filters IM, sources []SM) (SM, error) {
-	whereStr := SM{}
+// getReportFilterValue - convert a filter value to the fieldtype of the template field
+func getReportFilterValue(fieldname, fieldtype string, value interface{}) (interface{}, error) {
+	invalid := errors.New(ut.GetMessage("invalid_value") + " - " + fieldname + ": " + ut.ToString(value, ""))
+	svalue := strings.TrimSpace(ut.ToString(value, ""))
+	if len(svalue) > 1 && strings.HasPrefix(svalue, "'") && strings.HasSuffix(svalue, "'") {
+		// quoted values of the older clients
+		svalue = svalue[1 : len(svalue)-1]
+	}
+	switch fieldtype {
+	case "date":
+		for _, layout := range []string{"2006-01-02", time.RFC3339, "2006-01-02T15:04:05", TimeLayout} {
+			if tm, err := time.Parse(layout, svalue); err == nil {
+				return tm.Format("2006-01-02"), nil
+			}
+		}
+		return nil, invalid
+	case "integer":
+		ivalue, err := strconv.ParseInt(svalue, 10, 64)
+		if err != nil {
+			return nil, invalid
+		}
+		return ivalue, nil
+	case "float":
+		fvalue, err := strconv.ParseFloat(svalue, 64)
+		if err != nil {
+			return nil, invalid
+		}
+		return fvalue, nil
+	case "bool":
+		bvalue, err := strconv.ParseBool(svalue)
+		if err != nil {
+			return nil, invalid
+		}
+		if bvalue {
+			return int64(1), nil
+		}
+		return int64(0), nil
+	}
+	return svalue, nil
+}
+
+/*
+getReportDataWhere - validate the filters by the fields definitions of the template and convert the values
+to the fieldtypes. Returns the bound values (@fieldname) and the conditions of the @where_str by datasets
+("nods": all datasets).
+*/
+func (nstore *NervaStore) getReportDataWhere(reportTemplate, filters IM) (values IM, whereStr SM, err error) {
+	values, whereStr = IM{}, SM{}
 	fields := IM{}
 	if tFields, found := reportTemplate["fields"].(IM); found {
 		fields = tFields
 	}
 
-	setWhere := func(wkey, fieldname, rel string) {
-		fstr := ""
-		if fields[fieldname].(IM)["sql"] == nil || fields[fieldname].(IM)["sql"] == "" {
-			fstr = fieldname + rel + filters[fieldname].(string)
-		} else {
-			fstr = strings.ReplaceAll(fstr, "@"+fieldname, filters[fieldname].(string))
+	for fieldname, value := range filters {
+		if fieldname == "@id" {
+			if values["id"], err = getReportFilterValue(fieldname, "integer", value); err != nil {
+				return values, whereStr, err
+			}
+			continue
+		}
+		field, found := fields[fieldname].(IM)
+		if !found {
+			return values, whereStr, errors.New(ut.GetMessage("invalid_fieldname") + ": " + fieldname)
 		}
-		if _, found := whereStr[wkey]; !found {
-			whereStr[wkey] = " and " + fstr
-		} else {
-			whereStr[wkey] = whereStr[wkey] + " and " + fstr
+		fieldtype := ut.ToString(field["fieldtype"], "string")
+		if values[fieldname], err = getReportFilterValue(fieldname, fieldtype, value); err != nil {
+			return values, whereStr, err
+		}
+		if field["wheretype"] == "where" {
+			fstr := ut.ToString(field["sqlstr"], ut.ToString(field["sql"], ""))
+			if fstr == "" {
+				rel := " = "
+				if fieldtype == "string" {
+					rel = " like "
+				}
+				fstr = fieldname + rel + "@" + fieldname
+			}
+			wkey := ut.ToString(field["dataset"], "nods")
+			whereStr[wkey] += " and " + fstr
 		}
 	}
+	return values, whereStr, nil
+}
 
-	for fieldname, value := range filters {
-		if _, found := fields[fieldname]; !found {
-			if fieldname == "@id" {
-				for index := 0; index < len(sources); index++ {
-					ds := sources[index]
-					ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], "@id", value.(string))
-				}
-			} else {
-				return whereStr, errors.New(ut.GetMessage("invalid_fieldname") + ": " + fieldname)
+/*
+getReportBindSQL - replace the @name references of the report SQL with the query parameter placeholders
+of the database engine. The string values of the SQL are not changed, except the '@name' (quoted) references.
+*/
+func (nstore *NervaStore) getReportBindSQL(sqlString string, values IM) (string, []interface{}, error) {
+	params := make([]interface{}, 0)
+	var sb strings.Builder
+	src := []rune(sqlString)
+	isName := func(r rune) bool {
+		return r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+	}
+	bind := func(name string) bool {
+		value, found := values[name]
+		if found {
+			params = append(params, value)
+			sb.WriteString(nstore.ds.PrmString(len(params)))
+		}
+		return found
+	}
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '\'':
+			end := i + 1
+			for end < len(src) && src[end] != '\'' {
+				end++
 			}
-		} else {
-			rel := " = "
-			if fields[fieldname].(IM)["fieldtype"] == "date" {
-				filters[fieldname] = "'" + ut.ToString(filters[fieldname], "") + "'"
+			if end >= len(src) {
+				end = len(src) - 1
 			}
-			if fields[fieldname].(IM)["fieldtype"] == "string" {
-				fieldtype := ut.ToString(filters[fieldname], "")
-				if !strings.HasPrefix(fieldtype, "'") {
-					filters[fieldname] = "'" + fieldtype + "'"
-				}
-				rel = " like "
+			literal := string(src[i : end+1])
+			if !(strings.HasPrefix(literal, "'@") && strings.HasSuffix(literal, "'") && bind(literal[2:len(literal)-1])) {
+				sb.WriteString(literal)
 			}
-			if fields[fieldname].(IM)["wheretype"] == "where" && fields[fieldname].(IM)["dataset"] == nil {
-				setWhere("nods", fieldname, rel)
+			i = end
+
+		case src[i] == '@' && i+1 < len(src) && isName(src[i+1]):
+			end := i + 1
+			for end < len(src) && isName(src[end]) {
+				end++
 			}
-			for index := 0; index < len(sources); index++ {
-				ds := sources[index]
-				if fields[fieldname].(IM)["wheretype"] == "where" {
-					if fields[fieldname].(IM)["dataset"] == ds["dataset"] {
-						setWhere(fields[fieldname].(IM)["dataset"].(string), fieldname, rel)
-					}
-				} else {
-					if fields[fieldname].(IM)["sql"] == nil || fields[fieldname].(IM)["sql"] == "" {
-						ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], "@"+fieldname, filters[fieldname].(string))
-					} else {
-						fstr := strings.ReplaceAll(fields[fieldname].(IM)["sql"].(string), "@"+fieldname, filters[fieldname].(string))
-						ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], "@"+fieldname, fstr)
-					}
+			name := string(src[i+1 : end])
+			if !bind(name) {
+				if name == "where_str" || name == "id" {
+					return sqlString, params, errors.New(ut.GetMessage("missing_required_field") + ": @" + name)
 				}
+				sb.WriteString("@" + name)
 			}
+			i = end - 1
+
+		default:
+			sb.WriteRune(src[i])
 		}
 	}
-	return whereStr, nil
+	return sb.String(), params, nil
+}
+
+// getReportFunction - the rows of a registered server function datasource ({"function": "name"}) of a report template
+func (nstore *NervaStore) getReportFunction(key string, values IM) ([]IM, error) {
+	options := IM{}
+	for name, value := range values {
+		options[name] = value
+	}
+	result, err := nstore.GetService(key, options)
+	if err != nil {
+		return nil, err
+	}
+	rows, valid := result.([]IM)
+	if !valid {
+		return nil, errors.New(ut.GetMessage("invalid_value") + ": " + key)
+	}
+	return rows, nil
 }
 
 func (nstore *NervaStore) getReportData(reportTemplate, filters IM, sources []SM) (datarows IM, err error) {
@@ -150,29 +266,41 @@ func (nstore *NervaStore) getReportData(reportTemplate, filters IM, sources []SM
 		for key, label := range labels {
 			for si := 0; si < len(sources); si++ {
 				sources[si]["sqlstr"] = strings.ReplaceAll(
-					sources[si]["sqlstr"], "={{"+key+"}}", label.(string))
+					sources[si]["sqlstr"], "={{"+key+"}}", strings.ReplaceAll(ut.ToString(label, ""), "'", "''"))
 			}
 		}
 	}
 
-	whereStr, err := nstore.getReportDataWhere(reportTemplate, filters, sources)
+	values, whereStr, err := nstore.getReportDataWhere(reportTemplate, filters)
 	if err != nil {
 		return datarows, err
 	}
+	fields, _ := reportTemplate["fields"].(IM)
 
 	trows := 0
 	const whereKey = "@where_str"
 	for index := 0; index < len(sources); index++ {
 		ds := sources[index]
-		if _, found := whereStr[ds["dataset"]]; found {
-			ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], whereKey, whereStr[ds["dataset"]])
+		if ds["function"] != "" {
+			if datarows[ds["dataset"]], err = nstore.getReportFunction(ds["function"], values); err != nil {
+				return datarows, err
+			}
+			trows += len(datarows[ds["dataset"]].([]IM))
+			continue
 		}
-		if _, found := whereStr["nods"]; found {
-			ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], whereKey, whereStr["nods"])
+		ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], whereKey, whereStr[ds["dataset"]]+whereStr["nods"])
+		for fieldname, field := range fields {
+			// the "in" fields with an SQL expression
+			if fstr := ut.ToString(field.(IM)["sqlstr"], ut.ToString(field.(IM)["sql"], "")); fstr != "" &&
+				field.(IM)["wheretype"] != "where" && values[fieldname] != nil {
+				ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], "@"+fieldname, fstr)
+			}
+		}
+		sqlString, params, err := nstore.getReportBindSQL(ds["sqlstr"], values)
+		if err != nil {
+			return datarows, err
 		}
-		ds["sqlstr"] = strings.ReplaceAll(ds["sqlstr"], whereKey, "")
-		params := make([]interface{}, 0)
-		datarows[ds["dataset"]], err = nstore.ds.QuerySQL(ds["sqlstr"], params, nil)
+		datarows[ds["dataset"]], err = nstore.ds.QuerySQL(sqlString, params, nil)
 		if err != nil {
 			return datarows, err
 		}
@@ -236,21 +364,75 @@ func (nstore *NervaStore) getReportCSV(reportTemplate, datarows IM, base64Encodi
 	return IM{"filetype": "csv", "template": b.String(), "data": nil}, nil
 }
 
-func (nstore *NervaStore) getReportPDF(options, datarows IM, jsonTemplate string) (result IM, err error) {
-	orientation := ut.ToString(options["orientation"], "p")
-	size := ut.ToString(options["size"], "a4")
-	rpt := report.New(orientation, size,
-		ut.ToString(nstore.config["NT_FONT_FAMILY"], ""), ut.ToString(nstore.config["NT_FONT_DIR"], ""))
-	rpt.ImagePath = ut.ToString(nstore.config["NT_REPORT_DIR"], "")
-	if err = rpt.LoadJSONDefinition(jsonTemplate); err != nil {
+// getReportGrid - the XLSX or HTML output of a CSV template: a datagrid for every details list,
+// and the optional column totals of the "totals" fieldnames
+func (nstore *NervaStore) getReportGrid(reportTemplate, datarows IM, output string) (result IM, err error) {
+	rpt := report.New()
+	if meta, found := reportTemplate["meta"].(IM); found {
+		rpt.SetReportValue("Title", ut.ToString(meta["repname"], rpt.Title))
+	}
+	labels, _ := reportTemplate["data"].(IM)["labels"].(IM)
+	if details, valid := reportTemplate["details"].([]interface{}); valid {
+		for di := 0; di < len(details); di++ {
+			databind := ut.ToString(details[di].(IM)["databind"], "")
+			columns, cols := details[di].(IM)["columns"].([]interface{})
+			data, found := datarows[databind].([]IM)
+			if !found || !cols {
+				continue
+			}
+			totals := []string{}
+			if tvalues, valid := details[di].(IM)["totals"].([]interface{}); valid {
+				for ti := 0; ti < len(tvalues); ti++ {
+					totals = append(totals, ut.ToString(tvalues[ti], ""))
+				}
+			}
+			grid, err := rpt.AppendElement("details", "datagrid",
+				IM{"name": ut.ToString(details[di].(IM)["name"], databind), "databind": databind})
+			if err != nil {
+				return result, err
+			}
+			for ci := 0; ci < len(columns); ci++ {
+				fieldname := ut.ToString(columns[ci], "")
+				column := IM{"fieldname": fieldname, "label": ut.ToString(labels[fieldname], fieldname)}
+				if ut.Contains(totals, fieldname) {
+					total := 0.0
+					for i := 0; i < len(data); i++ {
+						total += ut.ToFloat(data[i][fieldname], 0)
+					}
+					column["footer"] = strconv.FormatFloat(roundFloat(total, 2), 'f', 2, 64)
+				}
+				if _, err := rpt.AppendElement(grid, "column", column); err != nil {
+					return result, err
+				}
+			}
+		}
+	}
+	if err = setReportData(rpt, datarows); err != nil {
 		return result, err
 	}
+	return getReportOutput(rpt, output)
+}
+
+// getReportOutput - the XLSX or HTML output of a report
+func getReportOutput(rpt *report.Report, output string) (result IM, err error) {
+	if output == "html" {
+		return IM{"filetype": "html", "template": rpt.Save2Html(), "data": nil}, nil
+	}
+	xlsx, err := rpt.Save2Xlsx()
+	if err != nil {
+		return result, err
+	}
+	return IM{"filetype": "xlsx", "template": xlsx, "data": nil}, nil
+}
+
+// setReportData - set the report datasets with string values
+func setReportData(rpt *report.Report, datarows IM) error {
 	for key, value := range datarows {
 		switch v := value.(type) {
 		case string, map[string]string, []map[string]string:
 			_, err := rpt.SetData(key, v)
 			if err != nil {
-				return result, err
+				return err
 			}
 		case map[string]interface{}:
 			values := SM{}
@@ -259,7 +441,7 @@ func (nstore *NervaStore) getReportPDF(options, datarows IM, jsonTemplate string
 			}
 			_, err := rpt.SetData(key, values)
 			if err != nil {
-				return result, err
+				return err
 			}
 		case []map[string]interface{}:
 			ivalues := []SM{}
@@ -272,10 +454,28 @@ func (nstore *NervaStore) getReportPDF(options, datarows IM, jsonTemplate string
 			}
 			_, err := rpt.SetData(key, ivalues)
 			if err != nil {
-				return result, err
+				return err
 			}
 		}
 	}
+	return nil
+}
+
+func (nstore *NervaStore) getReportPDF(options, datarows IM, jsonTemplate string) (result IM, err error) {
+	orientation := ut.ToString(options["orientation"], "p")
+	size := ut.ToString(options["size"], "a4")
+	rpt := report.New(orientation, size,
+		ut.ToString(nstore.config["NT_FONT_FAMILY"], ""), ut.ToString(nstore.config["NT_FONT_DIR"], ""))
+	rpt.ImagePath = ut.ToString(nstore.config["NT_REPORT_DIR"], "")
+	if err = rpt.LoadJSONDefinition(jsonTemplate); err != nil {
+		return result, err
+	}
+	if err = setReportData(rpt, datarows); err != nil {
+		return result, err
+	}
+	if output := ut.ToString(options["output"], ""); output == "xlsx" || output == "html" {
+		return getReportOutput(rpt, output)
+	}
 	rpt.CreateReport()
 
 	switch options["output"] {
@@ -299,7 +499,7 @@ func (nstore *NervaStore) getReportPDF(options, datarows IM, jsonTemplate string
 	}
 }
 
-//getReport - server-side PDF and CSV report generation
+//getReport - server-side PDF, CSV, XLSX and HTML report generation
 func (nstore *NervaStore) getReport(options IM) (results IM, err error) {
 
 	results = IM{
@@ -333,10 +533,15 @@ func (nstore *NervaStore) getReport(options IM) (results IM, err error) {
 			for dkey, ds := range tSources {
 				dsValues := SM{"dataset": dkey, "sqlstr": ""}
 				for engine, sql := range ds.(IM) {
-					if (engine == "default" && dsValues["sqlstr"] == "") || engine == cengine {
-						dsValues["sqlstr"] = sql.(string)
+					if engine == "function" {
+						dsValues["function"] = ut.ToString(sql, "")
+					} else if (engine == "default" && dsValues["sqlstr"] == "") || engine == cengine {
+						dsValues["sqlstr"] = ut.ToString(sql, "")
 					}
 				}
+				if _, found := ds.(IM)[cengine]; !found {
+					dsValues["sqlstr"] = getReportSourceSQL(cengine, dsValues["sqlstr"])
+				}
 				sources = append(sources, dsValues)
 			}
 		}
@@ -354,6 +559,9 @@ func (nstore *NervaStore) getReport(options IM) (results IM, err error) {
 			"data":     results["datarows"]}, nil
 	}
 	reptype := ut.ToString(results["report"].(IM)["reptype"], "")
+	if output := ut.ToString(options["output"], ""); reptype == "csv" && (output == "xlsx" || output == "html") {
+		return nstore.getReportGrid(reportTemplate, results["datarows"].(IM), output)
+	}
 	if reptype == "csv" {
 		base64Encoding := (options["output"] == "base64")
 		return nstore.getReportCSV(reportTemplate, results["datarows"].(IM), base64Encoding)
@@ -0,0 +1,295 @@
+package nervatura
+
+import (
+	"errors"
+	"reflect"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+	"github.com/robertkrimen/otto"
+)
+
+// scriptStackLimit - the max. call stack depth of a script
+const scriptStackLimit = 1000
+
+// errScriptTimeout - the interrupt value of the NT_SCRIPT_TIMEOUT
+var errScriptTimeout = errors.New(ut.GetMessage("script_timeout"))
+
+// scriptEnabled - the NT_SCRIPT_ENABLED setting and the nervatypes without hook scripts
+func (nstore *NervaStore) scriptEnabled(nervatype string) bool {
+	switch nervatype {
+	case "ui_script", "outbox", "log":
+		return false
+	}
+	return ut.ToBoolean(nstore.config["NT_SCRIPT_ENABLED"], false)
+}
+
+// scriptValue - convert the exported script values to IM, []interface{} and the basic types
+func scriptValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case IM:
+		result := IM{}
+		for key, item := range v {
+			result[key] = scriptValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for index, item := range v {
+			result[index] = scriptValue(item)
+		}
+		return result
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8 {
+		result := make([]interface{}, rv.Len())
+		for index := 0; index < rv.Len(); index++ {
+			result[index] = scriptValue(rv.Index(index).Interface())
+		}
+		return result
+	}
+	return value
+}
+
+// scriptError - throw a Go error as a script exception
+func scriptError(vm *otto.Otto, err error) {
+	panic(vm.MakeCustomError("NervaturaError", err.Error()))
+}
+
+// scriptArg - the IM value of a function argument
+func scriptArg(vm *otto.Otto, call otto.FunctionCall, index int) IM {
+	value, _ := call.Argument(index).Export()
+	options, valid := scriptValue(value).(IM)
+	if !valid {
+		scriptError(vm, errors.New(ut.GetMessage("invalid_value")))
+	}
+	return options
+}
+
+// scriptResult - the script value of a Go result
+func scriptResult(vm *otto.Otto, result interface{}, err error) otto.Value {
+	if err != nil {
+		scriptError(vm, err)
+	}
+	value, err := vm.ToValue(result)
+	if err != nil {
+		scriptError(vm, err)
+	}
+	return value
+}
+
+/*
+scriptBindings - the Get, Update, nextNumber and getPriceValue functions of the scripts.
+The functions run in the transaction and with the access rights (ui_audit, transfilter) of the caller,
+and the Update does not call the hook scripts.
+*/
+func (nstore *NervaStore) scriptBindings(vm *otto.Otto, trans interface{}) {
+	api := &API{NStore: nstore}
+	vm.Set("Get", func(call otto.FunctionCall) otto.Value {
+		result, err := api.getData(scriptArg(vm, call, 0), trans)
+		return scriptResult(vm, result, err)
+	})
+	vm.Set("Update", func(call otto.FunctionCall) otto.Value {
+		nervatype := call.Argument(0).String()
+		if _, found := api.NStore.models[nervatype]; !found {
+			scriptError(vm, errors.New(ut.GetMessage("invalid_nervatype")+" "+nervatype))
+		}
+		value, _ := call.Argument(1).Export()
+		data := []IM{}
+		switch v := scriptValue(value).(type) {
+		case IM:
+			data = append(data, v)
+		case []interface{}:
+			for _, item := range v {
+				if row, valid := item.(IM); valid {
+					data = append(data, row)
+				}
+			}
+		}
+		var err error
+		if nervatype == "trans" {
+			if data, err = api.updateTransInfo(data); err != nil {
+				scriptError(vm, err)
+			}
+		}
+		if data, err = api.updateSetKeys(nervatype, data); err != nil {
+			scriptError(vm, err)
+		}
+		if data, err = api.updateCheckInfo(nervatype, data); err != nil {
+			scriptError(vm, err)
+		}
+		if err = api.auditUpdate(nervatype, data); err != nil {
+			scriptError(vm, err)
+		}
+		results := []int64{}
+		for _, values := range data {
+			id, err := api.NStore.UpdateData(IM{"nervatype": nervatype, "values": values, "validate": true,
+				"insert_row": true, "insert_field": true, "script_enabled": false, "trans": trans})
+			if err != nil {
+				scriptError(vm, err)
+			}
+			results = append(results, id)
+		}
+		return scriptResult(vm, results, nil)
+	})
+	vm.Set("nextNumber", func(call otto.FunctionCall) otto.Value {
+		options := scriptArg(vm, call, 0)
+		options["trans"] = trans
+		result, err := api.NStore.nextNumber(options)
+		return scriptResult(vm, result, err)
+	})
+	vm.Set("getPriceValue", func(call otto.FunctionCall) otto.Value {
+		result, err := api.NStore.getPriceValue(scriptArg(vm, call, 0))
+		return scriptResult(vm, result, err)
+	})
+}
+
+/*
+runScript - run a ui_script source (JavaScript) in a sandboxed interpreter. The interpreter has only the
+script bindings and the global values, and the run is interrupted after the NT_SCRIPT_TIMEOUT.
+Returns the value of the last statement.
+*/
+func (nstore *NervaStore) runScript(script IM, globals IM, trans interface{}) (result interface{}, err error) {
+	scriptkey := ut.ToString(script["scriptkey"], "")
+	vm := otto.New()
+	vm.SetStackDepthLimit(scriptStackLimit)
+	vm.Set("console", otto.UndefinedValue())
+	nstore.scriptBindings(vm, trans)
+	for name, value := range globals {
+		if err = vm.Set(name, value); err != nil {
+			return nil, err
+		}
+	}
+
+	timeout := ut.ToFloat(nstore.config["NT_SCRIPT_TIMEOUT"], 5)
+	vm.Interrupt = make(chan func(), 1)
+	timer := time.AfterFunc(time.Duration(timeout*float64(time.Second)), func() {
+		vm.Interrupt <- func() {
+			panic(errScriptTimeout)
+		}
+	})
+	defer func() {
+		timer.Stop()
+		if pe := recover(); pe != nil {
+			if pe != errScriptTimeout {
+				panic(pe)
+			}
+			err = errors.New(ut.GetMessage("script_timeout") + ": " + scriptkey)
+		}
+	}()
+
+	value, err := vm.Run(ut.ToString(script["source"], ""))
+	if err != nil {
+		return nil, errors.New(ut.GetMessage("script_error") + " " + scriptkey + ": " + err.Error())
+	}
+	result, _ = value.Export()
+	return scriptValue(result), nil
+}
+
+// getScripts - the active ui_script rows
+func (nstore *NervaStore) getScripts(filters []Filter, trans interface{}) ([]IM, error) {
+	return nstore.ds.Query([]Query{{
+		Fields: []string{"scriptkey", "description", "nervatype", "hook", "source"}, From: "ui_script",
+		Filters: append([]Filter{{Field: "inactive", Comp: "==", Value: 0}}, filters...),
+		OrderBy: []string{"scriptkey"}}}, trans)
+}
+
+/*
+runHooks - run the before_update, after_update, before_delete or after_delete scripts of a nervatype
+in the caller transaction. An error (exception) of a script aborts the operation.
+*/
+func (nstore *NervaStore) runHooks(nervatype, hook string, globals IM, trans interface{}) error {
+	scripts, err := nstore.getScripts([]Filter{
+		{Field: "nervatype", Comp: "==", Value: nervatype},
+		{Field: "hook", Comp: "==", Value: hook}}, trans)
+	if err != nil {
+		return err
+	}
+	globals["nervatype"] = nervatype
+	globals["hook"] = hook
+	for _, script := range scripts {
+		if _, err = nstore.runScript(script, globals, trans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scriptAudit - the object rights of a function script: the ui_script (setting) and the optional nervatype of the script
+func scriptAudit(script IM) *ServerFunction {
+	fn := &ServerFunction{Name: ut.ToString(script["scriptkey"], ""),
+		Audit: []FunctionAudit{{Nervatype: "setting", Right: "readonly"}}}
+	if nervatype := ut.ToString(script["nervatype"], ""); nervatype != "" {
+		fn.Audit = append(fn.Audit, FunctionAudit{Nervatype: nervatype, Right: "update"})
+	}
+	return fn
+}
+
+// scriptFunction - call a function hook script of the database by the API.Function
+func (api *API) scriptFunction(key string, values IM) (result interface{}, err error) {
+	if !api.NStore.scriptEnabled("") {
+		return nil, errors.New(ut.GetMessage("unknown_method") + ": " + key)
+	}
+	if ok, err := api.NStore.connected(); !ok || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New(ut.GetMessage("not_connect"))
+	}
+	scripts, err := api.NStore.getScripts([]Filter{
+		{Field: "scriptkey", Comp: "==", Value: key},
+		{Field: "hook", Comp: "==", Value: "function"}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(scripts) == 0 {
+		return nil, errors.New(ut.GetMessage("unknown_method") + ": " + key)
+	}
+	if err = api.checkFunctionAudit(scriptAudit(scripts[0])); err != nil {
+		return nil, err
+	}
+
+	var trans interface{}
+	if api.NStore.ds.Properties().Transaction {
+		trans, err = api.NStore.ds.BeginTransaction()
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer func() {
+		pe := recover()
+		if trans != nil {
+			if err != nil || pe != nil {
+				api.NStore.rollbackTrans(trans)
+			} else {
+				err = api.NStore.commitTrans(trans)
+			}
+		}
+		if pe != nil {
+			panic(pe)
+		}
+	}()
+	return api.NStore.runScript(scripts[0], IM{"params": values}, trans)
+}
+
+// scriptFunctionList - the function hook scripts of the database
+func (api *API) scriptFunctionList() ([]IM, error) {
+	results := []IM{}
+	if !api.NStore.scriptEnabled("") {
+		return results, nil
+	}
+	scripts, err := api.NStore.getScripts([]Filter{{Field: "hook", Comp: "==", Value: "function"}}, nil)
+	if err != nil {
+		return results, err
+	}
+	for _, script := range scripts {
+		if _, found := GetFunction(ut.ToString(script["scriptkey"], "")); !found {
+			audit := []IM{}
+			for _, right := range scriptAudit(script).Audit {
+				audit = append(audit, IM{"nervatype": right.Nervatype, "transtype": right.Transtype, "right": right.Right})
+			}
+			results = append(results, IM{"name": script["scriptkey"], "description": ut.ToString(script["description"], ""),
+				"args": []IM{}, "audit": audit, "transaction": true, "script": true})
+		}
+	}
+	return results, nil
+}
@@ -1,10 +1,12 @@
 package nervatura
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"mime/quotedprintable"
 	"net/smtp"
 	"strconv"
 	"strings"
@@ -13,24 +15,6 @@ import (
 	ut "github.com/nervatura/nervatura-service/pkg/utils"
 )
 
-//GetService - call Nervatura server side functions and services
-func (nstore *NervaStore) GetService(key string, options IM) (interface{}, error) {
-
-	switch key {
-
-	case "nextNumber":
-		return nstore.nextNumber(options)
-
-	case "getPriceValue":
-		return nstore.getPriceValue(options)
-
-	case "sendEmail":
-		return nstore.sendEmail(options)
-
-	}
-	return nil, errors.New(ut.GetMessage("unknown_method") + ": " + key)
-}
-
 //nextNumber - get the next value from the numberdef table (transnumber, custnumber, partnumber etc.)
 func (nstore *NervaStore) nextNumber(options IM) (retnumber string, err error) {
 
@@ -62,9 +46,9 @@ func (nstore *NervaStore) nextNumber(options IM) (retnumber string, err error) {
 		if trans != nil {
 			if _, found := options["trans"]; !found {
 				if err != nil || pe != nil {
-					nstore.ds.RollbackTransaction(trans)
+					nstore.rollbackTrans(trans)
 				} else {
-					err = nstore.ds.CommitTransaction(trans)
+					err = nstore.commitTrans(trans)
 				}
 			}
 		}
@@ -235,6 +219,20 @@ func (nstore *NervaStore) getPriceValue(options IM) (results IM, err error) {
 	return results, nil
 }
 
+// emailReportParams - the report options of an email attachment or body report
+func emailReportParams(report IM, output string) IM {
+	params := IM{"output": output}
+	for _, key := range []string{"reportkey", "report_id", "nervatype", "refnumber"} {
+		if _, found := report[key]; found {
+			params[key] = report[key]
+		}
+	}
+	if _, found := report["ref_id"]; found {
+		params["filters"] = IM{"@id": report["ref_id"]}
+	}
+	return params
+}
+
 func (nstore *NervaStore) sendEmail(options IM) (results IM, err error) {
 	results = IM{"result": "OK"}
 
@@ -246,6 +244,24 @@ func (nstore *NervaStore) sendEmail(options IM) (results IM, err error) {
 		return results, errors.New(ut.GetMessage("invalid_provider"))
 	}
 
+	// the access rights of the body and the attachment reports are checked before the sending
+	api := &API{NStore: nstore}
+	var bodyParams IM
+	if bodyReport, found := emailOpt["report"].(IM); found {
+		bodyParams = emailReportParams(bodyReport, "html")
+		if err := api.auditReport(bodyParams); err != nil {
+			return results, err
+		}
+	}
+	attachments, _ := emailOpt["attachments"].([]interface{})
+	attachmentParams := make([]IM, len(attachments))
+	for index := 0; index < len(attachments); index++ {
+		attachmentParams[index] = emailReportParams(attachments[index].(IM), "pdf")
+		if err := api.auditReport(attachmentParams[index]); err != nil {
+			return results, err
+		}
+	}
+
 	delimeter := "**=myohmy689407924327"
 	username := ut.ToString(nstore.config["NT_SMTP_USER"], "")
 	password := ut.ToString(nstore.config["NT_SMTP_PASSWORD"], "")
@@ -302,47 +318,44 @@ func (nstore *NervaStore) sendEmail(options IM) (results IM, err error) {
 
 	emailMsg += fmt.Sprintf("\r\n--%s\r\n", delimeter)
 	emailMsg += "Content-Type: text/html; charset=\"utf-8\"\r\n"
-	emailMsg += "Content-Transfer-Encoding: 7bit\r\n"
-	if _, found := emailOpt["html"]; found {
-		emailMsg += fmt.Sprintf("\r\n%s\r\n", ut.ToString(emailOpt["html"], ""))
-	} else {
-		emailMsg += fmt.Sprintf("\r\n%s\r\n", ut.ToString(emailOpt["text"], ""))
+	emailMsg += "Content-Transfer-Encoding: quoted-printable\r\n"
+	body := ut.ToString(emailOpt["text"], "")
+	if bodyParams != nil {
+		report, err := nstore.getReport(bodyParams)
+		if err != nil {
+			return results, err
+		}
+		body = fmt.Sprintf("%s", report["template"])
+	} else if _, found := emailOpt["html"]; found {
+		body = ut.ToString(emailOpt["html"], "")
 	}
+	// the long lines and the 8bit characters of the HTML body are encoded (max. 76 characters per line)
+	var qpBody bytes.Buffer
+	qpWriter := quotedprintable.NewWriter(&qpBody)
+	if _, err := qpWriter.Write([]byte(body)); err != nil {
+		return results, err
+	}
+	if err := qpWriter.Close(); err != nil {
+		return results, err
+	}
+	emailMsg += fmt.Sprintf("\r\n%s\r\n", qpBody.String())
 
-	if attachments, withAttachments := emailOpt["attachments"].([]interface{}); withAttachments {
-		for index := 0; index < len(attachments); index++ {
-			attachment := attachments[index].(IM)
-			params := IM{"output": "pdf"}
-			if _, found := attachment["reportkey"]; found {
-				params["reportkey"] = attachment["reportkey"]
-			}
-			if _, found := attachment["report_id"]; found {
-				params["report_id"] = attachment["report_id"]
-			}
-			if _, found := attachment["nervatype"]; found {
-				params["nervatype"] = attachment["nervatype"]
-			}
-			if _, found := attachment["refnumber"]; found {
-				params["refnumber"] = attachment["refnumber"]
-			}
-			if _, found := attachment["ref_id"]; found {
-				params["filters"] = IM{"@id": attachment["ref_id"]}
-			}
-			filename := "docs_" + strconv.Itoa(index+1) + ".pdf"
-			if _, found := attachment["filename"]; found {
-				filename = ut.ToString(attachment["filename"], "")
-			}
-			report, err := nstore.getReport(params)
-			if err != nil {
-				return results, err
-			}
-
-			emailMsg += fmt.Sprintf("\r\n--%s\r\n", delimeter)
-			emailMsg += "Content-Type: application/pdf; charset=\"utf-8\"\r\n"
-			emailMsg += "Content-Transfer-Encoding: base64\r\n"
-			emailMsg += "Content-Disposition: attachment;filename=\"" + filename + "\"\r\n"
-			emailMsg += "\r\n" + base64.StdEncoding.EncodeToString(report["template"].([]uint8))
+	for index := 0; index < len(attachments); index++ {
+		attachment := attachments[index].(IM)
+		filename := "docs_" + strconv.Itoa(index+1) + ".pdf"
+		if _, found := attachment["filename"]; found {
+			filename = ut.ToString(attachment["filename"], "")
 		}
+		report, err := nstore.getReport(attachmentParams[index])
+		if err != nil {
+			return results, err
+		}
+
+		emailMsg += fmt.Sprintf("\r\n--%s\r\n", delimeter)
+		emailMsg += "Content-Type: application/pdf; charset=\"utf-8\"\r\n"
+		emailMsg += "Content-Transfer-Encoding: base64\r\n"
+		emailMsg += "Content-Disposition: attachment;filename=\"" + filename + "\"\r\n"
+		emailMsg += "\r\n" + base64.StdEncoding.EncodeToString(report["template"].([]uint8))
 	}
 
 	if _, err := writer.Write([]byte(emailMsg)); err != nil {
@@ -0,0 +1,363 @@
+package nervatura
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// viewForbidden - the data changing, locking and server side (file, process, session) keywords and functions
+// of a query. The other statements are rejected by the first keyword of the statement.
+var viewForbidden = SL{
+	"insert", "update", "delete", "merge", "upsert", "truncate", "drop", "create", "alter", "grant", "revoke",
+	"exec", "execute", "into", "outfile", "dumpfile", "pragma", "attach", "detach", "vacuum", "declare", "prepare",
+	"deallocate", "listen", "notify", "lock", "unlock", "updlock", "xlock", "holdlock", "tablockx", "waitfor",
+	"openrowset", "opendatasource", "openquery", "openxml", "xp_cmdshell", "sp_executesql",
+	"load_file", "load_extension", "readfile", "writefile", "fts3_tokenizer", "sleep", "benchmark", "get_lock",
+	"release_lock", "pg_sleep", "pg_read_file", "pg_read_binary_file", "pg_ls_dir", "pg_stat_file",
+	"pg_terminate_backend", "pg_cancel_backend", "pg_reload_conf", "lo_import", "lo_export", "dblink", "dblink_exec",
+	"nextval", "setval", "set_config", "txid_current", "$$", "pg_notify", "lo_unlink", "lo_create", "lo_put",
+	"lo_from_bytea", "pg_advisory_lock", "dblink_connect", "dblink_send_query", "pg_logical_emit_message",
+	"query_to_xml", "query_to_xmlschema", "query_to_xml_and_xmlschema", "table_to_xml", "table_to_xmlschema",
+	"table_to_xml_and_xmlschema", "cursor_to_xml", "cursor_to_xmlschema", "schema_to_xml", "schema_to_xmlschema",
+	"schema_to_xml_and_xmlschema", "database_to_xml", "database_to_xmlschema", "database_to_xml_and_xmlschema",
+}
+
+// viewForbiddenPrefix - the function families of the large objects, locks, remote connections and replication
+var viewForbiddenPrefix = SL{
+	"lo_", "dblink", "pg_advisory_", "pg_try_advisory_", "pg_logical_", "pg_replication_", "pg_create_", "pg_drop_",
+}
+
+// viewForbiddenWord - the keyword or function is in the forbidden list or in a forbidden function family
+func viewForbiddenWord(word string) bool {
+	if ut.Contains(viewForbidden, word) {
+		return true
+	}
+	for _, prefix := range viewForbiddenPrefix {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// viewFromFunctions - the functions with a "from" argument keyword (not a table reference)
+var viewFromFunctions = SL{"extract", "substring", "substr", "trim", "position", "overlay"}
+
+type viewToken struct {
+	word  bool // keyword, function or (quoted) identifier
+	value string
+}
+
+/*
+viewTokens - split the SQL statement to words and symbols, without the comments and the string values.
+MySQL: backslash escape characters in the string values, # comments and "-- " comments (with a space).
+*/
+func viewTokens(sqlString string, mysql bool) ([]viewToken, error) {
+	tokens := []viewToken{}
+	src := []rune(sqlString)
+	closeQuote := map[rune]rune{'"': '"', '`': '`', '[': ']'}
+	isWord := func(r rune) bool {
+		return r == '_' || r == '$' || r == '#' || r == '@' ||
+			(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r > 127
+	}
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == ' ' || src[i] == '\t' || src[i] == '\n' || src[i] == '\r':
+
+		case (src[i] == '-' && i+1 < len(src) && src[i+1] == '-' &&
+			(!mysql || i+2 >= len(src) || src[i+2] <= ' ')) || (mysql && src[i] == '#'):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+
+		case src[i] == '/' && i+1 < len(src) && src[i+1] == '*':
+			end := strings.Index(string(src[i+2:]), "*/")
+			if i+2 < len(src) && (src[i+2] == '!' || src[i+2] == '+') {
+				// executable comment, optimizer hint
+				return tokens, errors.New(ut.GetMessage("sql_forbidden") + " " + string(src[i:i+3]))
+			}
+			if end < 0 {
+				return tokens, errors.New(ut.GetMessage("sql_syntax") + ": comment")
+			}
+			i += 2 + len([]rune(string(src[i+2:])[:end])) + 1
+
+		case src[i] == '\'' || (mysql && src[i] == '"'):
+			start, quote := i, src[i]
+			i++
+			for ; i < len(src); i++ {
+				if mysql && src[i] == '\\' {
+					i++
+					continue
+				}
+				if src[i] == quote {
+					if i+1 < len(src) && src[i+1] == quote {
+						i++
+						continue
+					}
+					break
+				}
+			}
+			if i >= len(src) {
+				return tokens, errors.New(ut.GetMessage("sql_syntax") + ": string")
+			}
+			if value := string(src[start:i]); strings.Contains(value, "{CA") || strings.Contains(value, "{FM") {
+				// the engine macros (decodeEngine) can change the string boundaries
+				return tokens, errors.New(ut.GetMessage("sql_syntax") + ": " + value + "'")
+			}
+			tokens = append(tokens, viewToken{value: "''"})
+
+		case closeQuote[src[i]] != 0:
+			start, quote := i+1, closeQuote[src[i]]
+			for i++; i < len(src) && src[i] != quote; i++ {
+			}
+			if i >= len(src) {
+				return tokens, errors.New(ut.GetMessage("sql_syntax") + ": identifier")
+			}
+			tokens = append(tokens, viewToken{word: true, value: strings.ToLower(string(src[start:i]))})
+
+		case isWord(src[i]):
+			start := i
+			for i+1 < len(src) && isWord(src[i+1]) {
+				i++
+			}
+			tokens = append(tokens, viewToken{word: true, value: strings.ToLower(string(src[start : i+1]))})
+
+		default:
+			tokens = append(tokens, viewToken{value: string(src[i])})
+		}
+	}
+	// qualified names (schema.table, alias.field)
+	result := []viewToken{}
+	for index := 0; index < len(tokens); index++ {
+		if tokens[index].value == "." && len(result) > 0 && result[len(result)-1].word &&
+			index+1 < len(tokens) && tokens[index+1].word {
+			result[len(result)-1].value += "." + tokens[index+1].value
+			index++
+			continue
+		}
+		result = append(result, tokens[index])
+	}
+	return result, nil
+}
+
+// viewClose - the index of the closing parenthesis
+func viewClose(tokens []viewToken, index int) int {
+	depth := 0
+	for ; index < len(tokens); index++ {
+		switch tokens[index].value {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return index
+			}
+		}
+	}
+	return index
+}
+
+// viewTableName - the name without the schema (owner, database) prefix
+func viewTableName(value string) string {
+	names := strings.Split(value, ".")
+	return names[len(names)-1]
+}
+
+// viewCTE - the names of the common table expressions of a WITH query
+func viewCTE(tokens []viewToken) map[string]bool {
+	names := map[string]bool{}
+	index := 1
+	if index < len(tokens) && tokens[index].value == "recursive" {
+		index++
+	}
+	for index < len(tokens) && tokens[index].word {
+		names[tokens[index].value] = true
+		index++
+		if index < len(tokens) && tokens[index].value == "(" {
+			index = viewClose(tokens, index) + 1
+		}
+		if index >= len(tokens) || tokens[index].value != "as" {
+			break
+		}
+		index++
+		for index < len(tokens) && tokens[index].word {
+			// materialized, not materialized
+			index++
+		}
+		index = viewClose(tokens, index) + 1
+		if index >= len(tokens) || tokens[index].value != "," {
+			break
+		}
+		index++
+	}
+	return names
+}
+
+// viewFromTables - the table names of a FROM list or a JOIN
+func viewFromTables(tokens []viewToken, index int) []string {
+	tables := []string{}
+	for index < len(tokens) {
+		switch {
+		case tokens[index].value == "(":
+			// subquery (the tables of the subquery are checked separately)
+			index = viewClose(tokens, index) + 1
+		case tokens[index].word:
+			tables = append(tables, tokens[index].value)
+			index++
+			if index < len(tokens) && tokens[index].value == "(" {
+				// table-valued function
+				index = viewClose(tokens, index) + 1
+			}
+		default:
+			return tables
+		}
+		if index < len(tokens) && tokens[index].value == "as" {
+			index++
+		}
+		if index < len(tokens) && tokens[index].word && !ut.Contains(SL{"where", "group", "order", "having", "limit", "offset",
+			"union", "except", "intersect", "join", "inner", "left", "right", "full", "cross", "natural", "on", "using",
+			"fetch", "for", "window", "lateral", "outer"}, tokens[index].value) {
+			// alias
+			index++
+		}
+		if index >= len(tokens) || tokens[index].value != "," {
+			return tables
+		}
+		index++
+	}
+	return tables
+}
+
+/*
+viewCheckSQL - the SQL statement analyzer of the View. Only a single SELECT or WITH query is allowed,
+without data changing statements, locking clauses and server side (file, process, session) functions.
+Returns the table names of the query.
+*/
+func viewCheckSQL(sqlString string, mysql bool) ([]string, error) {
+	tokens, err := viewTokens(sqlString, mysql)
+	if err != nil {
+		return nil, err
+	}
+	for len(tokens) > 0 && tokens[len(tokens)-1].value == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+	start := 0
+	for start < len(tokens) && tokens[start].value == "(" {
+		start++
+	}
+	if start >= len(tokens) || (tokens[start].value != "select" && tokens[start].value != "with") {
+		return nil, errors.New(ut.GetMessage("sql_not_select"))
+	}
+	cte := map[string]bool{}
+	if tokens[start].value == "with" {
+		cte = viewCTE(tokens[start:])
+	}
+
+	tables := []string{}
+	funcs := []string{}
+	for index, token := range tokens {
+		switch {
+		case token.value == ";":
+			return nil, errors.New(ut.GetMessage("sql_multiple"))
+
+		case token.value == "(":
+			fname := ""
+			if index > 0 && tokens[index-1].word {
+				fname = tokens[index-1].value
+			}
+			funcs = append(funcs, fname)
+
+		case token.value == ")":
+			if len(funcs) == 0 {
+				return nil, errors.New(ut.GetMessage("sql_syntax") + ": )")
+			}
+			funcs = funcs[:len(funcs)-1]
+
+		case token.word && viewForbiddenWord(viewTableName(token.value)):
+			return nil, errors.New(ut.GetMessage("sql_forbidden") + " " + token.value)
+
+		case token.value == "for" && index+1 < len(tokens) && ut.Contains(SL{"share", "no", "key"}, tokens[index+1].value):
+			// locking clause
+			return nil, errors.New(ut.GetMessage("sql_forbidden") + " for " + tokens[index+1].value)
+
+		case token.value == "from" && index > 0 && tokens[index-1].value == "distinct":
+			// is [not] distinct from
+
+		case token.value == "from" && (len(funcs) == 0 || !ut.Contains(viewFromFunctions, funcs[len(funcs)-1])),
+			token.value == "join":
+			for _, table := range viewFromTables(tokens, index+1) {
+				if strings.Contains(table, ".") {
+					// the schema (owner, database) qualified names can refer to another database
+					return nil, errors.New(ut.GetMessage("sql_table") + " " + table)
+				}
+				if !cte[table] {
+					tables = append(tables, table)
+				}
+			}
+		}
+	}
+	if len(funcs) > 0 {
+		return nil, errors.New(ut.GetMessage("sql_syntax") + ": (")
+	}
+	return tables, nil
+}
+
+// viewCheckTables - the NT_VIEW_TABLES allowlist (default: the DataModel tables) and the audit rights of the query tables
+func (api *API) viewCheckTables(tables []string) error {
+	allowed := getOptionList(api.NStore.config["NT_VIEW_TABLES"])
+	state, err := api.getAuditState()
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		model, found := api.NStore.models[table].(IM)
+		if (len(allowed) > 0 && !ut.Contains(allowed, table)) || (len(allowed) == 0 && !found) {
+			return errors.New(ut.GetMessage("sql_table") + " " + table)
+		}
+		if state == nil {
+			continue
+		}
+		if !found {
+			// the access rights of the other tables are unknown
+			return permissionError(table)
+		}
+		// the rows of the raw SQL queries can not be filtered
+		access := model["_access"].(SL)
+		switch {
+		case access[0] == "transtype":
+			if state.transDisabled || state.transfilter != "all" {
+				return permissionError(table)
+			}
+		case len(access) > 1:
+			if state.disabled || state.transfilter != "all" {
+				return permissionError(table)
+			}
+		default:
+			right, err := api.auditRight(state, access[0], "")
+			if err != nil {
+				return err
+			}
+			if right == "disabled" {
+				return permissionError(table)
+			}
+		}
+	}
+	return nil
+}
+
+// viewQuery - check and run a View query with the NT_VIEW_MAX_ROWS row limit and the NT_VIEW_TIMEOUT
+func (api *API) viewQuery(sqlString string, params []interface{}, trans interface{}) ([]IM, error) {
+	tables, err := viewCheckSQL(sqlString, api.NStore.ds.Connection().Engine == "mysql")
+	if err != nil {
+		return nil, err
+	}
+	if err = api.viewCheckTables(tables); err != nil {
+		return nil, err
+	}
+	maxRows := ut.ToInteger(api.NStore.config["NT_VIEW_MAX_ROWS"], 10000)
+	timeout := ut.ToFloat(api.NStore.config["NT_VIEW_TIMEOUT"], 30)
+	return api.NStore.ds.QueryLimit(sqlString, params, maxRows, time.Duration(timeout*float64(time.Second)), trans)
+}
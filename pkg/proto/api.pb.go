@@ -264,6 +264,8 @@ const (
 	ReportOutput_xml    ReportOutput = 1
 	ReportOutput_data   ReportOutput = 2
 	ReportOutput_base64 ReportOutput = 3
+	ReportOutput_xlsx   ReportOutput = 4
+	ReportOutput_html   ReportOutput = 5
 )
 
 // Enum value maps for ReportOutput.
@@ -273,12 +275,16 @@ var (
 		1: "xml",
 		2: "data",
 		3: "base64",
+		4: "xlsx",
+		5: "html",
 	}
 	ReportOutput_value = map[string]int32{
 		"auto":   0,
 		"xml":    1,
 		"data":   2,
 		"base64": 3,
+		"xlsx":   4,
+		"html":   5,
 	}
 )
 
@@ -1158,21 +1164,17 @@ func (x *ResponseDatabaseCreate) GetDetails() *ResponseRows {
 	return nil
 }
 
-// Delete parameters
-type RequestDelete struct {
+type RequestDatabaseBackup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nervatype DataType `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
-	// The object ID
-	Id int64 `protobuf:"varint,36,opt,name=id,proto3" json:"id,omitempty"`
-	// Use Key instead of ID
-	Key string `protobuf:"bytes,37,opt,name=key,proto3" json:"key,omitempty"`
+	// Alias name of the database
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
-func (x *RequestDelete) Reset() {
-	*x = RequestDelete{}
+func (x *RequestDatabaseBackup) Reset() {
+	*x = RequestDatabaseBackup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1180,13 +1182,13 @@ func (x *RequestDelete) Reset() {
 	}
 }
 
-func (x *RequestDelete) String() string {
+func (x *RequestDatabaseBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestDelete) ProtoMessage() {}
+func (*RequestDatabaseBackup) ProtoMessage() {}
 
-func (x *RequestDelete) ProtoReflect() protoreflect.Message {
+func (x *RequestDatabaseBackup) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1198,44 +1200,29 @@ func (x *RequestDelete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestDelete.ProtoReflect.Descriptor instead.
-func (*RequestDelete) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestDatabaseBackup.ProtoReflect.Descriptor instead.
+func (*RequestDatabaseBackup) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RequestDelete) GetNervatype() DataType {
-	if x != nil {
-		return x.Nervatype
-	}
-	return DataType_address
-}
-
-func (x *RequestDelete) GetId() int64 {
+func (x *RequestDatabaseBackup) GetAlias() string {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *RequestDelete) GetKey() string {
-	if x != nil {
-		return x.Key
+		return x.Alias
 	}
 	return ""
 }
 
-// Only "select" queries and functions can be executed. Changes to the data are not saved in the database.
-type RequestView struct {
+type ResponseDatabaseBackup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The array of Query object
-	Options []*RequestView_Query `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	// Backup archive content
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *RequestView) Reset() {
-	*x = RequestView{}
+func (x *ResponseDatabaseBackup) Reset() {
+	*x = ResponseDatabaseBackup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,13 +1230,13 @@ func (x *RequestView) Reset() {
 	}
 }
 
-func (x *RequestView) String() string {
+func (x *ResponseDatabaseBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestView) ProtoMessage() {}
+func (*ResponseDatabaseBackup) ProtoMessage() {}
 
-func (x *RequestView) ProtoReflect() protoreflect.Message {
+func (x *ResponseDatabaseBackup) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1261,29 +1248,31 @@ func (x *RequestView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestView.ProtoReflect.Descriptor instead.
-func (*RequestView) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseDatabaseBackup.ProtoReflect.Descriptor instead.
+func (*ResponseDatabaseBackup) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RequestView) GetOptions() []*RequestView_Query {
+func (x *ResponseDatabaseBackup) GetValue() []byte {
 	if x != nil {
-		return x.Options
+		return x.Value
 	}
 	return nil
 }
 
-type ResponseView struct {
+type RequestDatabaseRestore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// key - results map
-	Values map[string]*ResponseRows `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Alias name of the target database
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// Backup archive content
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ResponseView) Reset() {
-	*x = ResponseView{}
+func (x *RequestDatabaseRestore) Reset() {
+	*x = RequestDatabaseRestore{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1291,13 +1280,13 @@ func (x *ResponseView) Reset() {
 	}
 }
 
-func (x *ResponseView) String() string {
+func (x *RequestDatabaseRestore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseView) ProtoMessage() {}
+func (*RequestDatabaseRestore) ProtoMessage() {}
 
-func (x *ResponseView) ProtoReflect() protoreflect.Message {
+func (x *RequestDatabaseRestore) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1309,31 +1298,36 @@ func (x *ResponseView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseView.ProtoReflect.Descriptor instead.
-func (*ResponseView) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestDatabaseRestore.ProtoReflect.Descriptor instead.
+func (*RequestDatabaseRestore) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ResponseView) GetValues() map[string]*ResponseRows {
+func (x *RequestDatabaseRestore) GetAlias() string {
 	if x != nil {
-		return x.Values
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *RequestDatabaseRestore) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-type RequestFunction struct {
+// Result log data
+type ResponseDatabaseRestore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Server function name
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The array of parameter values
-	Values map[string]*Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Details *ResponseRows `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func (x *RequestFunction) Reset() {
-	*x = RequestFunction{}
+func (x *ResponseDatabaseRestore) Reset() {
+	*x = ResponseDatabaseRestore{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1341,13 +1335,13 @@ func (x *RequestFunction) Reset() {
 	}
 }
 
-func (x *RequestFunction) String() string {
+func (x *ResponseDatabaseRestore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestFunction) ProtoMessage() {}
+func (*ResponseDatabaseRestore) ProtoMessage() {}
 
-func (x *RequestFunction) ProtoReflect() protoreflect.Message {
+func (x *ResponseDatabaseRestore) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1359,35 +1353,31 @@ func (x *RequestFunction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestFunction.ProtoReflect.Descriptor instead.
-func (*RequestFunction) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseDatabaseRestore.ProtoReflect.Descriptor instead.
+func (*ResponseDatabaseRestore) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RequestFunction) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *RequestFunction) GetValues() map[string]*Value {
+func (x *ResponseDatabaseRestore) GetDetails() *ResponseRows {
 	if x != nil {
-		return x.Values
+		return x.Details
 	}
 	return nil
 }
 
-type ResponseFunction struct {
+type RequestDatabaseUpgrade struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Alias name of the database
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// Returns the upgrade SQL statements, without changes
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *ResponseFunction) Reset() {
-	*x = ResponseFunction{}
+func (x *RequestDatabaseUpgrade) Reset() {
+	*x = RequestDatabaseUpgrade{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1395,13 +1385,13 @@ func (x *ResponseFunction) Reset() {
 	}
 }
 
-func (x *ResponseFunction) String() string {
+func (x *RequestDatabaseUpgrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseFunction) ProtoMessage() {}
+func (*RequestDatabaseUpgrade) ProtoMessage() {}
 
-func (x *ResponseFunction) ProtoReflect() protoreflect.Message {
+func (x *RequestDatabaseUpgrade) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1413,28 +1403,36 @@ func (x *ResponseFunction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseFunction.ProtoReflect.Descriptor instead.
-func (*ResponseFunction) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestDatabaseUpgrade.ProtoReflect.Descriptor instead.
+func (*RequestDatabaseUpgrade) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ResponseFunction) GetValue() []byte {
+func (x *RequestDatabaseUpgrade) GetAlias() string {
 	if x != nil {
-		return x.Value
+		return x.Alias
 	}
-	return nil
+	return ""
 }
 
-type RequestReportList struct {
+func (x *RequestDatabaseUpgrade) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Result log data
+type ResponseDatabaseUpgrade struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Details *ResponseRows `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func (x *RequestReportList) Reset() {
-	*x = RequestReportList{}
+func (x *ResponseDatabaseUpgrade) Reset() {
+	*x = ResponseDatabaseUpgrade{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1442,13 +1440,13 @@ func (x *RequestReportList) Reset() {
 	}
 }
 
-func (x *RequestReportList) String() string {
+func (x *ResponseDatabaseUpgrade) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestReportList) ProtoMessage() {}
+func (*ResponseDatabaseUpgrade) ProtoMessage() {}
 
-func (x *RequestReportList) ProtoReflect() protoreflect.Message {
+func (x *ResponseDatabaseUpgrade) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1460,29 +1458,33 @@ func (x *RequestReportList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestReportList.ProtoReflect.Descriptor instead.
-func (*RequestReportList) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseDatabaseUpgrade.ProtoReflect.Descriptor instead.
+func (*ResponseDatabaseUpgrade) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RequestReportList) GetLabel() string {
+func (x *ResponseDatabaseUpgrade) GetDetails() *ResponseRows {
 	if x != nil {
-		return x.Label
+		return x.Details
 	}
-	return ""
+	return nil
 }
 
-// Returns all installable files from the NT_REPORT_DIR directory (empty value: all available built-in Nervatura Reports)
-type ResponseReportList struct {
+// Delete parameters
+type RequestDelete struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*ResponseReportList_Info `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Nervatype DataType `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
+	// The object ID
+	Id int64 `protobuf:"varint,36,opt,name=id,proto3" json:"id,omitempty"`
+	// Use Key instead of ID
+	Key string `protobuf:"bytes,37,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *ResponseReportList) Reset() {
-	*x = ResponseReportList{}
+func (x *RequestDelete) Reset() {
+	*x = RequestDelete{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1490,13 +1492,13 @@ func (x *ResponseReportList) Reset() {
 	}
 }
 
-func (x *ResponseReportList) String() string {
+func (x *RequestDelete) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReportList) ProtoMessage() {}
+func (*RequestDelete) ProtoMessage() {}
 
-func (x *ResponseReportList) ProtoReflect() protoreflect.Message {
+func (x *RequestDelete) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1508,31 +1510,44 @@ func (x *ResponseReportList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReportList.ProtoReflect.Descriptor instead.
-func (*ResponseReportList) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestDelete.ProtoReflect.Descriptor instead.
+func (*RequestDelete) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ResponseReportList) GetItems() []*ResponseReportList_Info {
+func (x *RequestDelete) GetNervatype() DataType {
 	if x != nil {
-		return x.Items
+		return x.Nervatype
 	}
-	return nil
+	return DataType_address
 }
 
-//
-//Admin user group membership required.
-type RequestReportInstall struct {
+func (x *RequestDelete) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RequestDelete) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// Only single "select" (or "with") queries of the allowed tables (NT_VIEW_TABLES) can be executed, with a max. number of rows (NT_VIEW_MAX_ROWS) and a timeout (NT_VIEW_TIMEOUT).
+type RequestView struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Example : ntr_invoice_en
-	Reportkey string `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
+	// The array of Query object
+	Options []*RequestView_Query `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *RequestReportInstall) Reset() {
-	*x = RequestReportInstall{}
+func (x *RequestView) Reset() {
+	*x = RequestView{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1540,13 +1555,13 @@ func (x *RequestReportInstall) Reset() {
 	}
 }
 
-func (x *RequestReportInstall) String() string {
+func (x *RequestView) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestReportInstall) ProtoMessage() {}
+func (*RequestView) ProtoMessage() {}
 
-func (x *RequestReportInstall) ProtoReflect() protoreflect.Message {
+func (x *RequestView) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1558,29 +1573,29 @@ func (x *RequestReportInstall) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestReportInstall.ProtoReflect.Descriptor instead.
-func (*RequestReportInstall) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestView.ProtoReflect.Descriptor instead.
+func (*RequestView) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RequestReportInstall) GetReportkey() string {
+func (x *RequestView) GetOptions() []*RequestView_Query {
 	if x != nil {
-		return x.Reportkey
+		return x.Options
 	}
-	return ""
+	return nil
 }
 
-type ResponseReportInstall struct {
+type ResponseView struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Returns a new report ID.
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// key - results map
+	Values map[string]*ResponseRows `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ResponseReportInstall) Reset() {
-	*x = ResponseReportInstall{}
+func (x *ResponseView) Reset() {
+	*x = ResponseView{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1588,13 +1603,13 @@ func (x *ResponseReportInstall) Reset() {
 	}
 }
 
-func (x *ResponseReportInstall) String() string {
+func (x *ResponseView) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReportInstall) ProtoMessage() {}
+func (*ResponseView) ProtoMessage() {}
 
-func (x *ResponseReportInstall) ProtoReflect() protoreflect.Message {
+func (x *ResponseView) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1606,29 +1621,31 @@ func (x *ResponseReportInstall) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReportInstall.ProtoReflect.Descriptor instead.
-func (*ResponseReportInstall) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseView.ProtoReflect.Descriptor instead.
+func (*ResponseView) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ResponseReportInstall) GetId() int64 {
+func (x *ResponseView) GetValues() map[string]*ResponseRows {
 	if x != nil {
-		return x.Id
+		return x.Values
 	}
-	return 0
+	return nil
 }
 
-type RequestReportDelete struct {
+type RequestFunction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Example : ntr_invoice_en
-	Reportkey string `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
+	// Server function name
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The array of parameter values
+	Values map[string]*Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RequestReportDelete) Reset() {
-	*x = RequestReportDelete{}
+func (x *RequestFunction) Reset() {
+	*x = RequestFunction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1636,13 +1653,13 @@ func (x *RequestReportDelete) Reset() {
 	}
 }
 
-func (x *RequestReportDelete) String() string {
+func (x *RequestFunction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestReportDelete) ProtoMessage() {}
+func (*RequestFunction) ProtoMessage() {}
 
-func (x *RequestReportDelete) ProtoReflect() protoreflect.Message {
+func (x *RequestFunction) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1654,38 +1671,35 @@ func (x *RequestReportDelete) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestReportDelete.ProtoReflect.Descriptor instead.
-func (*RequestReportDelete) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestFunction.ProtoReflect.Descriptor instead.
+func (*RequestFunction) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *RequestReportDelete) GetReportkey() string {
+func (x *RequestFunction) GetKey() string {
 	if x != nil {
-		return x.Reportkey
+		return x.Key
 	}
 	return ""
 }
 
-type RequestReport struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RequestFunction) GetValues() map[string]*Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
 
-	// Example : ntr_invoice_en
-	Reportkey   string            `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
-	Orientation ReportOrientation `protobuf:"varint,2,opt,name=orientation,proto3,enum=nervatura.ReportOrientation" json:"orientation,omitempty"`
-	Size        ReportSize        `protobuf:"varint,3,opt,name=size,proto3,enum=nervatura.ReportSize" json:"size,omitempty"`
-	Output      ReportOutput      `protobuf:"varint,4,opt,name=output,proto3,enum=nervatura.ReportOutput" json:"output,omitempty"`
-	Type        ReportType        `protobuf:"varint,5,opt,name=type,proto3,enum=nervatura.ReportType" json:"type,omitempty"`
-	// Example : DMINV/00001
-	Refnumber string `protobuf:"bytes,6,opt,name=refnumber,proto3" json:"refnumber,omitempty"`
-	// Custom report JSON template
-	Template string            `protobuf:"bytes,7,opt,name=template,proto3" json:"template,omitempty"`
-	Filters  map[string]*Value `protobuf:"bytes,8,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type ResponseFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *RequestReport) Reset() {
-	*x = RequestReport{}
+func (x *ResponseFunction) Reset() {
+	*x = ResponseFunction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1693,13 +1707,13 @@ func (x *RequestReport) Reset() {
 	}
 }
 
-func (x *RequestReport) String() string {
+func (x *ResponseFunction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestReport) ProtoMessage() {}
+func (*ResponseFunction) ProtoMessage() {}
 
-func (x *RequestReport) ProtoReflect() protoreflect.Message {
+func (x *ResponseFunction) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1711,77 +1725,29 @@ func (x *RequestReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestReport.ProtoReflect.Descriptor instead.
-func (*RequestReport) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseFunction.ProtoReflect.Descriptor instead.
+func (*ResponseFunction) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RequestReport) GetReportkey() string {
-	if x != nil {
-		return x.Reportkey
-	}
-	return ""
-}
-
-func (x *RequestReport) GetOrientation() ReportOrientation {
-	if x != nil {
-		return x.Orientation
-	}
-	return ReportOrientation_portrait
-}
-
-func (x *RequestReport) GetSize() ReportSize {
-	if x != nil {
-		return x.Size
-	}
-	return ReportSize_a3
-}
-
-func (x *RequestReport) GetOutput() ReportOutput {
-	if x != nil {
-		return x.Output
-	}
-	return ReportOutput_auto
-}
-
-func (x *RequestReport) GetType() ReportType {
-	if x != nil {
-		return x.Type
-	}
-	return ReportType_report_none
-}
-
-func (x *RequestReport) GetRefnumber() string {
-	if x != nil {
-		return x.Refnumber
-	}
-	return ""
-}
-
-func (x *RequestReport) GetTemplate() string {
-	if x != nil {
-		return x.Template
-	}
-	return ""
-}
-
-func (x *RequestReport) GetFilters() map[string]*Value {
+func (x *ResponseFunction) GetValue() []byte {
 	if x != nil {
-		return x.Filters
+		return x.Value
 	}
 	return nil
 }
 
-type ResponseReport struct {
+// Returns the registered server-side functions in name order
+type ResponseFunctionList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Items []*ResponseFunctionList_Info `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ResponseReport) Reset() {
-	*x = ResponseReport{}
+func (x *ResponseFunctionList) Reset() {
+	*x = ResponseFunctionList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1789,13 +1755,13 @@ func (x *ResponseReport) Reset() {
 	}
 }
 
-func (x *ResponseReport) String() string {
+func (x *ResponseFunctionList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReport) ProtoMessage() {}
+func (*ResponseFunctionList) ProtoMessage() {}
 
-func (x *ResponseReport) ProtoReflect() protoreflect.Message {
+func (x *ResponseFunctionList) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1807,29 +1773,28 @@ func (x *ResponseReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReport.ProtoReflect.Descriptor instead.
-func (*ResponseReport) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseFunctionList.ProtoReflect.Descriptor instead.
+func (*ResponseFunctionList) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ResponseReport) GetValue() []byte {
+func (x *ResponseFunctionList) GetItems() []*ResponseFunctionList_Info {
 	if x != nil {
-		return x.Value
+		return x.Items
 	}
 	return nil
 }
 
-type RequestUpdate struct {
+type RequestReportList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nervatype DataType              `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
-	Items     []*RequestUpdate_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *RequestUpdate) Reset() {
-	*x = RequestUpdate{}
+func (x *RequestReportList) Reset() {
+	*x = RequestReportList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1837,13 +1802,13 @@ func (x *RequestUpdate) Reset() {
 	}
 }
 
-func (x *RequestUpdate) String() string {
+func (x *RequestReportList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestUpdate) ProtoMessage() {}
+func (*RequestReportList) ProtoMessage() {}
 
-func (x *RequestUpdate) ProtoReflect() protoreflect.Message {
+func (x *RequestReportList) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1855,37 +1820,29 @@ func (x *RequestUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestUpdate.ProtoReflect.Descriptor instead.
-func (*RequestUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestReportList.ProtoReflect.Descriptor instead.
+func (*RequestReportList) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RequestUpdate) GetNervatype() DataType {
-	if x != nil {
-		return x.Nervatype
-	}
-	return DataType_address
-}
-
-func (x *RequestUpdate) GetItems() []*RequestUpdate_Item {
+func (x *RequestReportList) GetLabel() string {
 	if x != nil {
-		return x.Items
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-// If the ID (or Key) value is missing, it creates a new item.
-type ResponseUpdate struct {
+// Returns all installable files from the NT_REPORT_DIR directory (empty value: all available built-in Nervatura Reports)
+type ResponseReportList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Returns the all new/updated IDs values.
-	Values []int64 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Items []*ResponseReportList_Info `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *ResponseUpdate) Reset() {
-	*x = ResponseUpdate{}
+func (x *ResponseReportList) Reset() {
+	*x = ResponseReportList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1893,13 +1850,13 @@ func (x *ResponseUpdate) Reset() {
 	}
 }
 
-func (x *ResponseUpdate) String() string {
+func (x *ResponseReportList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseUpdate) ProtoMessage() {}
+func (*ResponseReportList) ProtoMessage() {}
 
-func (x *ResponseUpdate) ProtoReflect() protoreflect.Message {
+func (x *ResponseReportList) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1911,31 +1868,31 @@ func (x *ResponseUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseUpdate.ProtoReflect.Descriptor instead.
-func (*ResponseUpdate) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseReportList.ProtoReflect.Descriptor instead.
+func (*ResponseReportList) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ResponseUpdate) GetValues() []int64 {
+func (x *ResponseReportList) GetItems() []*ResponseReportList_Info {
 	if x != nil {
-		return x.Values
+		return x.Items
 	}
 	return nil
 }
 
-type RequestGet struct {
+//
+//Admin user group membership required.
+type RequestReportInstall struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nervatype DataType `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
-	Metadata  bool     `protobuf:"varint,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Ids       []int64  `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Filter    []string `protobuf:"bytes,7,rep,name=filter,proto3" json:"filter,omitempty"`
+	// Example : ntr_invoice_en
+	Reportkey string `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
 }
 
-func (x *RequestGet) Reset() {
-	*x = RequestGet{}
+func (x *RequestReportInstall) Reset() {
+	*x = RequestReportInstall{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1943,13 +1900,13 @@ func (x *RequestGet) Reset() {
 	}
 }
 
-func (x *RequestGet) String() string {
+func (x *RequestReportInstall) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestGet) ProtoMessage() {}
+func (*RequestReportInstall) ProtoMessage() {}
 
-func (x *RequestGet) ProtoReflect() protoreflect.Message {
+func (x *RequestReportInstall) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1961,49 +1918,29 @@ func (x *RequestGet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestGet.ProtoReflect.Descriptor instead.
-func (*RequestGet) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestReportInstall.ProtoReflect.Descriptor instead.
+func (*RequestReportInstall) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RequestGet) GetNervatype() DataType {
-	if x != nil {
-		return x.Nervatype
-	}
-	return DataType_address
-}
-
-func (x *RequestGet) GetMetadata() bool {
-	if x != nil {
-		return x.Metadata
-	}
-	return false
-}
-
-func (x *RequestGet) GetIds() []int64 {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
-}
-
-func (x *RequestGet) GetFilter() []string {
+func (x *RequestReportInstall) GetReportkey() string {
 	if x != nil {
-		return x.Filter
+		return x.Reportkey
 	}
-	return nil
+	return ""
 }
 
-type ResponseGet struct {
+type ResponseReportInstall struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values []*ResponseGet_Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	// Returns a new report ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ResponseGet) Reset() {
-	*x = ResponseGet{}
+func (x *ResponseReportInstall) Reset() {
+	*x = ResponseReportInstall{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2011,13 +1948,13 @@ func (x *ResponseGet) Reset() {
 	}
 }
 
-func (x *ResponseGet) String() string {
+func (x *ResponseReportInstall) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseGet) ProtoMessage() {}
+func (*ResponseReportInstall) ProtoMessage() {}
 
-func (x *ResponseGet) ProtoReflect() protoreflect.Message {
+func (x *ResponseReportInstall) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2029,34 +1966,29 @@ func (x *ResponseGet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseGet.ProtoReflect.Descriptor instead.
-func (*ResponseGet) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseReportInstall.ProtoReflect.Descriptor instead.
+func (*ResponseReportInstall) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ResponseGet) GetValues() []*ResponseGet_Value {
+func (x *ResponseReportInstall) GetId() int64 {
 	if x != nil {
-		return x.Values
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type MetaData struct {
+type RequestReportDelete struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Deffield](#deffield).fieldname.
-	Fieldname string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
-	// Reference to [Deffield](#deffield).fieldtype.
-	Fieldtype string `protobuf:"bytes,3,opt,name=fieldtype,proto3" json:"fieldtype,omitempty"`
-	Value     string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Notes     string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Example : ntr_invoice_en
+	Reportkey string `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
 }
 
-func (x *MetaData) Reset() {
-	*x = MetaData{}
+func (x *RequestReportDelete) Reset() {
+	*x = RequestReportDelete{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2064,13 +1996,13 @@ func (x *MetaData) Reset() {
 	}
 }
 
-func (x *MetaData) String() string {
+func (x *RequestReportDelete) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetaData) ProtoMessage() {}
+func (*RequestReportDelete) ProtoMessage() {}
 
-func (x *MetaData) ProtoReflect() protoreflect.Message {
+func (x *RequestReportDelete) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2082,78 +2014,38 @@ func (x *MetaData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetaData.ProtoReflect.Descriptor instead.
-func (*MetaData) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestReportDelete.ProtoReflect.Descriptor instead.
+func (*RequestReportDelete) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MetaData) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *MetaData) GetFieldname() string {
-	if x != nil {
-		return x.Fieldname
-	}
-	return ""
-}
-
-func (x *MetaData) GetFieldtype() string {
-	if x != nil {
-		return x.Fieldtype
-	}
-	return ""
-}
-
-func (x *MetaData) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-func (x *MetaData) GetNotes() string {
+func (x *RequestReportDelete) GetReportkey() string {
 	if x != nil {
-		return x.Notes
+		return x.Reportkey
 	}
 	return ""
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Value is a generated unique key identifier: *{nervatype}/{refnumber}~{rownumber}*. The *rownumber* is the order of multiple *{nervatype}/{refnumber}* keys. For example: ```customer/DMCUST/00001~1```
-//
-//- ```nervatype```: Valid values: *customer, employee, event, place, product, project, tool, trans*
-//
-//- ```ref_id```: Valid values: *customer/{custnumber}, employee/{empnumber}, event/{calnumber}, place/{planumber}, product/{partnumber},
-//project/{pronumber}, tool/{serial}, trans/{transnumber}*
-//
-type Address struct {
+type RequestReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype int64 `protobuf:"varint,2,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
-	// Reference to [Customer](#customer).id, [Employee](#employee).id, [Event](#event).id, [Place](#place).id, [Product](#product).id, [Project](#project).id, [Tool](#tool).id, [Trans](#trans).id
-	RefId   int64  `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
-	Country string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
-	State   string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
-	Zipcode string `protobuf:"bytes,6,opt,name=zipcode,proto3" json:"zipcode,omitempty"`
-	City    string `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
-	Street  string `protobuf:"bytes,8,opt,name=street,proto3" json:"street,omitempty"`
-	Notes   string `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Address meta data
-	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Example : ntr_invoice_en
+	Reportkey   string            `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
+	Orientation ReportOrientation `protobuf:"varint,2,opt,name=orientation,proto3,enum=nervatura.ReportOrientation" json:"orientation,omitempty"`
+	Size        ReportSize        `protobuf:"varint,3,opt,name=size,proto3,enum=nervatura.ReportSize" json:"size,omitempty"`
+	Output      ReportOutput      `protobuf:"varint,4,opt,name=output,proto3,enum=nervatura.ReportOutput" json:"output,omitempty"`
+	Type        ReportType        `protobuf:"varint,5,opt,name=type,proto3,enum=nervatura.ReportType" json:"type,omitempty"`
+	// Example : DMINV/00001
+	Refnumber string `protobuf:"bytes,6,opt,name=refnumber,proto3" json:"refnumber,omitempty"`
+	// Custom report JSON template
+	Template string            `protobuf:"bytes,7,opt,name=template,proto3" json:"template,omitempty"`
+	Filters  map[string]*Value `protobuf:"bytes,8,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Address) Reset() {
-	*x = Address{}
+func (x *RequestReport) Reset() {
+	*x = RequestReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2161,13 +2053,13 @@ func (x *Address) Reset() {
 	}
 }
 
-func (x *Address) String() string {
+func (x *RequestReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Address) ProtoMessage() {}
+func (*RequestReport) ProtoMessage() {}
 
-func (x *Address) ProtoReflect() protoreflect.Message {
+func (x *RequestReport) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2179,111 +2071,77 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestReport.ProtoReflect.Descriptor instead.
+func (*RequestReport) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Address) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Address) GetNervatype() int64 {
-	if x != nil {
-		return x.Nervatype
-	}
-	return 0
-}
-
-func (x *Address) GetRefId() int64 {
+func (x *RequestReport) GetReportkey() string {
 	if x != nil {
-		return x.RefId
+		return x.Reportkey
 	}
-	return 0
+	return ""
 }
 
-func (x *Address) GetCountry() string {
+func (x *RequestReport) GetOrientation() ReportOrientation {
 	if x != nil {
-		return x.Country
+		return x.Orientation
 	}
-	return ""
+	return ReportOrientation_portrait
 }
 
-func (x *Address) GetState() string {
+func (x *RequestReport) GetSize() ReportSize {
 	if x != nil {
-		return x.State
+		return x.Size
 	}
-	return ""
+	return ReportSize_a3
 }
 
-func (x *Address) GetZipcode() string {
+func (x *RequestReport) GetOutput() ReportOutput {
 	if x != nil {
-		return x.Zipcode
+		return x.Output
 	}
-	return ""
+	return ReportOutput_auto
 }
 
-func (x *Address) GetCity() string {
+func (x *RequestReport) GetType() ReportType {
 	if x != nil {
-		return x.City
+		return x.Type
 	}
-	return ""
+	return ReportType_report_none
 }
 
-func (x *Address) GetStreet() string {
+func (x *RequestReport) GetRefnumber() string {
 	if x != nil {
-		return x.Street
+		return x.Refnumber
 	}
 	return ""
 }
 
-func (x *Address) GetNotes() string {
+func (x *RequestReport) GetTemplate() string {
 	if x != nil {
-		return x.Notes
+		return x.Template
 	}
 	return ""
 }
 
-func (x *Address) GetMetadata() []*MetaData {
+func (x *RequestReport) GetFilters() map[string]*Value {
 	if x != nil {
-		return x.Metadata
+		return x.Filters
 	}
 	return nil
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Barcode *code*
-//
-//- ```barcodetype```: Valid values: *CODE_128, CODE_39, EAN_13, EAN_8, QR*
-//
-//- ```product_id```: Product *partnumber*
-type Barcode struct {
+type ResponseReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Each product can be connected to any number of bar codes, but the code must remain unique to ensure that the product is clearly identifiable.
-	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	// Reference to [Product](#product).id
-	ProductId int64 `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	// Comment related to the barcode. Informal, has no role in identification.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// Reference to [Groups](#groups).id  (only where groupname = 'barcodetype').
-	Barcodetype int64 `protobuf:"varint,5,opt,name=barcodetype,proto3" json:"barcodetype,omitempty"`
-	// The actual amount of the products identified by the barcode. For example can be used for packaged goods, tray packaging.
-	Qty float64 `protobuf:"fixed64,6,opt,name=qty,proto3" json:"qty,omitempty"`
-	// If more than one bar code is assigned, this will be the default. Because of the uniqueness of the barcode the product is always clearly identifiable, but in reverse case (eg. in case the barcode should be printed on a document) we must assign one being the default for that product.
-	Defcode bool `protobuf:"varint,7,opt,name=defcode,proto3" json:"defcode,omitempty"`
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Barcode) Reset() {
-	*x = Barcode{}
+func (x *ResponseReport) Reset() {
+	*x = ResponseReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2291,13 +2149,13 @@ func (x *Barcode) Reset() {
 	}
 }
 
-func (x *Barcode) String() string {
+func (x *ResponseReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Barcode) ProtoMessage() {}
+func (*ResponseReport) ProtoMessage() {}
 
-func (x *Barcode) ProtoReflect() protoreflect.Message {
+func (x *ResponseReport) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2309,107 +2167,136 @@ func (x *Barcode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Barcode.ProtoReflect.Descriptor instead.
-func (*Barcode) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResponseReport.ProtoReflect.Descriptor instead.
+func (*ResponseReport) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *Barcode) GetId() int64 {
+func (x *ResponseReport) GetValue() []byte {
 	if x != nil {
-		return x.Id
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-func (x *Barcode) GetCode() string {
-	if x != nil {
-		return x.Code
+type RequestExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nervatype DataType `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
+	// Valid values: csv, jsonl. Default value: csv
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Optional Get filter (compact string or JSON filter tree). Default value: all records
+	Filter string  `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Ids    []int64 `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Export the metadata (deffield) values
+	Metadata bool `protobuf:"varint,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The number of the records per database query. Default value: 1000
+	Batch int64 `protobuf:"varint,6,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *RequestExport) Reset() {
+	*x = RequestExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Barcode) GetProductId() int64 {
+func (x *RequestExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestExport) ProtoMessage() {}
+
+func (x *RequestExport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestExport.ProtoReflect.Descriptor instead.
+func (*RequestExport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RequestExport) GetNervatype() DataType {
 	if x != nil {
-		return x.ProductId
+		return x.Nervatype
 	}
-	return 0
+	return DataType_address
 }
 
-func (x *Barcode) GetDescription() string {
+func (x *RequestExport) GetFormat() string {
 	if x != nil {
-		return x.Description
+		return x.Format
 	}
 	return ""
 }
 
-func (x *Barcode) GetBarcodetype() int64 {
+func (x *RequestExport) GetFilter() string {
 	if x != nil {
-		return x.Barcodetype
+		return x.Filter
 	}
-	return 0
+	return ""
 }
 
-func (x *Barcode) GetQty() float64 {
+func (x *RequestExport) GetIds() []int64 {
 	if x != nil {
-		return x.Qty
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-func (x *Barcode) GetDefcode() bool {
+func (x *RequestExport) GetMetadata() bool {
 	if x != nil {
-		return x.Defcode
+		return x.Metadata
 	}
 	return false
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: The value is a generated constant key identifier: *{nervatype}/{refnumber}~{rownumber}*. The rownumber is the order of multiple *{nervatype}/{refnumber}* keys.
-//
-//- ```nervatype```: Valid values: *customer, employee, event, place, product, project, tool, trans*
-//
-//- ```ref_id```: Valid values: *customer/{custnumber}, employee/{empnumber}, event/{calnumber}, place/{planumber}, product/{partnumber}, project/{pronumber}, tool/{serial}, trans/{transnumber}*
-type Contact struct {
+func (x *RequestExport) GetBatch() int64 {
+	if x != nil {
+		return x.Batch
+	}
+	return 0
+}
+
+type ResponseExport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype int64 `protobuf:"varint,2,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
-	// Reference to [Customer](#customer).id, [Employee](#employee).id, [Event](#event).id, [Place](#place).id, [Product](#product).id, [Project](#project).id, [Tool](#tool).id, [Trans](#trans).id
-	RefId     int64  `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
-	Firstname string `protobuf:"bytes,4,opt,name=firstname,proto3" json:"firstname,omitempty"`
-	Surname   string `protobuf:"bytes,5,opt,name=surname,proto3" json:"surname,omitempty"`
-	Status    string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Phone     string `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
-	Fax       string `protobuf:"bytes,8,opt,name=fax,proto3" json:"fax,omitempty"`
-	Mobil     string `protobuf:"bytes,9,opt,name=mobil,proto3" json:"mobil,omitempty"`
-	Email     string `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty"`
-	Notes     string `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Contact meta data
-	Metadata []*MetaData `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// CSV or JSON Lines file content
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Rows  int64  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
 }
 
-func (x *Contact) Reset() {
-	*x = Contact{}
+func (x *ResponseExport) Reset() {
+	*x = ResponseExport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[32]
+		mi := &file_api_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Contact) String() string {
+func (x *ResponseExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Contact) ProtoMessage() {}
+func (*ResponseExport) ProtoMessage() {}
 
-func (x *Contact) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[32]
+func (x *ResponseExport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,136 +2307,136 @@ func (x *Contact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
-func (*Contact) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ResponseExport.ProtoReflect.Descriptor instead.
+func (*ResponseExport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Contact) GetId() int64 {
+func (x *ResponseExport) GetValue() []byte {
 	if x != nil {
-		return x.Id
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-func (x *Contact) GetNervatype() int64 {
+func (x *ResponseExport) GetRows() int64 {
 	if x != nil {
-		return x.Nervatype
+		return x.Rows
 	}
 	return 0
 }
 
-func (x *Contact) GetRefId() int64 {
-	if x != nil {
-		return x.RefId
-	}
-	return 0
-}
+type RequestImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Contact) GetFirstname() string {
-	if x != nil {
-		return x.Firstname
+	Nervatype DataType `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
+	// Valid values: csv, jsonl. Default value: csv
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// CSV or JSON Lines file content
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The number of the rows per transaction. Default value: 500
+	Batch int64 `protobuf:"varint,4,opt,name=batch,proto3" json:"batch,omitempty"`
+	// Validate the rows without saving
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RequestImport) Reset() {
+	*x = RequestImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Contact) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
+func (x *RequestImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Contact) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (*RequestImport) ProtoMessage() {}
+
+func (x *RequestImport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Contact) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+// Deprecated: Use RequestImport.ProtoReflect.Descriptor instead.
+func (*RequestImport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Contact) GetFax() string {
+func (x *RequestImport) GetNervatype() DataType {
 	if x != nil {
-		return x.Fax
+		return x.Nervatype
 	}
-	return ""
+	return DataType_address
 }
 
-func (x *Contact) GetMobil() string {
+func (x *RequestImport) GetFormat() string {
 	if x != nil {
-		return x.Mobil
+		return x.Format
 	}
 	return ""
 }
 
-func (x *Contact) GetEmail() string {
+func (x *RequestImport) GetValue() []byte {
 	if x != nil {
-		return x.Email
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *Contact) GetNotes() string {
+func (x *RequestImport) GetBatch() int64 {
 	if x != nil {
-		return x.Notes
+		return x.Batch
 	}
-	return ""
+	return 0
 }
 
-func (x *Contact) GetMetadata() []*MetaData {
+func (x *RequestImport) GetDryRun() bool {
 	if x != nil {
-		return x.Metadata
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Currency *curr*
-type Currency struct {
+type ResponseImport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The ISO 4217 code of the currency.
-	Curr string `protobuf:"bytes,2,opt,name=curr,proto3" json:"curr,omitempty"`
-	// The name of the currency.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// The number of decimal places used for recording and rounding by the program. Default: 2
-	Digit int64 `protobuf:"varint,4,opt,name=digit,proto3" json:"digit,omitempty"`
-	// Default Rate. You can specify an exchange rate vs. the default currency, which will be used by the reports.
-	Defrate float64 `protobuf:"fixed64,5,opt,name=defrate,proto3" json:"defrate,omitempty"`
-	// Rounding value for cash. Could be used in case the smallest banknote in circulation for that certain currency is not 1.
-	Cround int64 `protobuf:"varint,6,opt,name=cround,proto3" json:"cround,omitempty"`
-	// Currency meta data
-	Metadata []*MetaData `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	Rows    int64                   `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Success int64                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Failed  int64                   `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors  []*ResponseImport_Error `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *Currency) Reset() {
-	*x = Currency{}
+func (x *ResponseImport) Reset() {
+	*x = ResponseImport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[33]
+		mi := &file_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Currency) String() string {
+func (x *ResponseImport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Currency) ProtoMessage() {}
+func (*ResponseImport) ProtoMessage() {}
 
-func (x *Currency) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[33]
+func (x *ResponseImport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2560,111 +2447,71 @@ func (x *Currency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
-func (*Currency) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *Currency) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Currency) GetCurr() string {
-	if x != nil {
-		return x.Curr
-	}
-	return ""
-}
-
-func (x *Currency) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use ResponseImport.ProtoReflect.Descriptor instead.
+func (*ResponseImport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Currency) GetDigit() int64 {
+func (x *ResponseImport) GetRows() int64 {
 	if x != nil {
-		return x.Digit
+		return x.Rows
 	}
 	return 0
 }
 
-func (x *Currency) GetDefrate() float64 {
+func (x *ResponseImport) GetSuccess() int64 {
 	if x != nil {
-		return x.Defrate
+		return x.Success
 	}
 	return 0
 }
 
-func (x *Currency) GetCround() int64 {
+func (x *ResponseImport) GetFailed() int64 {
 	if x != nil {
-		return x.Cround
+		return x.Failed
 	}
 	return 0
 }
 
-func (x *Currency) GetMetadata() []*MetaData {
+func (x *ResponseImport) GetErrors() []*ResponseImport_Error {
 	if x != nil {
-		return x.Metadata
+		return x.Errors
 	}
 	return nil
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Customer *custnumber*
-//
-//- ```custtype```: Valid values: *own, company, private, other*
-type Customer struct {
+type RequestBankImport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'custtype')
-	Custtype int64 `protobuf:"varint,2,opt,name=custtype,proto3" json:"custtype,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = custnumber) data series.
-	Custnumber string `protobuf:"bytes,3,opt,name=custnumber,proto3" json:"custnumber,omitempty"`
-	// Full name of the customer
-	Custname  string `protobuf:"bytes,4,opt,name=custname,proto3" json:"custname,omitempty"`
-	Taxnumber string `protobuf:"bytes,5,opt,name=taxnumber,proto3" json:"taxnumber,omitempty"`
-	Account   string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
-	// Tax-free
-	Notax bool `protobuf:"varint,7,opt,name=notax,proto3" json:"notax,omitempty"`
-	// Payment per.
-	Terms int64 `protobuf:"varint,8,opt,name=terms,proto3" json:"terms,omitempty"`
-	// Customer's credit limit. Data is used by financial reports.
-	Creditlimit float64 `protobuf:"fixed64,9,opt,name=creditlimit,proto3" json:"creditlimit,omitempty"`
-	// If new product line is added (offer, order, invoice etc.) all products will receive the discount percentage specified in this field. If the product has a separate customer price, the value specified here will not be considered by the program.
-	Discount float64 `protobuf:"fixed64,10,opt,name=discount,proto3" json:"discount,omitempty"`
-	Notes    string  `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
-	Inactive bool    `protobuf:"varint,12,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	// Customer meta data
-	Metadata []*MetaData `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Valid values: camt053, mt940. Default value: XML content is camt053, other is mt940
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// CAMT.053 or MT940 file content
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The bank account (place) of the statements. Default value: default_bank setting
+	Planumber string `protobuf:"bytes,3,opt,name=planumber,proto3" json:"planumber,omitempty"`
+	// Match the statement lines without saving
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *Customer) Reset() {
-	*x = Customer{}
+func (x *RequestBankImport) Reset() {
+	*x = RequestBankImport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[34]
+		mi := &file_api_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Customer) String() string {
+func (x *RequestBankImport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Customer) ProtoMessage() {}
+func (*RequestBankImport) ProtoMessage() {}
 
-func (x *Customer) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[34]
+func (x *RequestBankImport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2675,153 +2522,152 @@ func (x *Customer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
-func (*Customer) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use RequestBankImport.ProtoReflect.Descriptor instead.
+func (*RequestBankImport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Customer) GetId() int64 {
+func (x *RequestBankImport) GetFormat() string {
 	if x != nil {
-		return x.Id
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *Customer) GetCusttype() int64 {
+func (x *RequestBankImport) GetValue() []byte {
 	if x != nil {
-		return x.Custtype
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-func (x *Customer) GetCustnumber() string {
+func (x *RequestBankImport) GetPlanumber() string {
 	if x != nil {
-		return x.Custnumber
+		return x.Planumber
 	}
 	return ""
 }
 
-func (x *Customer) GetCustname() string {
+func (x *RequestBankImport) GetDryRun() bool {
 	if x != nil {
-		return x.Custname
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *Customer) GetTaxnumber() string {
-	if x != nil {
-		return x.Taxnumber
-	}
-	return ""
+type ResponseBankImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows       int64                           `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Matched    int64                           `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	Statements []*ResponseBankImport_Statement `protobuf:"bytes,3,rep,name=statements,proto3" json:"statements,omitempty"`
+	Matches    []*ResponseBankImport_Match     `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+	Unmatched  []*ResponseBankImport_Line      `protobuf:"bytes,5,rep,name=unmatched,proto3" json:"unmatched,omitempty"`
+	DryRun     bool                            `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *Customer) GetAccount() string {
-	if x != nil {
-		return x.Account
+func (x *ResponseBankImport) Reset() {
+	*x = ResponseBankImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Customer) GetNotax() bool {
-	if x != nil {
-		return x.Notax
+func (x *ResponseBankImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseBankImport) ProtoMessage() {}
+
+func (x *ResponseBankImport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Customer) GetTerms() int64 {
+// Deprecated: Use ResponseBankImport.ProtoReflect.Descriptor instead.
+func (*ResponseBankImport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResponseBankImport) GetRows() int64 {
 	if x != nil {
-		return x.Terms
+		return x.Rows
 	}
 	return 0
 }
 
-func (x *Customer) GetCreditlimit() float64 {
+func (x *ResponseBankImport) GetMatched() int64 {
 	if x != nil {
-		return x.Creditlimit
+		return x.Matched
 	}
 	return 0
 }
 
-func (x *Customer) GetDiscount() float64 {
+func (x *ResponseBankImport) GetStatements() []*ResponseBankImport_Statement {
 	if x != nil {
-		return x.Discount
+		return x.Statements
 	}
-	return 0
+	return nil
 }
 
-func (x *Customer) GetNotes() string {
+func (x *ResponseBankImport) GetMatches() []*ResponseBankImport_Match {
 	if x != nil {
-		return x.Notes
+		return x.Matches
 	}
-	return ""
+	return nil
 }
 
-func (x *Customer) GetInactive() bool {
+func (x *ResponseBankImport) GetUnmatched() []*ResponseBankImport_Line {
 	if x != nil {
-		return x.Inactive
+		return x.Unmatched
 	}
-	return false
+	return nil
 }
 
-func (x *Customer) GetMetadata() []*MetaData {
+func (x *ResponseBankImport) GetDryRun() bool {
 	if x != nil {
-		return x.Metadata
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Deffield *fieldname*
-//
-//- ```nervatype```: Valid values: *address, barcode, contact, currency, customer, employee, event, item, link, log, movement, payment, price, place, product, project, rate, tax, tool, trans, setting*
-//
-//- ```subtype```: All groupvalue from Groups, where groupname equal *custtype, placetype, protype, toolgroup, transtype*
-//
-//- ```fieldtype```: Valid values: *bool, date, time, float, integer, string, valuelist, notes, urlink, password, customer, tool, transitem, transmovement, transpayment, product, project, employee, place*
-type Deffield struct {
+type RequestUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fieldname string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
-	//  Reference to [Groups](#groups).id (where groupname in ('custtype','placetype','  protype','toolgroup','transtype'))
-	Subtype *int64 `protobuf:"varint,4,opt,name=subtype,proto3,oneof" json:"subtype,omitempty"`
-	// Reference to [Groups](#groups).id  (only where groupname = 'fieldtype')
-	Fieldtype   int64  `protobuf:"varint,5,opt,name=fieldtype,proto3" json:"fieldtype,omitempty"`
-	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	// If fieldtype=valuelist: valid values are listed, separated by ~
-	Valuelist string `protobuf:"bytes,7,opt,name=valuelist,proto3" json:"valuelist,omitempty"`
-	// When selected, the attribute in case of adding a new element (eg a new customer or employee is created) will automatically be created with the default value according to its type and also will be attached to the new element.
-	Addnew bool `protobuf:"varint,8,opt,name=addnew,proto3" json:"addnew,omitempty"`
-	// Can appear or not (hidden value) on the entry forms
-	Visible bool `protobuf:"varint,9,opt,name=visible,proto3" json:"visible,omitempty"`
-	// The value of the attribute can not be changed in the program interface
-	Readonly bool `protobuf:"varint,10,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Nervatype DataType              `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
+	Items     []*RequestUpdate_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *Deffield) Reset() {
-	*x = Deffield{}
+func (x *RequestUpdate) Reset() {
+	*x = RequestUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[35]
+		mi := &file_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Deffield) String() string {
+func (x *RequestUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Deffield) ProtoMessage() {}
+func (*RequestUpdate) ProtoMessage() {}
 
-func (x *Deffield) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[35]
+func (x *RequestUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2832,128 +2678,189 @@ func (x *Deffield) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Deffield.ProtoReflect.Descriptor instead.
-func (*Deffield) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use RequestUpdate.ProtoReflect.Descriptor instead.
+func (*RequestUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Deffield) GetId() int64 {
+func (x *RequestUpdate) GetNervatype() DataType {
 	if x != nil {
-		return x.Id
+		return x.Nervatype
 	}
-	return 0
+	return DataType_address
 }
 
-func (x *Deffield) GetFieldname() string {
+func (x *RequestUpdate) GetItems() []*RequestUpdate_Item {
 	if x != nil {
-		return x.Fieldname
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *Deffield) GetNervatype() int64 {
-	if x != nil {
-		return x.Nervatype
+// If the ID (or Key) value is missing, it creates a new item.
+type ResponseUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Returns the all new/updated IDs values.
+	Values []int64 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *ResponseUpdate) Reset() {
+	*x = ResponseUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Deffield) GetSubtype() int64 {
-	if x != nil && x.Subtype != nil {
-		return *x.Subtype
+func (x *ResponseUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseUpdate) ProtoMessage() {}
+
+func (x *ResponseUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Deffield) GetFieldtype() int64 {
+// Deprecated: Use ResponseUpdate.ProtoReflect.Descriptor instead.
+func (*ResponseUpdate) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ResponseUpdate) GetValues() []int64 {
 	if x != nil {
-		return x.Fieldtype
+		return x.Values
 	}
-	return 0
+	return nil
 }
 
-func (x *Deffield) GetDescription() string {
-	if x != nil {
-		return x.Description
+// Change feed parameters
+type RequestSubscribe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Watched nervatypes. Empty value: all nervatypes
+	Nervatypes []DataType `protobuf:"varint,1,rep,packed,name=nervatypes,proto3,enum=nervatura.DataType" json:"nervatypes,omitempty"`
+	// The last received event id. Resume the stream after reconnecting. Default value: 0 (only the new events)
+	Cursor int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Valid values: insert, update, delete. Empty value: all operations
+	Operations []string `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	//
+	//Optional record field filters, checked against the current values of the record.
+	//Only applied to the nervatypes that have the filter field.
+	//Reference fields can also be filtered by key values. Example: transtype: invoice, customer_id: DMCUST/00001
+	Filters map[string]*Value `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RequestSubscribe) Reset() {
+	*x = RequestSubscribe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Deffield) GetValuelist() string {
+func (x *RequestSubscribe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSubscribe) ProtoMessage() {}
+
+func (x *RequestSubscribe) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSubscribe.ProtoReflect.Descriptor instead.
+func (*RequestSubscribe) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RequestSubscribe) GetNervatypes() []DataType {
 	if x != nil {
-		return x.Valuelist
+		return x.Nervatypes
 	}
-	return ""
+	return nil
 }
 
-func (x *Deffield) GetAddnew() bool {
+func (x *RequestSubscribe) GetCursor() int64 {
 	if x != nil {
-		return x.Addnew
+		return x.Cursor
 	}
-	return false
+	return 0
 }
 
-func (x *Deffield) GetVisible() bool {
+func (x *RequestSubscribe) GetOperations() []string {
 	if x != nil {
-		return x.Visible
+		return x.Operations
 	}
-	return false
+	return nil
 }
 
-func (x *Deffield) GetReadonly() bool {
+func (x *RequestSubscribe) GetFilters() map[string]*Value {
 	if x != nil {
-		return x.Readonly
+		return x.Filters
 	}
-	return false
+	return nil
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Employee *empnumber*
-//
-//- ```usergroup```: All groupvalue from Groups, where groupname equal usergroup
-//
-//- ```department```: All groupvalue from Groups, where groupname equal department
-type Employee struct {
+// Record change event
+type ResponseEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = empnumber) data series.
-	Empnumber string `protobuf:"bytes,2,opt,name=empnumber,proto3" json:"empnumber,omitempty"`
-	// Database login name. Should be unique on database level.
-	Username *string `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'usergroup')
-	Usergroup int64   `protobuf:"varint,4,opt,name=usergroup,proto3" json:"usergroup,omitempty"`
-	Startdate *string `protobuf:"bytes,5,opt,name=startdate,proto3,oneof" json:"startdate,omitempty"`
-	Enddate   *string `protobuf:"bytes,6,opt,name=enddate,proto3,oneof" json:"enddate,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'department')
-	Department      *int64 `protobuf:"varint,7,opt,name=department,proto3,oneof" json:"department,omitempty"`
-	RegistrationKey string `protobuf:"bytes,8,opt,name=registration_key,json=registrationKey,proto3" json:"registration_key,omitempty"`
-	Inactive        bool   `protobuf:"varint,9,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	// Employee meta data
-	Metadata []*MetaData `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty"`
-}
-
-func (x *Employee) Reset() {
-	*x = Employee{}
+	// Event id. The sequence cursor of the stream.
+	Id        int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nervatype DataType `protobuf:"varint,2,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
+	RefId     int64    `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Refnumber string   `protobuf:"bytes,4,opt,name=refnumber,proto3" json:"refnumber,omitempty"`
+	// insert, update or delete
+	Operation string `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	Username  string `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	Crdate    string `protobuf:"bytes,7,opt,name=crdate,proto3" json:"crdate,omitempty"`
+	// The changed field values
+	Values map[string]*Value `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ResponseEvent) Reset() {
+	*x = ResponseEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[36]
+		mi := &file_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Employee) String() string {
+func (x *ResponseEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Employee) ProtoMessage() {}
+func (*ResponseEvent) ProtoMessage() {}
 
-func (x *Employee) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[36]
+func (x *ResponseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2964,134 +2871,116 @@ func (x *Employee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Employee.ProtoReflect.Descriptor instead.
-func (*Employee) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ResponseEvent.ProtoReflect.Descriptor instead.
+func (*ResponseEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Employee) GetId() int64 {
+func (x *ResponseEvent) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Employee) GetEmpnumber() string {
+func (x *ResponseEvent) GetNervatype() DataType {
 	if x != nil {
-		return x.Empnumber
-	}
-	return ""
-}
-
-func (x *Employee) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+		return x.Nervatype
 	}
-	return ""
+	return DataType_address
 }
 
-func (x *Employee) GetUsergroup() int64 {
+func (x *ResponseEvent) GetRefId() int64 {
 	if x != nil {
-		return x.Usergroup
+		return x.RefId
 	}
 	return 0
 }
 
-func (x *Employee) GetStartdate() string {
-	if x != nil && x.Startdate != nil {
-		return *x.Startdate
+func (x *ResponseEvent) GetRefnumber() string {
+	if x != nil {
+		return x.Refnumber
 	}
 	return ""
 }
 
-func (x *Employee) GetEnddate() string {
-	if x != nil && x.Enddate != nil {
-		return *x.Enddate
+func (x *ResponseEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
 	}
 	return ""
 }
 
-func (x *Employee) GetDepartment() int64 {
-	if x != nil && x.Department != nil {
-		return *x.Department
-	}
-	return 0
-}
-
-func (x *Employee) GetRegistrationKey() string {
+func (x *ResponseEvent) GetUsername() string {
 	if x != nil {
-		return x.RegistrationKey
+		return x.Username
 	}
 	return ""
 }
 
-func (x *Employee) GetInactive() bool {
+func (x *ResponseEvent) GetCrdate() string {
 	if x != nil {
-		return x.Inactive
+		return x.Crdate
 	}
-	return false
+	return ""
 }
 
-func (x *Employee) GetMetadata() []*MetaData {
+func (x *ResponseEvent) GetValues() map[string]*Value {
 	if x != nil {
-		return x.Metadata
+		return x.Values
 	}
 	return nil
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Event *calnumber*
-//
-//- ```nervatype```: Valid values: *customer, employee, place, product, project, tool, trans*
-//
-//- ```ref_id```: Valid values: *customer/{custnumber}, employee/{empnumber}, place/{planumber}, product/{partnumber}, project/{pronumber}, tool/{serial}, trans/{transnumber}*
-//
-//- ```eventgroup```: All groupvalue from Groups, where groupname equal eventgroup
-type Event struct {
+type RequestGet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = calnumber) data series.
-	Calnumber string `protobuf:"bytes,2,opt,name=calnumber,proto3" json:"calnumber,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
-	// Reference to [Customer](#customer).id, [Employee](#employee).id, [Place](#place).id, [Product](#product).id, [Project](#project).id, [Tool](#tool).id, [Trans](#trans).id
-	RefId int64  `protobuf:"varint,4,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
-	Uid   string `protobuf:"bytes,5,opt,name=uid,proto3" json:"uid,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'eventgroup')
-	Eventgroup *int64 `protobuf:"varint,6,opt,name=eventgroup,proto3,oneof" json:"eventgroup,omitempty"`
-	// Datetime
-	Fromdate string `protobuf:"bytes,7,opt,name=fromdate,proto3" json:"fromdate,omitempty"`
-	// Datetime
-	Todate      *string `protobuf:"bytes,8,opt,name=todate,proto3,oneof" json:"todate,omitempty"`
-	Subject     string  `protobuf:"bytes,9,opt,name=subject,proto3" json:"subject,omitempty"`
-	Place       string  `protobuf:"bytes,10,opt,name=place,proto3" json:"place,omitempty"`
-	Description string  `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
-	// Event meta data
-	Metadata []*MetaData `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	Nervatype DataType `protobuf:"varint,1,opt,name=nervatype,proto3,enum=nervatura.DataType" json:"nervatype,omitempty"`
+	Metadata  bool     `protobuf:"varint,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Ids       []int64  `protobuf:"varint,3,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	//
+	//Compact filter conditions: fieldname;comparison;value (joined with AND).
+	//Comparison values: ==, !=, <, <=, >, >=, in, like, is (null, notnull), between.
+	//The "||" separated condition groups are joined with OR. Example: custname;like;%Co.%||custtype;in;116,117
+	//The metadata (deffield) fieldnames can also be used. Example: sample_customer_float;>;100
+	Filter []string `protobuf:"bytes,7,rep,name=filter,proto3" json:"filter,omitempty"`
+	// Returned fields (projection). The id field is always returned. Default value: all fields
+	Fields []string `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Ordering fields with optional asc/desc direction. Example: custname desc
+	Order []string `protobuf:"bytes,9,rep,name=order,proto3" json:"order,omitempty"`
+	// Max. number of returned records. Default value: 0 (all records)
+	Limit int64 `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of skipped records
+	Offset int64 `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Keyset pagination: the id of the last record of the previous page. Only with the id (or id desc) ordering
+	Cursor int64 `protobuf:"varint,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Returns the total number of the filtered records (without paging)
+	Total bool `protobuf:"varint,13,opt,name=total,proto3" json:"total,omitempty"`
+	//
+	//JSON filter tree. Condition nodes: {"field": "custname", "comp": "like", "value": "%Co.%"},
+	//group nodes: {"and": [nodes]}, {"or": [nodes]}. Example: {"or": [{"field": "custname", "comp": "like", "value": "%Co.%"}, {"field": "custtype", "comp": "in", "value": [116, 117]}]}
+	FilterTree string `protobuf:"bytes,14,opt,name=filter_tree,json=filterTree,proto3" json:"filter_tree,omitempty"`
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
+func (x *RequestGet) Reset() {
+	*x = RequestGet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[37]
+		mi := &file_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event) String() string {
+func (x *RequestGet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*RequestGet) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[37]
+func (x *RequestGet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3102,132 +2991,115 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RequestGet.ProtoReflect.Descriptor instead.
+func (*RequestGet) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Event) GetId() int64 {
+func (x *RequestGet) GetNervatype() DataType {
 	if x != nil {
-		return x.Id
+		return x.Nervatype
 	}
-	return 0
+	return DataType_address
 }
 
-func (x *Event) GetCalnumber() string {
+func (x *RequestGet) GetMetadata() bool {
 	if x != nil {
-		return x.Calnumber
+		return x.Metadata
 	}
-	return ""
+	return false
 }
 
-func (x *Event) GetNervatype() int64 {
+func (x *RequestGet) GetIds() []int64 {
 	if x != nil {
-		return x.Nervatype
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-func (x *Event) GetRefId() int64 {
+func (x *RequestGet) GetFilter() []string {
 	if x != nil {
-		return x.RefId
+		return x.Filter
 	}
-	return 0
+	return nil
 }
 
-func (x *Event) GetUid() string {
+func (x *RequestGet) GetFields() []string {
 	if x != nil {
-		return x.Uid
-	}
-	return ""
-}
-
-func (x *Event) GetEventgroup() int64 {
-	if x != nil && x.Eventgroup != nil {
-		return *x.Eventgroup
+		return x.Fields
 	}
-	return 0
+	return nil
 }
 
-func (x *Event) GetFromdate() string {
+func (x *RequestGet) GetOrder() []string {
 	if x != nil {
-		return x.Fromdate
+		return x.Order
 	}
-	return ""
+	return nil
 }
 
-func (x *Event) GetTodate() string {
-	if x != nil && x.Todate != nil {
-		return *x.Todate
+func (x *RequestGet) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetSubject() string {
+func (x *RequestGet) GetOffset() int64 {
 	if x != nil {
-		return x.Subject
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetPlace() string {
+func (x *RequestGet) GetCursor() int64 {
 	if x != nil {
-		return x.Place
+		return x.Cursor
 	}
-	return ""
+	return 0
 }
 
-func (x *Event) GetDescription() string {
+func (x *RequestGet) GetTotal() bool {
 	if x != nil {
-		return x.Description
+		return x.Total
 	}
-	return ""
+	return false
 }
 
-func (x *Event) GetMetadata() []*MetaData {
+func (x *RequestGet) GetFilterTree() string {
 	if x != nil {
-		return x.Metadata
+		return x.FilterTree
 	}
-	return nil
+	return ""
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: The value is a generated constant key identifier: *{refnumber}~~{fieldname}~{rownumber}*. The rownumber is the order of multiple *{refnumber}~~{fieldname}* keys.
-//
-//- ```ref_id```: Valid values: *{nervatype}/{refnumber}*
-type Fieldvalue struct {
+type ResponseGet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Deffield](#deffield).fieldname.
-	Fieldname string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
-	// Reference to any type.id where type = [Deffield](#deffield).nervatype. If it is null then nervatype = setting.
-	RefId *int64 `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3,oneof" json:"ref_id,omitempty"`
-	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Notes string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Values []*ResponseGet_Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	// The total number of the filtered records, if the total value of the request is true
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *Fieldvalue) Reset() {
-	*x = Fieldvalue{}
+func (x *ResponseGet) Reset() {
+	*x = ResponseGet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[38]
+		mi := &file_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Fieldvalue) String() string {
+func (x *ResponseGet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fieldvalue) ProtoMessage() {}
+func (*ResponseGet) ProtoMessage() {}
 
-func (x *Fieldvalue) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[38]
+func (x *ResponseGet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3238,79 +3110,56 @@ func (x *Fieldvalue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fieldvalue.ProtoReflect.Descriptor instead.
-func (*Fieldvalue) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ResponseGet.ProtoReflect.Descriptor instead.
+func (*ResponseGet) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Fieldvalue) GetId() int64 {
+func (x *ResponseGet) GetValues() []*ResponseGet_Value {
 	if x != nil {
-		return x.Id
+		return x.Values
 	}
-	return 0
+	return nil
 }
 
-func (x *Fieldvalue) GetFieldname() string {
+func (x *ResponseGet) GetTotal() int64 {
 	if x != nil {
-		return x.Fieldname
-	}
-	return ""
-}
-
-func (x *Fieldvalue) GetRefId() int64 {
-	if x != nil && x.RefId != nil {
-		return *x.RefId
+		return x.Total
 	}
 	return 0
 }
 
-func (x *Fieldvalue) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-func (x *Fieldvalue) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
-
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: Group *groupname~groupvalue*
-type Groups struct {
+type MetaData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Groupname   string `protobuf:"bytes,2,opt,name=groupname,proto3" json:"groupname,omitempty"`
-	Groupvalue  string `protobuf:"bytes,3,opt,name=groupvalue,proto3" json:"groupvalue,omitempty"`
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Inactive    bool   `protobuf:"varint,5,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference to [Deffield](#deffield).fieldname.
+	Fieldname string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	// Reference to [Deffield](#deffield).fieldtype.
+	Fieldtype string `protobuf:"bytes,3,opt,name=fieldtype,proto3" json:"fieldtype,omitempty"`
+	Value     string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Notes     string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *Groups) Reset() {
-	*x = Groups{}
+func (x *MetaData) Reset() {
+	*x = MetaData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[39]
+		mi := &file_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Groups) String() string {
+func (x *MetaData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Groups) ProtoMessage() {}
+func (*MetaData) ProtoMessage() {}
 
-func (x *Groups) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[39]
+func (x *MetaData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3321,100 +3170,93 @@ func (x *Groups) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Groups.ProtoReflect.Descriptor instead.
-func (*Groups) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use MetaData.ProtoReflect.Descriptor instead.
+func (*MetaData) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Groups) GetId() int64 {
+func (x *MetaData) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Groups) GetGroupname() string {
+func (x *MetaData) GetFieldname() string {
 	if x != nil {
-		return x.Groupname
+		return x.Fieldname
 	}
 	return ""
 }
 
-func (x *Groups) GetGroupvalue() string {
+func (x *MetaData) GetFieldtype() string {
 	if x != nil {
-		return x.Groupvalue
+		return x.Fieldtype
 	}
 	return ""
 }
 
-func (x *Groups) GetDescription() string {
+func (x *MetaData) GetValue() string {
 	if x != nil {
-		return x.Description
+		return x.Value
 	}
 	return ""
 }
 
-func (x *Groups) GetInactive() bool {
+func (x *MetaData) GetNotes() string {
 	if x != nil {
-		return x.Inactive
+		return x.Notes
 	}
-	return false
+	return ""
 }
 
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Trans and Item *transnumber~rownumber*
+//- ```id```: Value is a generated unique key identifier: *{nervatype}/{refnumber}~{rownumber}*. The *rownumber* is the order of multiple *{nervatype}/{refnumber}* keys. For example: ```customer/DMCUST/00001~1```
 //
-//- ```trans_id```: Trans *transnumber*
+//- ```nervatype```: Valid values: *customer, employee, event, place, product, project, tool, trans*
 //
-//- ```product_id```: Product *partnumber*
+//- ```ref_id```: Valid values: *customer/{custnumber}, employee/{empnumber}, event/{calnumber}, place/{planumber}, product/{partnumber},
+//project/{pronumber}, tool/{serial}, trans/{transnumber}*
 //
-//- ```tax_id```: Tax *taxcode*
-type Item struct {
+type Address struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [trans](#trans).id
-	TransId int64 `protobuf:"varint,2,opt,name=trans_id,json=transId,proto3" json:"trans_id,omitempty"`
-	// Reference to [product](#product).id
-	ProductId int64   `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Unit      string  `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
-	Qty       float64 `protobuf:"fixed64,5,opt,name=qty,proto3" json:"qty,omitempty"`
-	Fxprice   float64 `protobuf:"fixed64,6,opt,name=fxprice,proto3" json:"fxprice,omitempty"`
-	Netamount float64 `protobuf:"fixed64,7,opt,name=netamount,proto3" json:"netamount,omitempty"`
-	Discount  float64 `protobuf:"fixed64,8,opt,name=discount,proto3" json:"discount,omitempty"`
-	// Reference to [Tax](#tax).id
-	TaxId       int64   `protobuf:"varint,9,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
-	Vatamount   float64 `protobuf:"fixed64,10,opt,name=vatamount,proto3" json:"vatamount,omitempty"`
-	Amount      float64 `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount,omitempty"`
-	Description string  `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
-	Deposit     bool    `protobuf:"varint,13,opt,name=deposit,proto3" json:"deposit,omitempty"`
-	Ownstock    float64 `protobuf:"fixed64,14,opt,name=ownstock,proto3" json:"ownstock,omitempty"`
-	Actionprice bool    `protobuf:"varint,15,opt,name=actionprice,proto3" json:"actionprice,omitempty"`
-	// Item meta data
-	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype int64 `protobuf:"varint,2,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
+	// Reference to [Customer](#customer).id, [Employee](#employee).id, [Event](#event).id, [Place](#place).id, [Product](#product).id, [Project](#project).id, [Tool](#tool).id, [Trans](#trans).id
+	RefId   int64  `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Country string `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	State   string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Zipcode string `protobuf:"bytes,6,opt,name=zipcode,proto3" json:"zipcode,omitempty"`
+	City    string `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	Street  string `protobuf:"bytes,8,opt,name=street,proto3" json:"street,omitempty"`
+	Notes   string `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Address meta data
+	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Item) Reset() {
-	*x = Item{}
+func (x *Address) Reset() {
+	*x = Address{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[40]
+		mi := &file_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Item) String() string {
+func (x *Address) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Item) ProtoMessage() {}
+func (*Address) ProtoMessage() {}
 
-func (x *Item) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[40]
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3425,117 +3267,75 @@ func (x *Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Item.ProtoReflect.Descriptor instead.
-func (*Item) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Item) GetId() int64 {
+func (x *Address) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Item) GetTransId() int64 {
+func (x *Address) GetNervatype() int64 {
 	if x != nil {
-		return x.TransId
+		return x.Nervatype
 	}
 	return 0
 }
 
-func (x *Item) GetProductId() int64 {
+func (x *Address) GetRefId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.RefId
 	}
 	return 0
 }
 
-func (x *Item) GetUnit() string {
+func (x *Address) GetCountry() string {
 	if x != nil {
-		return x.Unit
+		return x.Country
 	}
 	return ""
 }
 
-func (x *Item) GetQty() float64 {
-	if x != nil {
-		return x.Qty
-	}
-	return 0
-}
-
-func (x *Item) GetFxprice() float64 {
-	if x != nil {
-		return x.Fxprice
-	}
-	return 0
-}
-
-func (x *Item) GetNetamount() float64 {
-	if x != nil {
-		return x.Netamount
-	}
-	return 0
-}
-
-func (x *Item) GetDiscount() float64 {
-	if x != nil {
-		return x.Discount
-	}
-	return 0
-}
-
-func (x *Item) GetTaxId() int64 {
-	if x != nil {
-		return x.TaxId
-	}
-	return 0
-}
-
-func (x *Item) GetVatamount() float64 {
-	if x != nil {
-		return x.Vatamount
-	}
-	return 0
-}
-
-func (x *Item) GetAmount() float64 {
+func (x *Address) GetState() string {
 	if x != nil {
-		return x.Amount
+		return x.State
 	}
-	return 0
+	return ""
 }
 
-func (x *Item) GetDescription() string {
+func (x *Address) GetZipcode() string {
 	if x != nil {
-		return x.Description
+		return x.Zipcode
 	}
 	return ""
 }
 
-func (x *Item) GetDeposit() bool {
+func (x *Address) GetCity() string {
 	if x != nil {
-		return x.Deposit
+		return x.City
 	}
-	return false
+	return ""
 }
 
-func (x *Item) GetOwnstock() float64 {
+func (x *Address) GetStreet() string {
 	if x != nil {
-		return x.Ownstock
+		return x.Street
 	}
-	return 0
+	return ""
 }
 
-func (x *Item) GetActionprice() bool {
+func (x *Address) GetNotes() string {
 	if x != nil {
-		return x.Actionprice
+		return x.Notes
 	}
-	return false
+	return ""
 }
 
-func (x *Item) GetMetadata() []*MetaData {
+func (x *Address) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -3545,48 +3345,48 @@ func (x *Item) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: *{nervatype_1}~{refnumber_1}~~{nervatype_2}~{refnumber_2}*
-//
-//- ```nervatype_1```: All groupvalue from Groups, where groupname equal nervatype
+//- ```id```: Barcode *code*
 //
-//- ```ref_id_1```: *{nervatype_1}/{refnumber_1}*
+//- ```barcodetype```: Valid values: *CODE_128, CODE_39, EAN_13, EAN_8, QR*
 //
-//- ```nervatype_2```: All groupvalue from Groups, where groupname equal nervatype
-type Link struct {
+//- ```product_id```: Product *partnumber*
+type Barcode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype_1 int64 `protobuf:"varint,2,opt,name=nervatype_1,json=nervatype1,proto3" json:"nervatype_1,omitempty"`
-	// Reference to {nervatype}.id
-	RefId_1 int64 `protobuf:"varint,3,opt,name=ref_id_1,json=refId1,proto3" json:"ref_id_1,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype_2 int64 `protobuf:"varint,4,opt,name=nervatype_2,json=nervatype2,proto3" json:"nervatype_2,omitempty"`
-	// Reference to {nervatype}.id
-	RefId_2 int64 `protobuf:"varint,5,opt,name=ref_id_2,json=refId2,proto3" json:"ref_id_2,omitempty"`
-	// Link meta data
-	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Each product can be connected to any number of bar codes, but the code must remain unique to ensure that the product is clearly identifiable.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Reference to [Product](#product).id
+	ProductId int64 `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Comment related to the barcode. Informal, has no role in identification.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Reference to [Groups](#groups).id  (only where groupname = 'barcodetype').
+	Barcodetype int64 `protobuf:"varint,5,opt,name=barcodetype,proto3" json:"barcodetype,omitempty"`
+	// The actual amount of the products identified by the barcode. For example can be used for packaged goods, tray packaging.
+	Qty float64 `protobuf:"fixed64,6,opt,name=qty,proto3" json:"qty,omitempty"`
+	// If more than one bar code is assigned, this will be the default. Because of the uniqueness of the barcode the product is always clearly identifiable, but in reverse case (eg. in case the barcode should be printed on a document) we must assign one being the default for that product.
+	Defcode bool `protobuf:"varint,7,opt,name=defcode,proto3" json:"defcode,omitempty"`
 }
 
-func (x *Link) Reset() {
-	*x = Link{}
+func (x *Barcode) Reset() {
+	*x = Barcode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[41]
+		mi := &file_api_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Link) String() string {
+func (x *Barcode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Link) ProtoMessage() {}
+func (*Barcode) ProtoMessage() {}
 
-func (x *Link) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[41]
+func (x *Barcode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3597,102 +3397,107 @@ func (x *Link) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Link.ProtoReflect.Descriptor instead.
-func (*Link) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use Barcode.ProtoReflect.Descriptor instead.
+func (*Barcode) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Link) GetId() int64 {
+func (x *Barcode) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Link) GetNervatype_1() int64 {
+func (x *Barcode) GetCode() string {
 	if x != nil {
-		return x.Nervatype_1
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *Link) GetRefId_1() int64 {
+func (x *Barcode) GetProductId() int64 {
 	if x != nil {
-		return x.RefId_1
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *Link) GetNervatype_2() int64 {
+func (x *Barcode) GetDescription() string {
 	if x != nil {
-		return x.Nervatype_2
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Barcode) GetBarcodetype() int64 {
+	if x != nil {
+		return x.Barcodetype
 	}
 	return 0
 }
 
-func (x *Link) GetRefId_2() int64 {
+func (x *Barcode) GetQty() float64 {
 	if x != nil {
-		return x.RefId_2
+		return x.Qty
 	}
 	return 0
 }
 
-func (x *Link) GetMetadata() []*MetaData {
+func (x *Barcode) GetDefcode() bool {
 	if x != nil {
-		return x.Metadata
+		return x.Defcode
 	}
-	return nil
+	return false
 }
 
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: *{empnumber}~{crdate}'*
-//
-//- ```employee_id```: Employee *empnumber*
-//
-//- ```ref_id```: *{nervatype}/{refnumber}*
+//- ```id```: The value is a generated constant key identifier: *{nervatype}/{refnumber}~{rownumber}*. The rownumber is the order of multiple *{nervatype}/{refnumber}* keys.
 //
-//- ```nervatype```: All groupvalue from Groups, where groupname equal nervatype
+//- ```nervatype```: Valid values: *customer, employee, event, place, product, project, tool, trans*
 //
-//- ```logstate```: Valid values: update, closed, deleted, print, login, logout
-type Log struct {
+//- ```ref_id```: Valid values: *customer/{custnumber}, employee/{empnumber}, event/{calnumber}, place/{planumber}, product/{partnumber}, project/{pronumber}, tool/{serial}, trans/{transnumber}*
+type Contact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Employee](#employee).id
-	EmployeeId int64 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
-	// Date-time
-	Crdate string `protobuf:"bytes,3,opt,name=crdate,proto3" json:"crdate,omitempty"`
 	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype *int64 `protobuf:"varint,4,opt,name=nervatype,proto3,oneof" json:"nervatype,omitempty"`
-	// Reference to {nervatype}.id
-	RefId *int64 `protobuf:"varint,5,opt,name=ref_id,json=refId,proto3,oneof" json:"ref_id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'logstate')
-	Logstate int64 `protobuf:"varint,6,opt,name=logstate,proto3" json:"logstate,omitempty"`
-	// Log meta data
-	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	Nervatype int64 `protobuf:"varint,2,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
+	// Reference to [Customer](#customer).id, [Employee](#employee).id, [Event](#event).id, [Place](#place).id, [Product](#product).id, [Project](#project).id, [Tool](#tool).id, [Trans](#trans).id
+	RefId     int64  `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Firstname string `protobuf:"bytes,4,opt,name=firstname,proto3" json:"firstname,omitempty"`
+	Surname   string `protobuf:"bytes,5,opt,name=surname,proto3" json:"surname,omitempty"`
+	Status    string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Phone     string `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
+	Fax       string `protobuf:"bytes,8,opt,name=fax,proto3" json:"fax,omitempty"`
+	Mobil     string `protobuf:"bytes,9,opt,name=mobil,proto3" json:"mobil,omitempty"`
+	Email     string `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty"`
+	Notes     string `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Contact meta data
+	Metadata []*MetaData `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Log) Reset() {
-	*x = Log{}
+func (x *Contact) Reset() {
+	*x = Contact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[42]
+		mi := &file_api_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Log) String() string {
+func (x *Contact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Log) ProtoMessage() {}
+func (*Contact) ProtoMessage() {}
 
-func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[42]
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3703,54 +3508,89 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Log.ProtoReflect.Descriptor instead.
-func (*Log) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *Log) GetId() int64 {
+func (x *Contact) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Log) GetEmployeeId() int64 {
+func (x *Contact) GetNervatype() int64 {
 	if x != nil {
-		return x.EmployeeId
+		return x.Nervatype
 	}
 	return 0
 }
 
-func (x *Log) GetCrdate() string {
+func (x *Contact) GetRefId() int64 {
 	if x != nil {
-		return x.Crdate
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *Contact) GetFirstname() string {
+	if x != nil {
+		return x.Firstname
 	}
 	return ""
 }
 
-func (x *Log) GetNervatype() int64 {
-	if x != nil && x.Nervatype != nil {
-		return *x.Nervatype
+func (x *Contact) GetSurname() string {
+	if x != nil {
+		return x.Surname
 	}
-	return 0
+	return ""
 }
 
-func (x *Log) GetRefId() int64 {
-	if x != nil && x.RefId != nil {
-		return *x.RefId
+func (x *Contact) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *Log) GetLogstate() int64 {
+func (x *Contact) GetPhone() string {
 	if x != nil {
-		return x.Logstate
+		return x.Phone
 	}
-	return 0
+	return ""
 }
 
-func (x *Log) GetMetadata() []*MetaData {
+func (x *Contact) GetFax() string {
+	if x != nil {
+		return x.Fax
+	}
+	return ""
+}
+
+func (x *Contact) GetMobil() string {
+	if x != nil {
+		return x.Mobil
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Contact) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Contact) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -3760,59 +3600,44 @@ func (x *Log) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Trans and Item *transnumber~rownumber*
-//
-//- ```trans_id```: Trans *transnumber*
-//
-//- ```product_id```: Product *partnumber*
-//
-//- ```movetype```: Valid values: *inventory, tool, plan, head*
-//
-//- ```tool_id```: Tool *serial*
-//
-//- ```place_id```: Place *planumber*
-type Movement struct {
+//- ```id```: Currency *curr*
+type Currency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Trans](#trans).id
-	TransId int64 `protobuf:"varint,2,opt,name=trans_id,json=transId,proto3" json:"trans_id,omitempty"`
-	// Date-time
-	Shippingdate string `protobuf:"bytes,3,opt,name=shippingdate,proto3" json:"shippingdate,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'movetype')
-	Movetype int64 `protobuf:"varint,4,opt,name=movetype,proto3" json:"movetype,omitempty"`
-	// Reference to [Product](#product).id
-	ProductId *int64 `protobuf:"varint,5,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
-	// Reference to [Tool](#tool).id
-	ToolId *int64 `protobuf:"varint,6,opt,name=tool_id,json=toolId,proto3,oneof" json:"tool_id,omitempty"`
-	// Reference to [Place](#place).id
-	PlaceId     *int64  `protobuf:"varint,7,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
-	Qty         float64 `protobuf:"fixed64,8,opt,name=qty,proto3" json:"qty,omitempty"`
-	Description string  `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
-	Shared      bool    `protobuf:"varint,10,opt,name=shared,proto3" json:"shared,omitempty"`
-	// Movement meta data
-	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// The ISO 4217 code of the currency.
+	Curr string `protobuf:"bytes,2,opt,name=curr,proto3" json:"curr,omitempty"`
+	// The name of the currency.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The number of decimal places used for recording and rounding by the program. Default: 2
+	Digit int64 `protobuf:"varint,4,opt,name=digit,proto3" json:"digit,omitempty"`
+	// Default Rate. You can specify an exchange rate vs. the default currency, which will be used by the reports.
+	Defrate float64 `protobuf:"fixed64,5,opt,name=defrate,proto3" json:"defrate,omitempty"`
+	// Rounding value for cash. Could be used in case the smallest banknote in circulation for that certain currency is not 1.
+	Cround int64 `protobuf:"varint,6,opt,name=cround,proto3" json:"cround,omitempty"`
+	// Currency meta data
+	Metadata []*MetaData `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Movement) Reset() {
-	*x = Movement{}
+func (x *Currency) Reset() {
+	*x = Currency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[43]
+		mi := &file_api_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Movement) String() string {
+func (x *Currency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Movement) ProtoMessage() {}
+func (*Currency) ProtoMessage() {}
 
-func (x *Movement) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[43]
+func (x *Currency) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3823,82 +3648,54 @@ func (x *Movement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Movement.ProtoReflect.Descriptor instead.
-func (*Movement) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use Currency.ProtoReflect.Descriptor instead.
+func (*Currency) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Movement) GetId() int64 {
+func (x *Currency) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Movement) GetTransId() int64 {
+func (x *Currency) GetCurr() string {
 	if x != nil {
-		return x.TransId
+		return x.Curr
 	}
-	return 0
+	return ""
 }
 
-func (x *Movement) GetShippingdate() string {
+func (x *Currency) GetDescription() string {
 	if x != nil {
-		return x.Shippingdate
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Movement) GetMovetype() int64 {
+func (x *Currency) GetDigit() int64 {
 	if x != nil {
-		return x.Movetype
-	}
-	return 0
-}
-
-func (x *Movement) GetProductId() int64 {
-	if x != nil && x.ProductId != nil {
-		return *x.ProductId
-	}
-	return 0
-}
-
-func (x *Movement) GetToolId() int64 {
-	if x != nil && x.ToolId != nil {
-		return *x.ToolId
-	}
-	return 0
-}
-
-func (x *Movement) GetPlaceId() int64 {
-	if x != nil && x.PlaceId != nil {
-		return *x.PlaceId
+		return x.Digit
 	}
 	return 0
 }
 
-func (x *Movement) GetQty() float64 {
+func (x *Currency) GetDefrate() float64 {
 	if x != nil {
-		return x.Qty
+		return x.Defrate
 	}
 	return 0
 }
 
-func (x *Movement) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *Movement) GetShared() bool {
+func (x *Currency) GetCround() int64 {
 	if x != nil {
-		return x.Shared
+		return x.Cround
 	}
-	return false
+	return 0
 }
 
-func (x *Movement) GetMetadata() []*MetaData {
+func (x *Currency) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -3908,48 +3705,54 @@ func (x *Movement) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Numberdef *numberkey*
-type Numberdef struct {
+//- ```id```: Customer *custnumber*
+//
+//- ```custtype```: Valid values: *own, company, private, other*
+type Customer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique key
-	Numberkey string `protobuf:"bytes,2,opt,name=numberkey,proto3" json:"numberkey,omitempty"`
-	// The text prefix of the identifier. It can be any length, but usage of special characters, spaces in the text is not recommended.
-	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	// The current status of the counter, the next sequence number will be one value higher than this one. It is possible to re-set the counter, but the uniqueness must be ensured in all cases!
-	Curvalue int64 `protobuf:"varint,4,opt,name=curvalue,proto3" json:"curvalue,omitempty"`
-	// If selected, the created identifier will contain the year.
-	Isyear bool `protobuf:"varint,5,opt,name=isyear,proto3" json:"isyear,omitempty"`
-	// The separator character in the identifier. Default: "/"
-	Sep string `protobuf:"bytes,6,opt,name=sep,proto3" json:"sep,omitempty"`
-	// The value field is arranged in such length to the right and filled with zeros.
-	Len         int64  `protobuf:"varint,7,opt,name=len,proto3" json:"len,omitempty"`
-	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
-	Visible     bool   `protobuf:"varint,9,opt,name=visible,proto3" json:"visible,omitempty"`
-	Readonly    bool   `protobuf:"varint,10,opt,name=readonly,proto3" json:"readonly,omitempty"`
-	Orderby     int64  `protobuf:"varint,11,opt,name=orderby,proto3" json:"orderby,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'custtype')
+	Custtype int64 `protobuf:"varint,2,opt,name=custtype,proto3" json:"custtype,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = custnumber) data series.
+	Custnumber string `protobuf:"bytes,3,opt,name=custnumber,proto3" json:"custnumber,omitempty"`
+	// Full name of the customer
+	Custname  string `protobuf:"bytes,4,opt,name=custname,proto3" json:"custname,omitempty"`
+	Taxnumber string `protobuf:"bytes,5,opt,name=taxnumber,proto3" json:"taxnumber,omitempty"`
+	Account   string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
+	// Tax-free
+	Notax bool `protobuf:"varint,7,opt,name=notax,proto3" json:"notax,omitempty"`
+	// Payment per.
+	Terms int64 `protobuf:"varint,8,opt,name=terms,proto3" json:"terms,omitempty"`
+	// Customer's credit limit. Data is used by financial reports.
+	Creditlimit float64 `protobuf:"fixed64,9,opt,name=creditlimit,proto3" json:"creditlimit,omitempty"`
+	// If new product line is added (offer, order, invoice etc.) all products will receive the discount percentage specified in this field. If the product has a separate customer price, the value specified here will not be considered by the program.
+	Discount float64 `protobuf:"fixed64,10,opt,name=discount,proto3" json:"discount,omitempty"`
+	Notes    string  `protobuf:"bytes,11,opt,name=notes,proto3" json:"notes,omitempty"`
+	Inactive bool    `protobuf:"varint,12,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	// Customer meta data
+	Metadata []*MetaData `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Numberdef) Reset() {
-	*x = Numberdef{}
+func (x *Customer) Reset() {
+	*x = Customer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[44]
+		mi := &file_api_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Numberdef) String() string {
+func (x *Customer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Numberdef) ProtoMessage() {}
+func (*Customer) ProtoMessage() {}
 
-func (x *Numberdef) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[44]
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3960,122 +3763,153 @@ func (x *Numberdef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Numberdef.ProtoReflect.Descriptor instead.
-func (*Numberdef) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Numberdef) GetId() int64 {
+func (x *Customer) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Numberdef) GetNumberkey() string {
+func (x *Customer) GetCusttype() int64 {
 	if x != nil {
-		return x.Numberkey
+		return x.Custtype
+	}
+	return 0
+}
+
+func (x *Customer) GetCustnumber() string {
+	if x != nil {
+		return x.Custnumber
 	}
 	return ""
 }
 
-func (x *Numberdef) GetPrefix() string {
+func (x *Customer) GetCustname() string {
 	if x != nil {
-		return x.Prefix
+		return x.Custname
 	}
 	return ""
 }
 
-func (x *Numberdef) GetCurvalue() int64 {
+func (x *Customer) GetTaxnumber() string {
 	if x != nil {
-		return x.Curvalue
+		return x.Taxnumber
 	}
-	return 0
+	return ""
 }
 
-func (x *Numberdef) GetIsyear() bool {
+func (x *Customer) GetAccount() string {
 	if x != nil {
-		return x.Isyear
+		return x.Account
+	}
+	return ""
+}
+
+func (x *Customer) GetNotax() bool {
+	if x != nil {
+		return x.Notax
 	}
 	return false
 }
 
-func (x *Numberdef) GetSep() string {
+func (x *Customer) GetTerms() int64 {
 	if x != nil {
-		return x.Sep
+		return x.Terms
 	}
-	return ""
+	return 0
 }
 
-func (x *Numberdef) GetLen() int64 {
+func (x *Customer) GetCreditlimit() float64 {
 	if x != nil {
-		return x.Len
+		return x.Creditlimit
 	}
 	return 0
 }
 
-func (x *Numberdef) GetDescription() string {
+func (x *Customer) GetDiscount() float64 {
 	if x != nil {
-		return x.Description
+		return x.Discount
 	}
-	return ""
+	return 0
 }
 
-func (x *Numberdef) GetVisible() bool {
+func (x *Customer) GetNotes() string {
 	if x != nil {
-		return x.Visible
+		return x.Notes
 	}
-	return false
+	return ""
 }
 
-func (x *Numberdef) GetReadonly() bool {
+func (x *Customer) GetInactive() bool {
 	if x != nil {
-		return x.Readonly
+		return x.Inactive
 	}
 	return false
 }
 
-func (x *Numberdef) GetOrderby() int64 {
+func (x *Customer) GetMetadata() []*MetaData {
 	if x != nil {
-		return x.Orderby
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Pattern *description*
-type Pattern struct {
+//- ```id```: Deffield *fieldname*
+//
+//- ```nervatype```: Valid values: *address, barcode, contact, currency, customer, employee, event, item, link, log, movement, payment, price, place, product, project, rate, tax, tool, trans, setting*
+//
+//- ```subtype```: All groupvalue from Groups, where groupname equal *custtype, placetype, protype, toolgroup, transtype*
+//
+//- ```fieldtype```: Valid values: *bool, date, time, float, integer, string, valuelist, notes, urlink, password, customer, tool, transitem, transmovement, transpayment, product, project, employee, place*
+type Deffield struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'transtype')
-	Transtype  int64  `protobuf:"varint,3,opt,name=transtype,proto3" json:"transtype,omitempty"`
-	Notes      string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
-	Defpattern bool   `protobuf:"varint,5,opt,name=defpattern,proto3" json:"defpattern,omitempty"`
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fieldname string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
+	//  Reference to [Groups](#groups).id (where groupname in ('custtype','placetype','  protype','toolgroup','transtype'))
+	Subtype *int64 `protobuf:"varint,4,opt,name=subtype,proto3,oneof" json:"subtype,omitempty"`
+	// Reference to [Groups](#groups).id  (only where groupname = 'fieldtype')
+	Fieldtype   int64  `protobuf:"varint,5,opt,name=fieldtype,proto3" json:"fieldtype,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// If fieldtype=valuelist: valid values are listed, separated by ~
+	Valuelist string `protobuf:"bytes,7,opt,name=valuelist,proto3" json:"valuelist,omitempty"`
+	// When selected, the attribute in case of adding a new element (eg a new customer or employee is created) will automatically be created with the default value according to its type and also will be attached to the new element.
+	Addnew bool `protobuf:"varint,8,opt,name=addnew,proto3" json:"addnew,omitempty"`
+	// Can appear or not (hidden value) on the entry forms
+	Visible bool `protobuf:"varint,9,opt,name=visible,proto3" json:"visible,omitempty"`
+	// The value of the attribute can not be changed in the program interface
+	Readonly bool `protobuf:"varint,10,opt,name=readonly,proto3" json:"readonly,omitempty"`
 }
 
-func (x *Pattern) Reset() {
-	*x = Pattern{}
+func (x *Deffield) Reset() {
+	*x = Deffield{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[45]
+		mi := &file_api_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Pattern) String() string {
+func (x *Deffield) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pattern) ProtoMessage() {}
+func (*Deffield) ProtoMessage() {}
 
-func (x *Pattern) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[45]
+func (x *Deffield) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4086,42 +3920,77 @@ func (x *Pattern) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pattern.ProtoReflect.Descriptor instead.
-func (*Pattern) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use Deffield.ProtoReflect.Descriptor instead.
+func (*Deffield) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Pattern) GetId() int64 {
+func (x *Deffield) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Pattern) GetDescription() string {
+func (x *Deffield) GetFieldname() string {
 	if x != nil {
-		return x.Description
+		return x.Fieldname
 	}
 	return ""
 }
 
-func (x *Pattern) GetTranstype() int64 {
+func (x *Deffield) GetNervatype() int64 {
 	if x != nil {
-		return x.Transtype
+		return x.Nervatype
 	}
 	return 0
 }
 
-func (x *Pattern) GetNotes() string {
+func (x *Deffield) GetSubtype() int64 {
+	if x != nil && x.Subtype != nil {
+		return *x.Subtype
+	}
+	return 0
+}
+
+func (x *Deffield) GetFieldtype() int64 {
 	if x != nil {
-		return x.Notes
+		return x.Fieldtype
+	}
+	return 0
+}
+
+func (x *Deffield) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Pattern) GetDefpattern() bool {
+func (x *Deffield) GetValuelist() string {
 	if x != nil {
-		return x.Defpattern
+		return x.Valuelist
+	}
+	return ""
+}
+
+func (x *Deffield) GetAddnew() bool {
+	if x != nil {
+		return x.Addnew
+	}
+	return false
+}
+
+func (x *Deffield) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *Deffield) GetReadonly() bool {
+	if x != nil {
+		return x.Readonly
 	}
 	return false
 }
@@ -4129,41 +3998,50 @@ func (x *Pattern) GetDefpattern() bool {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Trans and Item *transnumber~rownumber*
+//- ```id```: Employee *empnumber*
 //
-//- ```trans_id```: Trans *transnumber*
-type Payment struct {
+//- ```usergroup```: All groupvalue from Groups, where groupname equal usergroup
+//
+//- ```department```: All groupvalue from Groups, where groupname equal department
+type Employee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Trans](#trans).id
-	TransId  int64   `protobuf:"varint,2,opt,name=trans_id,json=transId,proto3" json:"trans_id,omitempty"`
-	Paiddate string  `protobuf:"bytes,3,opt,name=paiddate,proto3" json:"paiddate,omitempty"`
-	Amount   float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Notes    string  `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Payment meta data
-	Metadata []*MetaData `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = empnumber) data series.
+	Empnumber string `protobuf:"bytes,2,opt,name=empnumber,proto3" json:"empnumber,omitempty"`
+	// Database login name. Should be unique on database level.
+	Username *string `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'usergroup')
+	Usergroup int64   `protobuf:"varint,4,opt,name=usergroup,proto3" json:"usergroup,omitempty"`
+	Startdate *string `protobuf:"bytes,5,opt,name=startdate,proto3,oneof" json:"startdate,omitempty"`
+	Enddate   *string `protobuf:"bytes,6,opt,name=enddate,proto3,oneof" json:"enddate,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'department')
+	Department      *int64 `protobuf:"varint,7,opt,name=department,proto3,oneof" json:"department,omitempty"`
+	RegistrationKey string `protobuf:"bytes,8,opt,name=registration_key,json=registrationKey,proto3" json:"registration_key,omitempty"`
+	Inactive        bool   `protobuf:"varint,9,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	// Employee meta data
+	Metadata []*MetaData `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Payment) Reset() {
-	*x = Payment{}
+func (x *Employee) Reset() {
+	*x = Employee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[46]
+		mi := &file_api_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Payment) String() string {
+func (x *Employee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Payment) ProtoMessage() {}
+func (*Employee) ProtoMessage() {}
 
-func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[46]
+func (x *Employee) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4174,95 +4052,134 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
-func (*Payment) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use Employee.ProtoReflect.Descriptor instead.
+func (*Employee) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Payment) GetId() int64 {
+func (x *Employee) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Payment) GetTransId() int64 {
+func (x *Employee) GetEmpnumber() string {
 	if x != nil {
-		return x.TransId
+		return x.Empnumber
 	}
-	return 0
+	return ""
 }
 
-func (x *Payment) GetPaiddate() string {
-	if x != nil {
-		return x.Paiddate
+func (x *Employee) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
 	return ""
 }
 
-func (x *Payment) GetAmount() float64 {
+func (x *Employee) GetUsergroup() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Usergroup
 	}
 	return 0
 }
 
-func (x *Payment) GetNotes() string {
-	if x != nil {
-		return x.Notes
+func (x *Employee) GetStartdate() string {
+	if x != nil && x.Startdate != nil {
+		return *x.Startdate
 	}
 	return ""
 }
 
-func (x *Payment) GetMetadata() []*MetaData {
-	if x != nil {
-		return x.Metadata
+func (x *Employee) GetEnddate() string {
+	if x != nil && x.Enddate != nil {
+		return *x.Enddate
 	}
-	return nil
+	return ""
+}
+
+func (x *Employee) GetDepartment() int64 {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return 0
+}
+
+func (x *Employee) GetRegistrationKey() string {
+	if x != nil {
+		return x.RegistrationKey
+	}
+	return ""
+}
+
+func (x *Employee) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+func (x *Employee) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
 }
 
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Place *planumber*
+//- ```id```: Event *calnumber*
 //
-//- ```placetype```: Valid values: *bank, cash, warehouse, other*
-type Place struct {
+//- ```nervatype```: Valid values: *customer, employee, place, product, project, tool, trans*
+//
+//- ```ref_id```: Valid values: *customer/{custnumber}, employee/{empnumber}, place/{planumber}, product/{partnumber}, project/{pronumber}, tool/{serial}, trans/{transnumber}*
+//
+//- ```eventgroup```: All groupvalue from Groups, where groupname equal eventgroup
+type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = planumber) data series.
-	Planumber string `protobuf:"bytes,2,opt,name=planumber,proto3" json:"planumber,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'placetype')
-	Placetype   int64   `protobuf:"varint,3,opt,name=placetype,proto3" json:"placetype,omitempty"`
-	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Curr        *string `protobuf:"bytes,5,opt,name=curr,proto3,oneof" json:"curr,omitempty"`
-	Defplace    bool    `protobuf:"varint,6,opt,name=defplace,proto3" json:"defplace,omitempty"`
-	Notes       string  `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	Inactive    bool    `protobuf:"varint,8,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	// Place meta data
-	Metadata []*MetaData `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = calnumber) data series.
+	Calnumber string `protobuf:"bytes,2,opt,name=calnumber,proto3" json:"calnumber,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
+	// Reference to [Customer](#customer).id, [Employee](#employee).id, [Place](#place).id, [Product](#product).id, [Project](#project).id, [Tool](#tool).id, [Trans](#trans).id
+	RefId int64  `protobuf:"varint,4,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Uid   string `protobuf:"bytes,5,opt,name=uid,proto3" json:"uid,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'eventgroup')
+	Eventgroup *int64 `protobuf:"varint,6,opt,name=eventgroup,proto3,oneof" json:"eventgroup,omitempty"`
+	// Datetime
+	Fromdate string `protobuf:"bytes,7,opt,name=fromdate,proto3" json:"fromdate,omitempty"`
+	// Datetime
+	Todate      *string `protobuf:"bytes,8,opt,name=todate,proto3,oneof" json:"todate,omitempty"`
+	Subject     string  `protobuf:"bytes,9,opt,name=subject,proto3" json:"subject,omitempty"`
+	Place       string  `protobuf:"bytes,10,opt,name=place,proto3" json:"place,omitempty"`
+	Description string  `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	// Event meta data
+	Metadata []*MetaData `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Place) Reset() {
-	*x = Place{}
+func (x *Event) Reset() {
+	*x = Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[47]
+		mi := &file_api_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Place) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Place) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *Place) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[47]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4273,68 +4190,89 @@ func (x *Place) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Place.ProtoReflect.Descriptor instead.
-func (*Place) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *Place) GetId() int64 {
+func (x *Event) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Place) GetPlanumber() string {
+func (x *Event) GetCalnumber() string {
 	if x != nil {
-		return x.Planumber
+		return x.Calnumber
 	}
 	return ""
 }
 
-func (x *Place) GetPlacetype() int64 {
+func (x *Event) GetNervatype() int64 {
 	if x != nil {
-		return x.Placetype
+		return x.Nervatype
 	}
 	return 0
 }
 
-func (x *Place) GetDescription() string {
+func (x *Event) GetRefId() int64 {
 	if x != nil {
-		return x.Description
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *Event) GetUid() string {
+	if x != nil {
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *Place) GetCurr() string {
-	if x != nil && x.Curr != nil {
-		return *x.Curr
+func (x *Event) GetEventgroup() int64 {
+	if x != nil && x.Eventgroup != nil {
+		return *x.Eventgroup
+	}
+	return 0
+}
+
+func (x *Event) GetFromdate() string {
+	if x != nil {
+		return x.Fromdate
 	}
 	return ""
 }
 
-func (x *Place) GetDefplace() bool {
+func (x *Event) GetTodate() string {
+	if x != nil && x.Todate != nil {
+		return *x.Todate
+	}
+	return ""
+}
+
+func (x *Event) GetSubject() string {
 	if x != nil {
-		return x.Defplace
+		return x.Subject
 	}
-	return false
+	return ""
 }
 
-func (x *Place) GetNotes() string {
+func (x *Event) GetPlace() string {
 	if x != nil {
-		return x.Notes
+		return x.Place
 	}
 	return ""
 }
 
-func (x *Place) GetInactive() bool {
+func (x *Event) GetDescription() string {
 	if x != nil {
-		return x.Inactive
+		return x.Description
 	}
-	return false
+	return ""
 }
 
-func (x *Place) GetMetadata() []*MetaData {
+func (x *Event) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -4344,49 +4282,40 @@ func (x *Place) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Price *partnumber~validfrom~curr~qty*
+//- ```id```: The value is a generated constant key identifier: *{refnumber}~~{fieldname}~{rownumber}*. The rownumber is the order of multiple *{refnumber}~~{fieldname}* keys.
 //
-//- ```product_id```: Product *partnumber*
-type Price struct {
+//- ```ref_id```: Valid values: *{nervatype}/{refnumber}*
+type Fieldvalue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Product](#product).id
-	ProductId int64 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	// Start of validity, mandatory data.
-	Validfrom string `protobuf:"bytes,3,opt,name=validfrom,proto3" json:"validfrom,omitempty"`
-	// End of validity, can be left empty.
-	Validto *string `protobuf:"bytes,4,opt,name=validto,proto3,oneof" json:"validto,omitempty"`
-	Curr    string  `protobuf:"bytes,5,opt,name=curr,proto3" json:"curr,omitempty"`
-	// Price ranges can also be specified, thus different price can be set for a smaller and bigger quantity of the same product. The quantity should be used as the lower threshold, ie. this should be the minimum quantity for the price set.
-	Qty float64 `protobuf:"fixed64,6,opt,name=qty,proto3" json:"qty,omitempty"`
-	// Price value
-	Pricevalue float64 `protobuf:"fixed64,7,opt,name=pricevalue,proto3" json:"pricevalue,omitempty"`
-	// Supplier (if marked) or customer price. By default the customer price.
-	Vendorprice bool `protobuf:"varint,8,opt,name=vendorprice,proto3" json:"vendorprice,omitempty"`
-	// Price meta data
-	Metadata []*MetaData `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reference to [Deffield](#deffield).fieldname.
+	Fieldname string `protobuf:"bytes,2,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	// Reference to any type.id where type = [Deffield](#deffield).nervatype. If it is null then nervatype = setting.
+	RefId *int64 `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3,oneof" json:"ref_id,omitempty"`
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Notes string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *Price) Reset() {
-	*x = Price{}
+func (x *Fieldvalue) Reset() {
+	*x = Fieldvalue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[48]
+		mi := &file_api_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Price) String() string {
+func (x *Fieldvalue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Price) ProtoMessage() {}
+func (*Fieldvalue) ProtoMessage() {}
 
-func (x *Price) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[48]
+func (x *Fieldvalue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4397,121 +4326,79 @@ func (x *Price) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Price.ProtoReflect.Descriptor instead.
-func (*Price) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use Fieldvalue.ProtoReflect.Descriptor instead.
+func (*Fieldvalue) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Price) GetId() int64 {
+func (x *Fieldvalue) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Price) GetProductId() int64 {
-	if x != nil {
-		return x.ProductId
-	}
-	return 0
-}
-
-func (x *Price) GetValidfrom() string {
-	if x != nil {
-		return x.Validfrom
-	}
-	return ""
-}
-
-func (x *Price) GetValidto() string {
-	if x != nil && x.Validto != nil {
-		return *x.Validto
-	}
-	return ""
-}
-
-func (x *Price) GetCurr() string {
+func (x *Fieldvalue) GetFieldname() string {
 	if x != nil {
-		return x.Curr
+		return x.Fieldname
 	}
 	return ""
 }
 
-func (x *Price) GetQty() float64 {
-	if x != nil {
-		return x.Qty
-	}
-	return 0
-}
-
-func (x *Price) GetPricevalue() float64 {
-	if x != nil {
-		return x.Pricevalue
+func (x *Fieldvalue) GetRefId() int64 {
+	if x != nil && x.RefId != nil {
+		return *x.RefId
 	}
 	return 0
 }
 
-func (x *Price) GetVendorprice() bool {
+func (x *Fieldvalue) GetValue() string {
 	if x != nil {
-		return x.Vendorprice
+		return x.Value
 	}
-	return false
+	return ""
 }
 
-func (x *Price) GetMetadata() []*MetaData {
+func (x *Fieldvalue) GetNotes() string {
 	if x != nil {
-		return x.Metadata
+		return x.Notes
 	}
-	return nil
+	return ""
 }
 
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Product *partnumber*
-//
-//- ```protype```: Valid values: *item, service*
-//
-//- ```tax_id```: Tax *taxcode*
-type Product struct {
+//- ```id```: Group *groupname~groupvalue*
+type Groups struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = partnumber) data series.
-	Partnumber string `protobuf:"bytes,2,opt,name=partnumber,proto3" json:"partnumber,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'protype')
-	Protype int64 `protobuf:"varint,3,opt,name=protype,proto3" json:"protype,omitempty"`
-	// The full name of the product or short description.
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Groupname   string `protobuf:"bytes,2,opt,name=groupname,proto3" json:"groupname,omitempty"`
+	Groupvalue  string `protobuf:"bytes,3,opt,name=groupvalue,proto3" json:"groupvalue,omitempty"`
 	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Unit        string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
-	// Reference to [Tax](#tax).id
-	TaxId    int64  `protobuf:"varint,6,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
-	Notes    string `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	Webitem  bool   `protobuf:"varint,8,opt,name=webitem,proto3" json:"webitem,omitempty"`
-	Inactive bool   `protobuf:"varint,9,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	// Product meta data
-	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	Inactive    bool   `protobuf:"varint,5,opt,name=inactive,proto3" json:"inactive,omitempty"`
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
+func (x *Groups) Reset() {
+	*x = Groups{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[49]
+		mi := &file_api_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Product) String() string {
+func (x *Groups) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*Groups) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[49]
+func (x *Groups) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4522,124 +4409,100 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use Groups.ProtoReflect.Descriptor instead.
+func (*Groups) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Product) GetId() int64 {
+func (x *Groups) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Product) GetPartnumber() string {
-	if x != nil {
-		return x.Partnumber
-	}
-	return ""
-}
-
-func (x *Product) GetProtype() int64 {
-	if x != nil {
-		return x.Protype
-	}
-	return 0
-}
-
-func (x *Product) GetDescription() string {
+func (x *Groups) GetGroupname() string {
 	if x != nil {
-		return x.Description
+		return x.Groupname
 	}
 	return ""
 }
 
-func (x *Product) GetUnit() string {
+func (x *Groups) GetGroupvalue() string {
 	if x != nil {
-		return x.Unit
+		return x.Groupvalue
 	}
 	return ""
 }
 
-func (x *Product) GetTaxId() int64 {
-	if x != nil {
-		return x.TaxId
-	}
-	return 0
-}
-
-func (x *Product) GetNotes() string {
+func (x *Groups) GetDescription() string {
 	if x != nil {
-		return x.Notes
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Product) GetWebitem() bool {
-	if x != nil {
-		return x.Webitem
-	}
-	return false
-}
-
-func (x *Product) GetInactive() bool {
+func (x *Groups) GetInactive() bool {
 	if x != nil {
 		return x.Inactive
 	}
 	return false
 }
 
-func (x *Product) GetMetadata() []*MetaData {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Project *pronumber*
+//- ```id```: Trans and Item *transnumber~rownumber*
 //
-//- ```customer_id```: Tax *custnumber*
-type Project struct {
+//- ```trans_id```: Trans *transnumber*
+//
+//- ```product_id```: Product *partnumber*
+//
+//- ```tax_id```: Tax *taxcode*
+type Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = pronumber) data series.
-	Pronumber string `protobuf:"bytes,2,opt,name=pronumber,proto3" json:"pronumber,omitempty"`
-	// The name of the project.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Reference to [Customer](#customer).id
-	CustomerId *int64  `protobuf:"varint,4,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
-	Startdate  *string `protobuf:"bytes,5,opt,name=startdate,proto3,oneof" json:"startdate,omitempty"`
-	Enddate    *string `protobuf:"bytes,6,opt,name=enddate,proto3,oneof" json:"enddate,omitempty"`
-	Notes      string  `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	Inactive   bool    `protobuf:"varint,8,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	// Project meta data
-	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reference to [trans](#trans).id
+	TransId int64 `protobuf:"varint,2,opt,name=trans_id,json=transId,proto3" json:"trans_id,omitempty"`
+	// Reference to [product](#product).id
+	ProductId int64   `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Unit      string  `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Qty       float64 `protobuf:"fixed64,5,opt,name=qty,proto3" json:"qty,omitempty"`
+	Fxprice   float64 `protobuf:"fixed64,6,opt,name=fxprice,proto3" json:"fxprice,omitempty"`
+	Netamount float64 `protobuf:"fixed64,7,opt,name=netamount,proto3" json:"netamount,omitempty"`
+	Discount  float64 `protobuf:"fixed64,8,opt,name=discount,proto3" json:"discount,omitempty"`
+	// Reference to [Tax](#tax).id
+	TaxId       int64   `protobuf:"varint,9,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	Vatamount   float64 `protobuf:"fixed64,10,opt,name=vatamount,proto3" json:"vatamount,omitempty"`
+	Amount      float64 `protobuf:"fixed64,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description string  `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	Deposit     bool    `protobuf:"varint,13,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Ownstock    float64 `protobuf:"fixed64,14,opt,name=ownstock,proto3" json:"ownstock,omitempty"`
+	Actionprice bool    `protobuf:"varint,15,opt,name=actionprice,proto3" json:"actionprice,omitempty"`
+	// Item meta data
+	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
+func (x *Item) Reset() {
+	*x = Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[50]
+		mi := &file_api_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Project) String() string {
+func (x *Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Project) ProtoMessage() {}
+func (*Item) ProtoMessage() {}
 
-func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[50]
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4650,68 +4513,117 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *Project) GetId() int64 {
+func (x *Item) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Project) GetPronumber() string {
+func (x *Item) GetTransId() int64 {
 	if x != nil {
-		return x.Pronumber
+		return x.TransId
 	}
-	return ""
+	return 0
 }
 
-func (x *Project) GetDescription() string {
+func (x *Item) GetProductId() int64 {
 	if x != nil {
-		return x.Description
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Item) GetUnit() string {
+	if x != nil {
+		return x.Unit
 	}
 	return ""
 }
 
-func (x *Project) GetCustomerId() int64 {
-	if x != nil && x.CustomerId != nil {
-		return *x.CustomerId
+func (x *Item) GetQty() float64 {
+	if x != nil {
+		return x.Qty
 	}
 	return 0
 }
 
-func (x *Project) GetStartdate() string {
-	if x != nil && x.Startdate != nil {
-		return *x.Startdate
+func (x *Item) GetFxprice() float64 {
+	if x != nil {
+		return x.Fxprice
 	}
-	return ""
+	return 0
 }
 
-func (x *Project) GetEnddate() string {
-	if x != nil && x.Enddate != nil {
-		return *x.Enddate
+func (x *Item) GetNetamount() float64 {
+	if x != nil {
+		return x.Netamount
 	}
-	return ""
+	return 0
 }
 
-func (x *Project) GetNotes() string {
+func (x *Item) GetDiscount() float64 {
 	if x != nil {
-		return x.Notes
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Item) GetTaxId() int64 {
+	if x != nil {
+		return x.TaxId
+	}
+	return 0
+}
+
+func (x *Item) GetVatamount() float64 {
+	if x != nil {
+		return x.Vatamount
+	}
+	return 0
+}
+
+func (x *Item) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Item) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Project) GetInactive() bool {
+func (x *Item) GetDeposit() bool {
 	if x != nil {
-		return x.Inactive
+		return x.Deposit
 	}
 	return false
 }
 
-func (x *Project) GetMetadata() []*MetaData {
+func (x *Item) GetOwnstock() float64 {
+	if x != nil {
+		return x.Ownstock
+	}
+	return 0
+}
+
+func (x *Item) GetActionprice() bool {
+	if x != nil {
+		return x.Actionprice
+	}
+	return false
+}
+
+func (x *Item) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -4721,50 +4633,48 @@ func (x *Project) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Rate *ratetype~ratedate~curr~planumber*
+//- ```id```: *{nervatype_1}~{refnumber_1}~~{nervatype_2}~{refnumber_2}*
 //
-//- ```place_id```: Place *planumber*
+//- ```nervatype_1```: All groupvalue from Groups, where groupname equal nervatype
 //
-//- ```ratetype```: Valid values: *rate, buy, sell,average*
+//- ```ref_id_1```: *{nervatype_1}/{refnumber_1}*
 //
-//- ```rategroup```: all groupvalue from Groups, where groupname equal rategroup
-type Rate struct {
+//- ```nervatype_2```: All groupvalue from Groups, where groupname equal nervatype
+type Link struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'ratetype')
-	Ratetype int64  `protobuf:"varint,2,opt,name=ratetype,proto3" json:"ratetype,omitempty"`
-	Ratedate string `protobuf:"bytes,3,opt,name=ratedate,proto3" json:"ratedate,omitempty"`
-	Curr     string `protobuf:"bytes,4,opt,name=curr,proto3" json:"curr,omitempty"`
-	// Reference to [Place](#place).id
-	PlaceId *int64 `protobuf:"varint,5,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'rategroup')
-	Rategroup *int64 `protobuf:"varint,6,opt,name=rategroup,proto3,oneof" json:"rategroup,omitempty"`
-	// Rate or interest value
-	Ratevalue float64 `protobuf:"fixed64,7,opt,name=ratevalue,proto3" json:"ratevalue,omitempty"`
-	// Rate meta data
-	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype_1 int64 `protobuf:"varint,2,opt,name=nervatype_1,json=nervatype1,proto3" json:"nervatype_1,omitempty"`
+	// Reference to {nervatype}.id
+	RefId_1 int64 `protobuf:"varint,3,opt,name=ref_id_1,json=refId1,proto3" json:"ref_id_1,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype_2 int64 `protobuf:"varint,4,opt,name=nervatype_2,json=nervatype2,proto3" json:"nervatype_2,omitempty"`
+	// Reference to {nervatype}.id
+	RefId_2 int64 `protobuf:"varint,5,opt,name=ref_id_2,json=refId2,proto3" json:"ref_id_2,omitempty"`
+	// Link meta data
+	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Rate) Reset() {
-	*x = Rate{}
+func (x *Link) Reset() {
+	*x = Link{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[51]
+		mi := &file_api_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Rate) String() string {
+func (x *Link) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rate) ProtoMessage() {}
+func (*Link) ProtoMessage() {}
 
-func (x *Rate) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[51]
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4775,61 +4685,47 @@ func (x *Rate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rate.ProtoReflect.Descriptor instead.
-func (*Rate) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *Rate) GetId() int64 {
+func (x *Link) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Rate) GetRatetype() int64 {
+func (x *Link) GetNervatype_1() int64 {
 	if x != nil {
-		return x.Ratetype
+		return x.Nervatype_1
 	}
 	return 0
 }
 
-func (x *Rate) GetRatedate() string {
-	if x != nil {
-		return x.Ratedate
-	}
-	return ""
-}
-
-func (x *Rate) GetCurr() string {
+func (x *Link) GetRefId_1() int64 {
 	if x != nil {
-		return x.Curr
-	}
-	return ""
-}
-
-func (x *Rate) GetPlaceId() int64 {
-	if x != nil && x.PlaceId != nil {
-		return *x.PlaceId
+		return x.RefId_1
 	}
 	return 0
 }
 
-func (x *Rate) GetRategroup() int64 {
-	if x != nil && x.Rategroup != nil {
-		return *x.Rategroup
+func (x *Link) GetNervatype_2() int64 {
+	if x != nil {
+		return x.Nervatype_2
 	}
 	return 0
 }
 
-func (x *Rate) GetRatevalue() float64 {
+func (x *Link) GetRefId_2() int64 {
 	if x != nil {
-		return x.Ratevalue
+		return x.RefId_2
 	}
 	return 0
 }
 
-func (x *Rate) GetMetadata() []*MetaData {
+func (x *Link) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -4839,40 +4735,52 @@ func (x *Rate) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Tax *taxcode*
-type Tax struct {
+//- ```id```: *{empnumber}~{crdate}'*
+//
+//- ```employee_id```: Employee *empnumber*
+//
+//- ```ref_id```: *{nervatype}/{refnumber}*
+//
+//- ```nervatype```: All groupvalue from Groups, where groupname equal nervatype
+//
+//- ```logstate```: Valid values: update, closed, deleted, print, login, logout
+type Log struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID.
-	Taxcode     string `protobuf:"bytes,2,opt,name=taxcode,proto3" json:"taxcode,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// Rate or interest value
-	Rate     float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
-	Inactive bool    `protobuf:"varint,5,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	// Tax meta data
-	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Reference to [Employee](#employee).id
+	EmployeeId int64 `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// Date-time
+	Crdate string `protobuf:"bytes,3,opt,name=crdate,proto3" json:"crdate,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype *int64 `protobuf:"varint,4,opt,name=nervatype,proto3,oneof" json:"nervatype,omitempty"`
+	// Reference to {nervatype}.id
+	RefId *int64 `protobuf:"varint,5,opt,name=ref_id,json=refId,proto3,oneof" json:"ref_id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'logstate')
+	Logstate int64 `protobuf:"varint,6,opt,name=logstate,proto3" json:"logstate,omitempty"`
+	// Log meta data
+	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Tax) Reset() {
-	*x = Tax{}
+func (x *Log) Reset() {
+	*x = Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[52]
+		mi := &file_api_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tax) String() string {
+func (x *Log) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tax) ProtoMessage() {}
+func (*Log) ProtoMessage() {}
 
-func (x *Tax) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[52]
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4883,47 +4791,54 @@ func (x *Tax) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tax.ProtoReflect.Descriptor instead.
-func (*Tax) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Tax) GetId() int64 {
+func (x *Log) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Tax) GetTaxcode() string {
+func (x *Log) GetEmployeeId() int64 {
 	if x != nil {
-		return x.Taxcode
+		return x.EmployeeId
 	}
-	return ""
+	return 0
 }
 
-func (x *Tax) GetDescription() string {
+func (x *Log) GetCrdate() string {
 	if x != nil {
-		return x.Description
+		return x.Crdate
 	}
 	return ""
 }
 
-func (x *Tax) GetRate() float64 {
-	if x != nil {
-		return x.Rate
+func (x *Log) GetNervatype() int64 {
+	if x != nil && x.Nervatype != nil {
+		return *x.Nervatype
 	}
 	return 0
 }
 
-func (x *Tax) GetInactive() bool {
+func (x *Log) GetRefId() int64 {
+	if x != nil && x.RefId != nil {
+		return *x.RefId
+	}
+	return 0
+}
+
+func (x *Log) GetLogstate() int64 {
 	if x != nil {
-		return x.Inactive
+		return x.Logstate
 	}
-	return false
+	return 0
 }
 
-func (x *Tax) GetMetadata() []*MetaData {
+func (x *Log) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -4933,47 +4848,59 @@ func (x *Tax) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Tool *serial*
+//- ```id```: Trans and Item *transnumber~rownumber*
 //
-//- ```toolgroup```: all groupvalue from Groups, where groupname equal toolgroup
+//- ```trans_id```: Trans *transnumber*
 //
 //- ```product_id```: Product *partnumber*
-type Tool struct {
+//
+//- ```movetype```: Valid values: *inventory, tool, plan, head*
+//
+//- ```tool_id```: Tool *serial*
+//
+//- ```place_id```: Place *planumber*
+type Movement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = serial) data series.
-	Serial      string `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Reference to [Trans](#trans).id
+	TransId int64 `protobuf:"varint,2,opt,name=trans_id,json=transId,proto3" json:"trans_id,omitempty"`
+	// Date-time
+	Shippingdate string `protobuf:"bytes,3,opt,name=shippingdate,proto3" json:"shippingdate,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'movetype')
+	Movetype int64 `protobuf:"varint,4,opt,name=movetype,proto3" json:"movetype,omitempty"`
 	// Reference to [Product](#product).id
-	ProductId int64 `protobuf:"varint,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'toolgroup')
-	Toolgroup *int64 `protobuf:"varint,5,opt,name=toolgroup,proto3,oneof" json:"toolgroup,omitempty"`
-	Notes     string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	Inactive  bool   `protobuf:"varint,7,opt,name=inactive,proto3" json:"inactive,omitempty"`
-	// Tool meta data
-	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	ProductId *int64 `protobuf:"varint,5,opt,name=product_id,json=productId,proto3,oneof" json:"product_id,omitempty"`
+	// Reference to [Tool](#tool).id
+	ToolId *int64 `protobuf:"varint,6,opt,name=tool_id,json=toolId,proto3,oneof" json:"tool_id,omitempty"`
+	// Reference to [Place](#place).id
+	PlaceId     *int64  `protobuf:"varint,7,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
+	Qty         float64 `protobuf:"fixed64,8,opt,name=qty,proto3" json:"qty,omitempty"`
+	Description string  `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	Shared      bool    `protobuf:"varint,10,opt,name=shared,proto3" json:"shared,omitempty"`
+	// Movement meta data
+	Metadata []*MetaData `protobuf:"bytes,16,rep,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *Tool) Reset() {
-	*x = Tool{}
+func (x *Movement) Reset() {
+	*x = Movement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[53]
+		mi := &file_api_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tool) String() string {
+func (x *Movement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tool) ProtoMessage() {}
+func (*Movement) ProtoMessage() {}
 
-func (x *Tool) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[53]
+func (x *Movement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4984,61 +4911,82 @@ func (x *Tool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tool.ProtoReflect.Descriptor instead.
-func (*Tool) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use Movement.ProtoReflect.Descriptor instead.
+func (*Movement) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Tool) GetId() int64 {
+func (x *Movement) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Tool) GetSerial() string {
+func (x *Movement) GetTransId() int64 {
 	if x != nil {
-		return x.Serial
+		return x.TransId
 	}
-	return ""
+	return 0
 }
 
-func (x *Tool) GetDescription() string {
+func (x *Movement) GetShippingdate() string {
 	if x != nil {
-		return x.Description
+		return x.Shippingdate
 	}
 	return ""
 }
 
-func (x *Tool) GetProductId() int64 {
+func (x *Movement) GetMovetype() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.Movetype
 	}
 	return 0
 }
 
-func (x *Tool) GetToolgroup() int64 {
-	if x != nil && x.Toolgroup != nil {
-		return *x.Toolgroup
+func (x *Movement) GetProductId() int64 {
+	if x != nil && x.ProductId != nil {
+		return *x.ProductId
 	}
 	return 0
 }
 
-func (x *Tool) GetNotes() string {
-	if x != nil {
-		return x.Notes
+func (x *Movement) GetToolId() int64 {
+	if x != nil && x.ToolId != nil {
+		return *x.ToolId
 	}
-	return ""
+	return 0
 }
 
-func (x *Tool) GetInactive() bool {
-	if x != nil {
-		return x.Inactive
+func (x *Movement) GetPlaceId() int64 {
+	if x != nil && x.PlaceId != nil {
+		return *x.PlaceId
 	}
-	return false
+	return 0
 }
 
-func (x *Tool) GetMetadata() []*MetaData {
+func (x *Movement) GetQty() float64 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *Movement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Movement) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *Movement) GetMetadata() []*MetaData {
 	if x != nil {
 		return x.Metadata
 	}
@@ -5048,85 +4996,174 @@ func (x *Tool) GetMetadata() []*MetaData {
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: Trans *transnumber*
-//
-//- ```transtype```: all groupvalue from Groups, where groupname equal transtype
-//
-//- ```direction```: Valid values *in, out, transfer*
-//
-//- ```customer_id```: Customer *custnumber*
-//
-//- ```employee_id```: Employee *empnumber*
-//
-//- ```department```: all groupvalue from Groups, where groupname equal department
-//
-//- ```project_id```: Project *pronumber*
-//
-//- ```place_id```: Place *planumber*
+//- ```id```: Numberdef *numberkey*
+type Numberdef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique key
+	Numberkey string `protobuf:"bytes,2,opt,name=numberkey,proto3" json:"numberkey,omitempty"`
+	// The text prefix of the identifier. It can be any length, but usage of special characters, spaces in the text is not recommended.
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The current status of the counter, the next sequence number will be one value higher than this one. It is possible to re-set the counter, but the uniqueness must be ensured in all cases!
+	Curvalue int64 `protobuf:"varint,4,opt,name=curvalue,proto3" json:"curvalue,omitempty"`
+	// If selected, the created identifier will contain the year.
+	Isyear bool `protobuf:"varint,5,opt,name=isyear,proto3" json:"isyear,omitempty"`
+	// The separator character in the identifier. Default: "/"
+	Sep string `protobuf:"bytes,6,opt,name=sep,proto3" json:"sep,omitempty"`
+	// The value field is arranged in such length to the right and filled with zeros.
+	Len         int64  `protobuf:"varint,7,opt,name=len,proto3" json:"len,omitempty"`
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Visible     bool   `protobuf:"varint,9,opt,name=visible,proto3" json:"visible,omitempty"`
+	Readonly    bool   `protobuf:"varint,10,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Orderby     int64  `protobuf:"varint,11,opt,name=orderby,proto3" json:"orderby,omitempty"`
+}
+
+func (x *Numberdef) Reset() {
+	*x = Numberdef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Numberdef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Numberdef) ProtoMessage() {}
+
+func (x *Numberdef) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Numberdef.ProtoReflect.Descriptor instead.
+func (*Numberdef) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Numberdef) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Numberdef) GetNumberkey() string {
+	if x != nil {
+		return x.Numberkey
+	}
+	return ""
+}
+
+func (x *Numberdef) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Numberdef) GetCurvalue() int64 {
+	if x != nil {
+		return x.Curvalue
+	}
+	return 0
+}
+
+func (x *Numberdef) GetIsyear() bool {
+	if x != nil {
+		return x.Isyear
+	}
+	return false
+}
+
+func (x *Numberdef) GetSep() string {
+	if x != nil {
+		return x.Sep
+	}
+	return ""
+}
+
+func (x *Numberdef) GetLen() int64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+func (x *Numberdef) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Numberdef) GetVisible() bool {
+	if x != nil {
+		return x.Visible
+	}
+	return false
+}
+
+func (x *Numberdef) GetReadonly() bool {
+	if x != nil {
+		return x.Readonly
+	}
+	return false
+}
+
+func (x *Numberdef) GetOrderby() int64 {
+	if x != nil {
+		return x.Orderby
+	}
+	return 0
+}
+
 //
-//- ```paidtype```: all groupvalue from Groups, where groupname equal paidtype
+//RequestUpdate Key->ID keys:
 //
-//- ```transtate```: all groupvalue from Groups, where groupname equal transtate
-type Trans struct {
+//- ```id```: Pattern *description*
+type Pattern struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = transnumber) data series.
-	Transnumber string `protobuf:"bytes,2,opt,name=transnumber,proto3" json:"transnumber,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'transtype')[Groups](#groups).id
-	Transtype int64 `protobuf:"varint,3,opt,name=transtype,proto3" json:"transtype,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'direction')
-	Direction      int64   `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
-	RefTransnumber *string `protobuf:"bytes,5,opt,name=ref_transnumber,json=refTransnumber,proto3,oneof" json:"ref_transnumber,omitempty"`
-	Crdate         string  `protobuf:"bytes,6,opt,name=crdate,proto3" json:"crdate,omitempty"`
-	Transdate      string  `protobuf:"bytes,7,opt,name=transdate,proto3" json:"transdate,omitempty"`
-	// Date-time
-	Duedate *string `protobuf:"bytes,8,opt,name=duedate,proto3,oneof" json:"duedate,omitempty"`
-	// Reference to [Customer](#customer).id
-	CustomerId *int64 `protobuf:"varint,9,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
-	// Reference to [Employee](#employee).id
-	EmployeeId *int64 `protobuf:"varint,10,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'department')
-	Department *int64 `protobuf:"varint,11,opt,name=department,proto3,oneof" json:"department,omitempty"`
-	// Reference to [Project](#project).id
-	ProjectId *int64 `protobuf:"varint,12,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	// Reference to [Place](#place).id
-	PlaceId *int64 `protobuf:"varint,13,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'paidtype')
-	Paidtype *int64  `protobuf:"varint,14,opt,name=paidtype,proto3,oneof" json:"paidtype,omitempty"`
-	Curr     *string `protobuf:"bytes,15,opt,name=curr,proto3,oneof" json:"curr,omitempty"`
-	Notax    bool    `protobuf:"varint,16,opt,name=notax,proto3" json:"notax,omitempty"`
-	Paid     bool    `protobuf:"varint,17,opt,name=paid,proto3" json:"paid,omitempty"`
-	Acrate   float64 `protobuf:"fixed64,18,opt,name=acrate,proto3" json:"acrate,omitempty"`
-	Notes    string  `protobuf:"bytes,19,opt,name=notes,proto3" json:"notes,omitempty"`
-	Intnotes string  `protobuf:"bytes,20,opt,name=intnotes,proto3" json:"intnotes,omitempty"`
-	Fnote    string  `protobuf:"bytes,21,opt,name=fnote,proto3" json:"fnote,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'transtate')
-	Transtate int64 `protobuf:"varint,22,opt,name=transtate,proto3" json:"transtate,omitempty"`
-	Closed    bool  `protobuf:"varint,23,opt,name=closed,proto3" json:"closed,omitempty"`
-	// Trans meta data
-	Metadata []*MetaData `protobuf:"bytes,24,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'transtype')
+	Transtype  int64  `protobuf:"varint,3,opt,name=transtype,proto3" json:"transtype,omitempty"`
+	Notes      string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Defpattern bool   `protobuf:"varint,5,opt,name=defpattern,proto3" json:"defpattern,omitempty"`
 }
 
-func (x *Trans) Reset() {
-	*x = Trans{}
+func (x *Pattern) Reset() {
+	*x = Pattern{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[54]
+		mi := &file_api_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Trans) String() string {
+func (x *Pattern) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Trans) ProtoMessage() {}
+func (*Pattern) ProtoMessage() {}
 
-func (x *Trans) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[54]
+func (x *Pattern) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5137,225 +5174,1892 @@ func (x *Trans) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Trans.ProtoReflect.Descriptor instead.
-func (*Trans) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use Pattern.ProtoReflect.Descriptor instead.
+func (*Pattern) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Trans) GetId() int64 {
+func (x *Pattern) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Trans) GetTransnumber() string {
+func (x *Pattern) GetDescription() string {
 	if x != nil {
-		return x.Transnumber
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Trans) GetTranstype() int64 {
+func (x *Pattern) GetTranstype() int64 {
 	if x != nil {
 		return x.Transtype
 	}
 	return 0
 }
 
-func (x *Trans) GetDirection() int64 {
+func (x *Pattern) GetNotes() string {
 	if x != nil {
-		return x.Direction
+		return x.Notes
 	}
-	return 0
+	return ""
 }
 
-func (x *Trans) GetRefTransnumber() string {
-	if x != nil && x.RefTransnumber != nil {
-		return *x.RefTransnumber
+func (x *Pattern) GetDefpattern() bool {
+	if x != nil {
+		return x.Defpattern
+	}
+	return false
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Trans and Item *transnumber~rownumber*
+//
+//- ```trans_id```: Trans *transnumber*
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference to [Trans](#trans).id
+	TransId  int64   `protobuf:"varint,2,opt,name=trans_id,json=transId,proto3" json:"trans_id,omitempty"`
+	Paiddate string  `protobuf:"bytes,3,opt,name=paiddate,proto3" json:"paiddate,omitempty"`
+	Amount   float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Notes    string  `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Payment meta data
+	Metadata []*MetaData `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Payment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetTransId() int64 {
+	if x != nil {
+		return x.TransId
+	}
+	return 0
+}
+
+func (x *Payment) GetPaiddate() string {
+	if x != nil {
+		return x.Paiddate
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Payment) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Place *planumber*
+//
+//- ```placetype```: Valid values: *bank, cash, warehouse, other*
+type Place struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = planumber) data series.
+	Planumber string `protobuf:"bytes,2,opt,name=planumber,proto3" json:"planumber,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'placetype')
+	Placetype   int64   `protobuf:"varint,3,opt,name=placetype,proto3" json:"placetype,omitempty"`
+	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Curr        *string `protobuf:"bytes,5,opt,name=curr,proto3,oneof" json:"curr,omitempty"`
+	Defplace    bool    `protobuf:"varint,6,opt,name=defplace,proto3" json:"defplace,omitempty"`
+	Notes       string  `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	Inactive    bool    `protobuf:"varint,8,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	// Place meta data
+	Metadata []*MetaData `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Place) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Place) GetPlanumber() string {
+	if x != nil {
+		return x.Planumber
+	}
+	return ""
+}
+
+func (x *Place) GetPlacetype() int64 {
+	if x != nil {
+		return x.Placetype
+	}
+	return 0
+}
+
+func (x *Place) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Place) GetCurr() string {
+	if x != nil && x.Curr != nil {
+		return *x.Curr
+	}
+	return ""
+}
+
+func (x *Place) GetDefplace() bool {
+	if x != nil {
+		return x.Defplace
+	}
+	return false
+}
+
+func (x *Place) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Place) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+func (x *Place) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Price *partnumber~validfrom~curr~qty*
+//
+//- ```product_id```: Product *partnumber*
+type Price struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference to [Product](#product).id
+	ProductId int64 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Start of validity, mandatory data.
+	Validfrom string `protobuf:"bytes,3,opt,name=validfrom,proto3" json:"validfrom,omitempty"`
+	// End of validity, can be left empty.
+	Validto *string `protobuf:"bytes,4,opt,name=validto,proto3,oneof" json:"validto,omitempty"`
+	Curr    string  `protobuf:"bytes,5,opt,name=curr,proto3" json:"curr,omitempty"`
+	// Price ranges can also be specified, thus different price can be set for a smaller and bigger quantity of the same product. The quantity should be used as the lower threshold, ie. this should be the minimum quantity for the price set.
+	Qty float64 `protobuf:"fixed64,6,opt,name=qty,proto3" json:"qty,omitempty"`
+	// Price value
+	Pricevalue float64 `protobuf:"fixed64,7,opt,name=pricevalue,proto3" json:"pricevalue,omitempty"`
+	// Supplier (if marked) or customer price. By default the customer price.
+	Vendorprice bool `protobuf:"varint,8,opt,name=vendorprice,proto3" json:"vendorprice,omitempty"`
+	// Price meta data
+	Metadata []*MetaData `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Price) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Price) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Price) GetValidfrom() string {
+	if x != nil {
+		return x.Validfrom
+	}
+	return ""
+}
+
+func (x *Price) GetValidto() string {
+	if x != nil && x.Validto != nil {
+		return *x.Validto
+	}
+	return ""
+}
+
+func (x *Price) GetCurr() string {
+	if x != nil {
+		return x.Curr
+	}
+	return ""
+}
+
+func (x *Price) GetQty() float64 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *Price) GetPricevalue() float64 {
+	if x != nil {
+		return x.Pricevalue
+	}
+	return 0
+}
+
+func (x *Price) GetVendorprice() bool {
+	if x != nil {
+		return x.Vendorprice
+	}
+	return false
+}
+
+func (x *Price) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Product *partnumber*
+//
+//- ```protype```: Valid values: *item, service*
+//
+//- ```tax_id```: Tax *taxcode*
+type Product struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = partnumber) data series.
+	Partnumber string `protobuf:"bytes,2,opt,name=partnumber,proto3" json:"partnumber,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'protype')
+	Protype int64 `protobuf:"varint,3,opt,name=protype,proto3" json:"protype,omitempty"`
+	// The full name of the product or short description.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Unit        string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Reference to [Tax](#tax).id
+	TaxId    int64  `protobuf:"varint,6,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+	Notes    string `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	Webitem  bool   `protobuf:"varint,8,opt,name=webitem,proto3" json:"webitem,omitempty"`
+	Inactive bool   `protobuf:"varint,9,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	// Product meta data
+	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Product) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetPartnumber() string {
+	if x != nil {
+		return x.Partnumber
+	}
+	return ""
+}
+
+func (x *Product) GetProtype() int64 {
+	if x != nil {
+		return x.Protype
+	}
+	return 0
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Product) GetTaxId() int64 {
+	if x != nil {
+		return x.TaxId
+	}
+	return 0
+}
+
+func (x *Product) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Product) GetWebitem() bool {
+	if x != nil {
+		return x.Webitem
+	}
+	return false
+}
+
+func (x *Product) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+func (x *Product) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Project *pronumber*
+//
+//- ```customer_id```: Tax *custnumber*
+type Project struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = pronumber) data series.
+	Pronumber string `protobuf:"bytes,2,opt,name=pronumber,proto3" json:"pronumber,omitempty"`
+	// The name of the project.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Reference to [Customer](#customer).id
+	CustomerId *int64  `protobuf:"varint,4,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	Startdate  *string `protobuf:"bytes,5,opt,name=startdate,proto3,oneof" json:"startdate,omitempty"`
+	Enddate    *string `protobuf:"bytes,6,opt,name=enddate,proto3,oneof" json:"enddate,omitempty"`
+	Notes      string  `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	Inactive   bool    `protobuf:"varint,8,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	// Project meta data
+	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Project) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetPronumber() string {
+	if x != nil {
+		return x.Pronumber
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetCustomerId() int64 {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return 0
+}
+
+func (x *Project) GetStartdate() string {
+	if x != nil && x.Startdate != nil {
+		return *x.Startdate
+	}
+	return ""
+}
+
+func (x *Project) GetEnddate() string {
+	if x != nil && x.Enddate != nil {
+		return *x.Enddate
+	}
+	return ""
+}
+
+func (x *Project) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Project) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+func (x *Project) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Rate *ratetype~ratedate~curr~planumber*
+//
+//- ```place_id```: Place *planumber*
+//
+//- ```ratetype```: Valid values: *rate, buy, sell,average*
+//
+//- ```rategroup```: all groupvalue from Groups, where groupname equal rategroup
+type Rate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'ratetype')
+	Ratetype int64  `protobuf:"varint,2,opt,name=ratetype,proto3" json:"ratetype,omitempty"`
+	Ratedate string `protobuf:"bytes,3,opt,name=ratedate,proto3" json:"ratedate,omitempty"`
+	Curr     string `protobuf:"bytes,4,opt,name=curr,proto3" json:"curr,omitempty"`
+	// Reference to [Place](#place).id
+	PlaceId *int64 `protobuf:"varint,5,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'rategroup')
+	Rategroup *int64 `protobuf:"varint,6,opt,name=rategroup,proto3,oneof" json:"rategroup,omitempty"`
+	// Rate or interest value
+	Ratevalue float64 `protobuf:"fixed64,7,opt,name=ratevalue,proto3" json:"ratevalue,omitempty"`
+	// Rate meta data
+	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Rate) Reset() {
+	*x = Rate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rate) ProtoMessage() {}
+
+func (x *Rate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rate.ProtoReflect.Descriptor instead.
+func (*Rate) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Rate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rate) GetRatetype() int64 {
+	if x != nil {
+		return x.Ratetype
+	}
+	return 0
+}
+
+func (x *Rate) GetRatedate() string {
+	if x != nil {
+		return x.Ratedate
+	}
+	return ""
+}
+
+func (x *Rate) GetCurr() string {
+	if x != nil {
+		return x.Curr
+	}
+	return ""
+}
+
+func (x *Rate) GetPlaceId() int64 {
+	if x != nil && x.PlaceId != nil {
+		return *x.PlaceId
+	}
+	return 0
+}
+
+func (x *Rate) GetRategroup() int64 {
+	if x != nil && x.Rategroup != nil {
+		return *x.Rategroup
+	}
+	return 0
+}
+
+func (x *Rate) GetRatevalue() float64 {
+	if x != nil {
+		return x.Ratevalue
+	}
+	return 0
+}
+
+func (x *Rate) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Tax *taxcode*
+type Tax struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique ID.
+	Taxcode     string `protobuf:"bytes,2,opt,name=taxcode,proto3" json:"taxcode,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Rate or interest value
+	Rate     float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Inactive bool    `protobuf:"varint,5,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	// Tax meta data
+	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Tax) Reset() {
+	*x = Tax{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tax) ProtoMessage() {}
+
+func (x *Tax) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tax.ProtoReflect.Descriptor instead.
+func (*Tax) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Tax) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tax) GetTaxcode() string {
+	if x != nil {
+		return x.Taxcode
+	}
+	return ""
+}
+
+func (x *Tax) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tax) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *Tax) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+func (x *Tax) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Tool *serial*
+//
+//- ```toolgroup```: all groupvalue from Groups, where groupname equal toolgroup
+//
+//- ```product_id```: Product *partnumber*
+type Tool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = serial) data series.
+	Serial      string `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Reference to [Product](#product).id
+	ProductId int64 `protobuf:"varint,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'toolgroup')
+	Toolgroup *int64 `protobuf:"varint,5,opt,name=toolgroup,proto3,oneof" json:"toolgroup,omitempty"`
+	Notes     string `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Inactive  bool   `protobuf:"varint,7,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	// Tool meta data
+	Metadata []*MetaData `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Tool) Reset() {
+	*x = Tool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tool) ProtoMessage() {}
+
+func (x *Tool) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tool.ProtoReflect.Descriptor instead.
+func (*Tool) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Tool) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tool) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
+}
+
+func (x *Tool) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Tool) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Tool) GetToolgroup() int64 {
+	if x != nil && x.Toolgroup != nil {
+		return *x.Toolgroup
+	}
+	return 0
+}
+
+func (x *Tool) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Tool) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+func (x *Tool) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: Trans *transnumber*
+//
+//- ```transtype```: all groupvalue from Groups, where groupname equal transtype
+//
+//- ```direction```: Valid values *in, out, transfer*
+//
+//- ```customer_id```: Customer *custnumber*
+//
+//- ```employee_id```: Employee *empnumber*
+//
+//- ```department```: all groupvalue from Groups, where groupname equal department
+//
+//- ```project_id```: Project *pronumber*
+//
+//- ```place_id```: Place *planumber*
+//
+//- ```paidtype```: all groupvalue from Groups, where groupname equal paidtype
+//
+//- ```transtate```: all groupvalue from Groups, where groupname equal transtate
+type Trans struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unique ID. If you set it to numberdef, it will be generated at the first data save. The format and value of the next data in row is taken from the numberdef (numberkey = transnumber) data series.
+	Transnumber string `protobuf:"bytes,2,opt,name=transnumber,proto3" json:"transnumber,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'transtype')[Groups](#groups).id
+	Transtype int64 `protobuf:"varint,3,opt,name=transtype,proto3" json:"transtype,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'direction')
+	Direction      int64   `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	RefTransnumber *string `protobuf:"bytes,5,opt,name=ref_transnumber,json=refTransnumber,proto3,oneof" json:"ref_transnumber,omitempty"`
+	Crdate         string  `protobuf:"bytes,6,opt,name=crdate,proto3" json:"crdate,omitempty"`
+	Transdate      string  `protobuf:"bytes,7,opt,name=transdate,proto3" json:"transdate,omitempty"`
+	// Date-time
+	Duedate *string `protobuf:"bytes,8,opt,name=duedate,proto3,oneof" json:"duedate,omitempty"`
+	// Reference to [Customer](#customer).id
+	CustomerId *int64 `protobuf:"varint,9,opt,name=customer_id,json=customerId,proto3,oneof" json:"customer_id,omitempty"`
+	// Reference to [Employee](#employee).id
+	EmployeeId *int64 `protobuf:"varint,10,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'department')
+	Department *int64 `protobuf:"varint,11,opt,name=department,proto3,oneof" json:"department,omitempty"`
+	// Reference to [Project](#project).id
+	ProjectId *int64 `protobuf:"varint,12,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// Reference to [Place](#place).id
+	PlaceId *int64 `protobuf:"varint,13,opt,name=place_id,json=placeId,proto3,oneof" json:"place_id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'paidtype')
+	Paidtype *int64  `protobuf:"varint,14,opt,name=paidtype,proto3,oneof" json:"paidtype,omitempty"`
+	Curr     *string `protobuf:"bytes,15,opt,name=curr,proto3,oneof" json:"curr,omitempty"`
+	Notax    bool    `protobuf:"varint,16,opt,name=notax,proto3" json:"notax,omitempty"`
+	Paid     bool    `protobuf:"varint,17,opt,name=paid,proto3" json:"paid,omitempty"`
+	Acrate   float64 `protobuf:"fixed64,18,opt,name=acrate,proto3" json:"acrate,omitempty"`
+	Notes    string  `protobuf:"bytes,19,opt,name=notes,proto3" json:"notes,omitempty"`
+	Intnotes string  `protobuf:"bytes,20,opt,name=intnotes,proto3" json:"intnotes,omitempty"`
+	Fnote    string  `protobuf:"bytes,21,opt,name=fnote,proto3" json:"fnote,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'transtate')
+	Transtate int64 `protobuf:"varint,22,opt,name=transtate,proto3" json:"transtate,omitempty"`
+	Closed    bool  `protobuf:"varint,23,opt,name=closed,proto3" json:"closed,omitempty"`
+	// Trans meta data
+	Metadata []*MetaData `protobuf:"bytes,24,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Trans) Reset() {
+	*x = Trans{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trans) ProtoMessage() {}
+
+func (x *Trans) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trans.ProtoReflect.Descriptor instead.
+func (*Trans) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Trans) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Trans) GetTransnumber() string {
+	if x != nil {
+		return x.Transnumber
+	}
+	return ""
+}
+
+func (x *Trans) GetTranstype() int64 {
+	if x != nil {
+		return x.Transtype
+	}
+	return 0
+}
+
+func (x *Trans) GetDirection() int64 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *Trans) GetRefTransnumber() string {
+	if x != nil && x.RefTransnumber != nil {
+		return *x.RefTransnumber
+	}
+	return ""
+}
+
+func (x *Trans) GetCrdate() string {
+	if x != nil {
+		return x.Crdate
+	}
+	return ""
+}
+
+func (x *Trans) GetTransdate() string {
+	if x != nil {
+		return x.Transdate
+	}
+	return ""
+}
+
+func (x *Trans) GetDuedate() string {
+	if x != nil && x.Duedate != nil {
+		return *x.Duedate
+	}
+	return ""
+}
+
+func (x *Trans) GetCustomerId() int64 {
+	if x != nil && x.CustomerId != nil {
+		return *x.CustomerId
+	}
+	return 0
+}
+
+func (x *Trans) GetEmployeeId() int64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
+	}
+	return 0
+}
+
+func (x *Trans) GetDepartment() int64 {
+	if x != nil && x.Department != nil {
+		return *x.Department
+	}
+	return 0
+}
+
+func (x *Trans) GetProjectId() int64 {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return 0
+}
+
+func (x *Trans) GetPlaceId() int64 {
+	if x != nil && x.PlaceId != nil {
+		return *x.PlaceId
+	}
+	return 0
+}
+
+func (x *Trans) GetPaidtype() int64 {
+	if x != nil && x.Paidtype != nil {
+		return *x.Paidtype
+	}
+	return 0
+}
+
+func (x *Trans) GetCurr() string {
+	if x != nil && x.Curr != nil {
+		return *x.Curr
+	}
+	return ""
+}
+
+func (x *Trans) GetNotax() bool {
+	if x != nil {
+		return x.Notax
+	}
+	return false
+}
+
+func (x *Trans) GetPaid() bool {
+	if x != nil {
+		return x.Paid
+	}
+	return false
+}
+
+func (x *Trans) GetAcrate() float64 {
+	if x != nil {
+		return x.Acrate
+	}
+	return 0
+}
+
+func (x *Trans) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Trans) GetIntnotes() string {
+	if x != nil {
+		return x.Intnotes
+	}
+	return ""
+}
+
+func (x *Trans) GetFnote() string {
+	if x != nil {
+		return x.Fnote
+	}
+	return ""
+}
+
+func (x *Trans) GetTranstate() int64 {
+	if x != nil {
+		return x.Transtate
+	}
+	return 0
+}
+
+func (x *Trans) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *Trans) GetMetadata() []*MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: UiAudit *{usergroup}~{nervatype}~{transtype}*
+//
+//- ```usergroup```: all groupvalue from Groups, where groupname equal usergroup
+//
+//- ```nervatype```: all groupvalue from Groups, where groupname equal nervatype
+//
+//- ```subtype```: all groupvalue from Groups, where groupname equal transtype, movetype, protype, custtype, placetype
+//
+//- ```inputfilter```: Valid values *disabled, readonly, update, all*
+type UiAudit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'usergroup')
+	Usergroup int64 `protobuf:"varint,2,opt,name=usergroup,proto3" json:"usergroup,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'movetype')
+	Subtype *int64 `protobuf:"varint,4,opt,name=subtype,proto3,oneof" json:"subtype,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'inputfilter')
+	Inputfilter int64 `protobuf:"varint,5,opt,name=inputfilter,proto3" json:"inputfilter,omitempty"`
+	Supervisor  bool  `protobuf:"varint,6,opt,name=supervisor,proto3" json:"supervisor,omitempty"`
+}
+
+func (x *UiAudit) Reset() {
+	*x = UiAudit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UiAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UiAudit) ProtoMessage() {}
+
+func (x *UiAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UiAudit.ProtoReflect.Descriptor instead.
+func (*UiAudit) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UiAudit) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UiAudit) GetUsergroup() int64 {
+	if x != nil {
+		return x.Usergroup
+	}
+	return 0
+}
+
+func (x *UiAudit) GetNervatype() int64 {
+	if x != nil {
+		return x.Nervatype
+	}
+	return 0
+}
+
+func (x *UiAudit) GetSubtype() int64 {
+	if x != nil && x.Subtype != nil {
+		return *x.Subtype
+	}
+	return 0
+}
+
+func (x *UiAudit) GetInputfilter() int64 {
+	if x != nil {
+		return x.Inputfilter
+	}
+	return 0
+}
+
+func (x *UiAudit) GetSupervisor() bool {
+	if x != nil {
+		return x.Supervisor
+	}
+	return false
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: UiMenu *menukey*
+type UiMenu struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Menukey     string `protobuf:"bytes,2,opt,name=menukey,proto3" json:"menukey,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Modul       string `protobuf:"bytes,4,opt,name=modul,proto3" json:"modul,omitempty"`
+	Icon        string `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'method')
+	Method   int64  `protobuf:"varint,6,opt,name=method,proto3" json:"method,omitempty"`
+	Funcname string `protobuf:"bytes,7,opt,name=funcname,proto3" json:"funcname,omitempty"`
+	Address  string `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *UiMenu) Reset() {
+	*x = UiMenu{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UiMenu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UiMenu) ProtoMessage() {}
+
+func (x *UiMenu) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UiMenu.ProtoReflect.Descriptor instead.
+func (*UiMenu) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UiMenu) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UiMenu) GetMenukey() string {
+	if x != nil {
+		return x.Menukey
+	}
+	return ""
+}
+
+func (x *UiMenu) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UiMenu) GetModul() string {
+	if x != nil {
+		return x.Modul
+	}
+	return ""
+}
+
+func (x *UiMenu) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *UiMenu) GetMethod() int64 {
+	if x != nil {
+		return x.Method
+	}
+	return 0
+}
+
+func (x *UiMenu) GetFuncname() string {
+	if x != nil {
+		return x.Funcname
+	}
+	return ""
+}
+
+func (x *UiMenu) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: UiMenufields *{menukey}~{fieldname}*
+//
+//- ```menu_id```: UiMenu *menukey*
+type UiMenufields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference to [UiMenu](#UiMenu).id
+	MenuId      int64  `protobuf:"varint,2,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	Fieldname   string `protobuf:"bytes,3,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'fieldtype')
+	Fieldtype int64 `protobuf:"varint,5,opt,name=fieldtype,proto3" json:"fieldtype,omitempty"`
+	Orderby   int64 `protobuf:"varint,6,opt,name=orderby,proto3" json:"orderby,omitempty"`
+}
+
+func (x *UiMenufields) Reset() {
+	*x = UiMenufields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UiMenufields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UiMenufields) ProtoMessage() {}
+
+func (x *UiMenufields) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UiMenufields.ProtoReflect.Descriptor instead.
+func (*UiMenufields) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UiMenufields) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UiMenufields) GetMenuId() int64 {
+	if x != nil {
+		return x.MenuId
+	}
+	return 0
+}
+
+func (x *UiMenufields) GetFieldname() string {
+	if x != nil {
+		return x.Fieldname
+	}
+	return ""
+}
+
+func (x *UiMenufields) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UiMenufields) GetFieldtype() int64 {
+	if x != nil {
+		return x.Fieldtype
+	}
+	return 0
+}
+
+func (x *UiMenufields) GetOrderby() int64 {
+	if x != nil {
+		return x.Orderby
+	}
+	return 0
+}
+
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: UiMessage *{secname}~{fieldname}~{lang}*
+type UiMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secname   string `protobuf:"bytes,2,opt,name=secname,proto3" json:"secname,omitempty"`
+	Fieldname string `protobuf:"bytes,3,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Lang      string `protobuf:"bytes,4,opt,name=lang,proto3" json:"lang,omitempty"`
+	Msg       string `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *UiMessage) Reset() {
+	*x = UiMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UiMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UiMessage) ProtoMessage() {}
+
+func (x *UiMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UiMessage.ProtoReflect.Descriptor instead.
+func (*UiMessage) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UiMessage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UiMessage) GetSecname() string {
+	if x != nil {
+		return x.Secname
+	}
+	return ""
+}
+
+func (x *UiMessage) GetFieldname() string {
+	if x != nil {
+		return x.Fieldname
+	}
+	return ""
+}
+
+func (x *UiMessage) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *UiMessage) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type UiPrintqueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype *int64 `protobuf:"varint,2,opt,name=nervatype,proto3,oneof" json:"nervatype,omitempty"`
+	// Reference to {nervatype}.id
+	RefId int64   `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	Qty   float64 `protobuf:"fixed64,4,opt,name=qty,proto3" json:"qty,omitempty"`
+	// Reference to [Employee](#employee).id
+	EmployeeId *int64 `protobuf:"varint,5,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	// Reference to [UiReport](#UiReport).id
+	ReportId int64 `protobuf:"varint,6,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	// Date-time
+	Crdate string `protobuf:"bytes,7,opt,name=crdate,proto3" json:"crdate,omitempty"`
+}
+
+func (x *UiPrintqueue) Reset() {
+	*x = UiPrintqueue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UiPrintqueue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UiPrintqueue) ProtoMessage() {}
+
+func (x *UiPrintqueue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UiPrintqueue.ProtoReflect.Descriptor instead.
+func (*UiPrintqueue) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UiPrintqueue) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UiPrintqueue) GetNervatype() int64 {
+	if x != nil && x.Nervatype != nil {
+		return *x.Nervatype
+	}
+	return 0
+}
+
+func (x *UiPrintqueue) GetRefId() int64 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+func (x *UiPrintqueue) GetQty() float64 {
+	if x != nil {
+		return x.Qty
+	}
+	return 0
+}
+
+func (x *UiPrintqueue) GetEmployeeId() int64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
 	}
-	return ""
+	return 0
 }
 
-func (x *Trans) GetCrdate() string {
+func (x *UiPrintqueue) GetReportId() int64 {
 	if x != nil {
-		return x.Crdate
+		return x.ReportId
 	}
-	return ""
+	return 0
 }
 
-func (x *Trans) GetTransdate() string {
+func (x *UiPrintqueue) GetCrdate() string {
 	if x != nil {
-		return x.Transdate
+		return x.Crdate
 	}
 	return ""
 }
 
-func (x *Trans) GetDuedate() string {
-	if x != nil && x.Duedate != nil {
-		return *x.Duedate
-	}
-	return ""
-}
+//
+//RequestUpdate Key->ID keys:
+//
+//- ```id```: UiReport *reportkey*
+//
+//- ```nervatype```: all groupvalue from Groups, where groupname equal nervatype
+//
+//- ```transtype```: all groupvalue from Groups, where groupname equal transtype
+//
+//- ```direction```: all groupvalue from Groups, where groupname equal direction
+//
+//- ```filetype```: all groupvalue from Groups, where groupname equal filetype
+type UiReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Trans) GetCustomerId() int64 {
-	if x != nil && x.CustomerId != nil {
-		return *x.CustomerId
-	}
-	return 0
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reportkey string `protobuf:"bytes,2,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
+	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'transtype')
+	Transtype *int64 `protobuf:"varint,4,opt,name=transtype,proto3,oneof" json:"transtype,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'direction')
+	Direction   *int64 `protobuf:"varint,5,opt,name=direction,proto3,oneof" json:"direction,omitempty"`
+	Repname     string `protobuf:"bytes,6,opt,name=repname,proto3" json:"repname,omitempty"`
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Label       string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	// Reference to [Groups](#groups).id (only where groupname = 'filetype')
+	Filetype int64  `protobuf:"varint,9,opt,name=filetype,proto3" json:"filetype,omitempty"`
+	Report   string `protobuf:"bytes,10,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *Trans) GetEmployeeId() int64 {
-	if x != nil && x.EmployeeId != nil {
-		return *x.EmployeeId
+func (x *UiReport) Reset() {
+	*x = UiReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Trans) GetDepartment() int64 {
-	if x != nil && x.Department != nil {
-		return *x.Department
-	}
-	return 0
+func (x *UiReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Trans) GetProjectId() int64 {
-	if x != nil && x.ProjectId != nil {
-		return *x.ProjectId
+func (*UiReport) ProtoMessage() {}
+
+func (x *UiReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Trans) GetPlaceId() int64 {
-	if x != nil && x.PlaceId != nil {
-		return *x.PlaceId
-	}
-	return 0
+// Deprecated: Use UiReport.ProtoReflect.Descriptor instead.
+func (*UiReport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *Trans) GetPaidtype() int64 {
-	if x != nil && x.Paidtype != nil {
-		return *x.Paidtype
+func (x *UiReport) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Trans) GetCurr() string {
-	if x != nil && x.Curr != nil {
-		return *x.Curr
+func (x *UiReport) GetReportkey() string {
+	if x != nil {
+		return x.Reportkey
 	}
 	return ""
 }
 
-func (x *Trans) GetNotax() bool {
+func (x *UiReport) GetNervatype() int64 {
 	if x != nil {
-		return x.Notax
+		return x.Nervatype
 	}
-	return false
+	return 0
 }
 
-func (x *Trans) GetPaid() bool {
-	if x != nil {
-		return x.Paid
+func (x *UiReport) GetTranstype() int64 {
+	if x != nil && x.Transtype != nil {
+		return *x.Transtype
 	}
-	return false
+	return 0
 }
 
-func (x *Trans) GetAcrate() float64 {
-	if x != nil {
-		return x.Acrate
+func (x *UiReport) GetDirection() int64 {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
 	}
 	return 0
 }
 
-func (x *Trans) GetNotes() string {
+func (x *UiReport) GetRepname() string {
 	if x != nil {
-		return x.Notes
+		return x.Repname
 	}
 	return ""
 }
 
-func (x *Trans) GetIntnotes() string {
+func (x *UiReport) GetDescription() string {
 	if x != nil {
-		return x.Intnotes
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Trans) GetFnote() string {
+func (x *UiReport) GetLabel() string {
 	if x != nil {
-		return x.Fnote
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Trans) GetTranstate() int64 {
+func (x *UiReport) GetFiletype() int64 {
 	if x != nil {
-		return x.Transtate
+		return x.Filetype
 	}
 	return 0
 }
 
-func (x *Trans) GetClosed() bool {
-	if x != nil {
-		return x.Closed
-	}
-	return false
-}
-
-func (x *Trans) GetMetadata() []*MetaData {
+func (x *UiReport) GetReport() string {
 	if x != nil {
-		return x.Metadata
+		return x.Report
 	}
-	return nil
+	return ""
 }
 
 //
 //RequestUpdate Key->ID keys:
 //
-//- ```id```: UiAudit *{usergroup}~{nervatype}~{transtype}*
-//
-//- ```usergroup```: all groupvalue from Groups, where groupname equal usergroup
-//
-//- ```nervatype```: all groupvalue from Groups, where groupname equal nervatype
-//
-//- ```subtype```: all groupvalue from Groups, where groupname equal transtype, movetype, protype, custtype, placetype
+//- ```id```: *{empnumber}~{section}~{cfgroup}~{cfname}*
 //
-//- ```inputfilter```: Valid values *disabled, readonly, update, all*
-type UiAudit struct {
+//- ```employee_id```: Employee *{empnumber}*
+type UiUserconfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'usergroup')
-	Usergroup int64 `protobuf:"varint,2,opt,name=usergroup,proto3" json:"usergroup,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'movetype')
-	Subtype *int64 `protobuf:"varint,4,opt,name=subtype,proto3,oneof" json:"subtype,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'inputfilter')
-	Inputfilter int64 `protobuf:"varint,5,opt,name=inputfilter,proto3" json:"inputfilter,omitempty"`
-	Supervisor  bool  `protobuf:"varint,6,opt,name=supervisor,proto3" json:"supervisor,omitempty"`
+	// Reference to [Employee](#employee).id
+	EmployeeId *int64  `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
+	Section    *string `protobuf:"bytes,3,opt,name=section,proto3,oneof" json:"section,omitempty"`
+	Cfgroup    string  `protobuf:"bytes,4,opt,name=cfgroup,proto3" json:"cfgroup,omitempty"`
+	Cfname     string  `protobuf:"bytes,5,opt,name=cfname,proto3" json:"cfname,omitempty"`
+	Cfvalue    *string `protobuf:"bytes,6,opt,name=cfvalue,proto3,oneof" json:"cfvalue,omitempty"`
+	Orderby    int64   `protobuf:"varint,7,opt,name=orderby,proto3" json:"orderby,omitempty"`
 }
 
-func (x *UiAudit) Reset() {
-	*x = UiAudit{}
+func (x *UiUserconfig) Reset() {
+	*x = UiUserconfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[55]
+		mi := &file_api_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UiAudit) String() string {
+func (x *UiUserconfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UiAudit) ProtoMessage() {}
+func (*UiUserconfig) ProtoMessage() {}
 
-func (x *UiAudit) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[55]
+func (x *UiUserconfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5366,90 +7070,85 @@ func (x *UiAudit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UiAudit.ProtoReflect.Descriptor instead.
-func (*UiAudit) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use UiUserconfig.ProtoReflect.Descriptor instead.
+func (*UiUserconfig) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UiAudit) GetId() int64 {
+func (x *UiUserconfig) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UiAudit) GetUsergroup() int64 {
-	if x != nil {
-		return x.Usergroup
+func (x *UiUserconfig) GetEmployeeId() int64 {
+	if x != nil && x.EmployeeId != nil {
+		return *x.EmployeeId
 	}
 	return 0
 }
 
-func (x *UiAudit) GetNervatype() int64 {
-	if x != nil {
-		return x.Nervatype
+func (x *UiUserconfig) GetSection() string {
+	if x != nil && x.Section != nil {
+		return *x.Section
 	}
-	return 0
+	return ""
 }
 
-func (x *UiAudit) GetSubtype() int64 {
-	if x != nil && x.Subtype != nil {
-		return *x.Subtype
+func (x *UiUserconfig) GetCfgroup() string {
+	if x != nil {
+		return x.Cfgroup
 	}
-	return 0
+	return ""
 }
 
-func (x *UiAudit) GetInputfilter() int64 {
+func (x *UiUserconfig) GetCfname() string {
 	if x != nil {
-		return x.Inputfilter
+		return x.Cfname
 	}
-	return 0
+	return ""
 }
 
-func (x *UiAudit) GetSupervisor() bool {
+func (x *UiUserconfig) GetCfvalue() string {
+	if x != nil && x.Cfvalue != nil {
+		return *x.Cfvalue
+	}
+	return ""
+}
+
+func (x *UiUserconfig) GetOrderby() int64 {
 	if x != nil {
-		return x.Supervisor
+		return x.Orderby
 	}
-	return false
+	return 0
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: UiMenu *menukey*
-type UiMenu struct {
+type ResponseRows_Item struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Menukey     string `protobuf:"bytes,2,opt,name=menukey,proto3" json:"menukey,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Modul       string `protobuf:"bytes,4,opt,name=modul,proto3" json:"modul,omitempty"`
-	Icon        string `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'method')
-	Method   int64  `protobuf:"varint,6,opt,name=method,proto3" json:"method,omitempty"`
-	Funcname string `protobuf:"bytes,7,opt,name=funcname,proto3" json:"funcname,omitempty"`
-	Address  string `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	Values map[string]*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *UiMenu) Reset() {
-	*x = UiMenu{}
+func (x *ResponseRows_Item) Reset() {
+	*x = ResponseRows_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[56]
+		mi := &file_api_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UiMenu) String() string {
+func (x *ResponseRows_Item) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UiMenu) ProtoMessage() {}
+func (*ResponseRows_Item) ProtoMessage() {}
 
-func (x *UiMenu) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[56]
+func (x *ResponseRows_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5460,105 +7159,113 @@ func (x *UiMenu) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UiMenu.ProtoReflect.Descriptor instead.
-func (*UiMenu) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ResponseRows_Item.ProtoReflect.Descriptor instead.
+func (*ResponseRows_Item) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *UiMenu) GetId() int64 {
+func (x *ResponseRows_Item) GetValues() map[string]*Value {
 	if x != nil {
-		return x.Id
+		return x.Values
 	}
-	return 0
+	return nil
 }
 
-func (x *UiMenu) GetMenukey() string {
-	if x != nil {
-		return x.Menukey
-	}
-	return ""
+type RequestView_Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Give the query a unique name
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The SQL query as a string
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// The array of parameter values
+	Values []*Value `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *UiMenu) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *RequestView_Query) Reset() {
+	*x = RequestView_Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *UiMenu) GetModul() string {
-	if x != nil {
-		return x.Modul
-	}
-	return ""
+func (x *RequestView_Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UiMenu) GetIcon() string {
-	if x != nil {
-		return x.Icon
+func (*RequestView_Query) ProtoMessage() {}
+
+func (x *RequestView_Query) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UiMenu) GetMethod() int64 {
+// Deprecated: Use RequestView_Query.ProtoReflect.Descriptor instead.
+func (*RequestView_Query) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *RequestView_Query) GetKey() string {
 	if x != nil {
-		return x.Method
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *UiMenu) GetFuncname() string {
+func (x *RequestView_Query) GetText() string {
 	if x != nil {
-		return x.Funcname
+		return x.Text
 	}
 	return ""
 }
 
-func (x *UiMenu) GetAddress() string {
+func (x *RequestView_Query) GetValues() []*Value {
 	if x != nil {
-		return x.Address
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: UiMenufields *{menukey}~{fieldname}*
-//
-//- ```menu_id```: UiMenu *menukey*
-type UiMenufields struct {
+type ResponseFunctionList_Arg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [UiMenu](#UiMenu).id
-	MenuId      int64  `protobuf:"varint,2,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
-	Fieldname   string `protobuf:"bytes,3,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// string, integer, number, boolean, date, object or list
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Required    bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
 	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'fieldtype')
-	Fieldtype int64 `protobuf:"varint,5,opt,name=fieldtype,proto3" json:"fieldtype,omitempty"`
-	Orderby   int64 `protobuf:"varint,6,opt,name=orderby,proto3" json:"orderby,omitempty"`
 }
 
-func (x *UiMenufields) Reset() {
-	*x = UiMenufields{}
+func (x *ResponseFunctionList_Arg) Reset() {
+	*x = ResponseFunctionList_Arg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[57]
+		mi := &file_api_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UiMenufields) String() string {
+func (x *ResponseFunctionList_Arg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UiMenufields) ProtoMessage() {}
+func (*ResponseFunctionList_Arg) ProtoMessage() {}
 
-func (x *UiMenufields) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[57]
+func (x *ResponseFunctionList_Arg) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5569,86 +7276,68 @@ func (x *UiMenufields) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UiMenufields.ProtoReflect.Descriptor instead.
-func (*UiMenufields) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *UiMenufields) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UiMenufields) GetMenuId() int64 {
-	if x != nil {
-		return x.MenuId
-	}
-	return 0
+// Deprecated: Use ResponseFunctionList_Arg.ProtoReflect.Descriptor instead.
+func (*ResponseFunctionList_Arg) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{24, 0}
 }
 
-func (x *UiMenufields) GetFieldname() string {
+func (x *ResponseFunctionList_Arg) GetName() string {
 	if x != nil {
-		return x.Fieldname
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UiMenufields) GetDescription() string {
+func (x *ResponseFunctionList_Arg) GetType() string {
 	if x != nil {
-		return x.Description
+		return x.Type
 	}
 	return ""
 }
 
-func (x *UiMenufields) GetFieldtype() int64 {
+func (x *ResponseFunctionList_Arg) GetRequired() bool {
 	if x != nil {
-		return x.Fieldtype
+		return x.Required
 	}
-	return 0
+	return false
 }
 
-func (x *UiMenufields) GetOrderby() int64 {
+func (x *ResponseFunctionList_Arg) GetDescription() string {
 	if x != nil {
-		return x.Orderby
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: UiMessage *{secname}~{fieldname}~{lang}*
-type UiMessage struct {
+// The minimal ui_audit object right of the function
+type ResponseFunctionList_Audit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Secname   string `protobuf:"bytes,2,opt,name=secname,proto3" json:"secname,omitempty"`
-	Fieldname string `protobuf:"bytes,3,opt,name=fieldname,proto3" json:"fieldname,omitempty"`
-	Lang      string `protobuf:"bytes,4,opt,name=lang,proto3" json:"lang,omitempty"`
-	Msg       string `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	Nervatype string `protobuf:"bytes,1,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
+	Transtype string `protobuf:"bytes,2,opt,name=transtype,proto3" json:"transtype,omitempty"`
+	// readonly, update or all
+	Right string `protobuf:"bytes,3,opt,name=right,proto3" json:"right,omitempty"`
 }
 
-func (x *UiMessage) Reset() {
-	*x = UiMessage{}
+func (x *ResponseFunctionList_Audit) Reset() {
+	*x = ResponseFunctionList_Audit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[58]
+		mi := &file_api_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UiMessage) String() string {
+func (x *ResponseFunctionList_Audit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UiMessage) ProtoMessage() {}
+func (*ResponseFunctionList_Audit) ProtoMessage() {}
 
-func (x *UiMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[58]
+func (x *ResponseFunctionList_Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5659,82 +7348,64 @@ func (x *UiMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UiMessage.ProtoReflect.Descriptor instead.
-func (*UiMessage) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *UiMessage) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UiMessage) GetSecname() string {
-	if x != nil {
-		return x.Secname
-	}
-	return ""
+// Deprecated: Use ResponseFunctionList_Audit.ProtoReflect.Descriptor instead.
+func (*ResponseFunctionList_Audit) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{24, 1}
 }
 
-func (x *UiMessage) GetFieldname() string {
+func (x *ResponseFunctionList_Audit) GetNervatype() string {
 	if x != nil {
-		return x.Fieldname
+		return x.Nervatype
 	}
 	return ""
 }
 
-func (x *UiMessage) GetLang() string {
+func (x *ResponseFunctionList_Audit) GetTranstype() string {
 	if x != nil {
-		return x.Lang
+		return x.Transtype
 	}
 	return ""
 }
 
-func (x *UiMessage) GetMsg() string {
+func (x *ResponseFunctionList_Audit) GetRight() string {
 	if x != nil {
-		return x.Msg
+		return x.Right
 	}
 	return ""
 }
 
-type UiPrintqueue struct {
+type ResponseFunctionList_Info struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype *int64 `protobuf:"varint,2,opt,name=nervatype,proto3,oneof" json:"nervatype,omitempty"`
-	// Reference to {nervatype}.id
-	RefId int64   `protobuf:"varint,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
-	Qty   float64 `protobuf:"fixed64,4,opt,name=qty,proto3" json:"qty,omitempty"`
-	// Reference to [Employee](#employee).id
-	EmployeeId *int64 `protobuf:"varint,5,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
-	// Reference to [UiReport](#UiReport).id
-	ReportId int64 `protobuf:"varint,6,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
-	// Date-time
-	Crdate string `protobuf:"bytes,7,opt,name=crdate,proto3" json:"crdate,omitempty"`
+	Name        string                        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Args        []*ResponseFunctionList_Arg   `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Audit       []*ResponseFunctionList_Audit `protobuf:"bytes,4,rep,name=audit,proto3" json:"audit,omitempty"`
+	// The function is called in a database transaction
+	Transaction bool `protobuf:"varint,5,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Function script (ui_script) of the database
+	Script bool `protobuf:"varint,6,opt,name=script,proto3" json:"script,omitempty"`
 }
 
-func (x *UiPrintqueue) Reset() {
-	*x = UiPrintqueue{}
+func (x *ResponseFunctionList_Info) Reset() {
+	*x = ResponseFunctionList_Info{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[59]
+		mi := &file_api_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UiPrintqueue) String() string {
+func (x *ResponseFunctionList_Info) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UiPrintqueue) ProtoMessage() {}
+func (*ResponseFunctionList_Info) ProtoMessage() {}
 
-func (x *UiPrintqueue) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[59]
+func (x *ResponseFunctionList_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5745,110 +7416,84 @@ func (x *UiPrintqueue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UiPrintqueue.ProtoReflect.Descriptor instead.
-func (*UiPrintqueue) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ResponseFunctionList_Info.ProtoReflect.Descriptor instead.
+func (*ResponseFunctionList_Info) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{24, 2}
 }
 
-func (x *UiPrintqueue) GetId() int64 {
+func (x *ResponseFunctionList_Info) GetName() string {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UiPrintqueue) GetNervatype() int64 {
-	if x != nil && x.Nervatype != nil {
-		return *x.Nervatype
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *UiPrintqueue) GetRefId() int64 {
+func (x *ResponseFunctionList_Info) GetDescription() string {
 	if x != nil {
-		return x.RefId
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *UiPrintqueue) GetQty() float64 {
+func (x *ResponseFunctionList_Info) GetArgs() []*ResponseFunctionList_Arg {
 	if x != nil {
-		return x.Qty
-	}
-	return 0
-}
-
-func (x *UiPrintqueue) GetEmployeeId() int64 {
-	if x != nil && x.EmployeeId != nil {
-		return *x.EmployeeId
+		return x.Args
 	}
-	return 0
+	return nil
 }
 
-func (x *UiPrintqueue) GetReportId() int64 {
+func (x *ResponseFunctionList_Info) GetAudit() []*ResponseFunctionList_Audit {
 	if x != nil {
-		return x.ReportId
+		return x.Audit
 	}
-	return 0
+	return nil
 }
 
-func (x *UiPrintqueue) GetCrdate() string {
+func (x *ResponseFunctionList_Info) GetTransaction() bool {
 	if x != nil {
-		return x.Crdate
+		return x.Transaction
 	}
-	return ""
+	return false
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: UiReport *reportkey*
-//
-//- ```nervatype```: all groupvalue from Groups, where groupname equal nervatype
-//
-//- ```transtype```: all groupvalue from Groups, where groupname equal transtype
-//
-//- ```direction```: all groupvalue from Groups, where groupname equal direction
-//
-//- ```filetype```: all groupvalue from Groups, where groupname equal filetype
-type UiReport struct {
+func (x *ResponseFunctionList_Info) GetScript() bool {
+	if x != nil {
+		return x.Script
+	}
+	return false
+}
+
+type ResponseReportList_Info struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Reportkey string `protobuf:"bytes,2,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'nervatype')
-	Nervatype int64 `protobuf:"varint,3,opt,name=nervatype,proto3" json:"nervatype,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'transtype')
-	Transtype *int64 `protobuf:"varint,4,opt,name=transtype,proto3,oneof" json:"transtype,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'direction')
-	Direction   *int64 `protobuf:"varint,5,opt,name=direction,proto3,oneof" json:"direction,omitempty"`
-	Repname     string `protobuf:"bytes,6,opt,name=repname,proto3" json:"repname,omitempty"`
-	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Label       string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
-	// Reference to [Groups](#groups).id (only where groupname = 'filetype')
-	Filetype int64  `protobuf:"varint,9,opt,name=filetype,proto3" json:"filetype,omitempty"`
-	Report   string `protobuf:"bytes,10,opt,name=report,proto3" json:"report,omitempty"`
+	Reportkey   string `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
+	Repname     string `protobuf:"bytes,2,opt,name=repname,proto3" json:"repname,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Label       string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Reptype     string `protobuf:"bytes,5,opt,name=reptype,proto3" json:"reptype,omitempty"`
+	Filename    string `protobuf:"bytes,6,opt,name=filename,proto3" json:"filename,omitempty"`
+	Installed   bool   `protobuf:"varint,7,opt,name=installed,proto3" json:"installed,omitempty"`
 }
 
-func (x *UiReport) Reset() {
-	*x = UiReport{}
+func (x *ResponseReportList_Info) Reset() {
+	*x = ResponseReportList_Info{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[60]
+		mi := &file_api_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UiReport) String() string {
+func (x *ResponseReportList_Info) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UiReport) ProtoMessage() {}
+func (*ResponseReportList_Info) ProtoMessage() {}
 
-func (x *UiReport) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[60]
+func (x *ResponseReportList_Info) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5859,119 +7504,86 @@ func (x *UiReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UiReport.ProtoReflect.Descriptor instead.
-func (*UiReport) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *UiReport) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use ResponseReportList_Info.ProtoReflect.Descriptor instead.
+func (*ResponseReportList_Info) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{26, 0}
 }
 
-func (x *UiReport) GetReportkey() string {
+func (x *ResponseReportList_Info) GetReportkey() string {
 	if x != nil {
 		return x.Reportkey
 	}
 	return ""
 }
 
-func (x *UiReport) GetNervatype() int64 {
-	if x != nil {
-		return x.Nervatype
-	}
-	return 0
-}
-
-func (x *UiReport) GetTranstype() int64 {
-	if x != nil && x.Transtype != nil {
-		return *x.Transtype
-	}
-	return 0
-}
-
-func (x *UiReport) GetDirection() int64 {
-	if x != nil && x.Direction != nil {
-		return *x.Direction
-	}
-	return 0
-}
-
-func (x *UiReport) GetRepname() string {
+func (x *ResponseReportList_Info) GetRepname() string {
 	if x != nil {
 		return x.Repname
 	}
 	return ""
 }
 
-func (x *UiReport) GetDescription() string {
+func (x *ResponseReportList_Info) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *UiReport) GetLabel() string {
+func (x *ResponseReportList_Info) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *UiReport) GetFiletype() int64 {
+func (x *ResponseReportList_Info) GetReptype() string {
 	if x != nil {
-		return x.Filetype
+		return x.Reptype
 	}
-	return 0
+	return ""
 }
 
-func (x *UiReport) GetReport() string {
+func (x *ResponseReportList_Info) GetFilename() string {
 	if x != nil {
-		return x.Report
+		return x.Filename
 	}
 	return ""
 }
 
-//
-//RequestUpdate Key->ID keys:
-//
-//- ```id```: *{empnumber}~{section}~{cfgroup}~{cfname}*
-//
-//- ```employee_id```: Employee *{empnumber}*
-type UiUserconfig struct {
+func (x *ResponseReportList_Info) GetInstalled() bool {
+	if x != nil {
+		return x.Installed
+	}
+	return false
+}
+
+type ResponseImport_Error struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Reference to [Employee](#employee).id
-	EmployeeId *int64  `protobuf:"varint,2,opt,name=employee_id,json=employeeId,proto3,oneof" json:"employee_id,omitempty"`
-	Section    *string `protobuf:"bytes,3,opt,name=section,proto3,oneof" json:"section,omitempty"`
-	Cfgroup    string  `protobuf:"bytes,4,opt,name=cfgroup,proto3" json:"cfgroup,omitempty"`
-	Cfname     string  `protobuf:"bytes,5,opt,name=cfname,proto3" json:"cfname,omitempty"`
-	Cfvalue    *string `protobuf:"bytes,6,opt,name=cfvalue,proto3,oneof" json:"cfvalue,omitempty"`
-	Orderby    int64   `protobuf:"varint,7,opt,name=orderby,proto3" json:"orderby,omitempty"`
+	Row     int64  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *UiUserconfig) Reset() {
-	*x = UiUserconfig{}
+func (x *ResponseImport_Error) Reset() {
+	*x = ResponseImport_Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[61]
+		mi := &file_api_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UiUserconfig) String() string {
+func (x *ResponseImport_Error) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UiUserconfig) ProtoMessage() {}
+func (*ResponseImport_Error) ProtoMessage() {}
 
-func (x *UiUserconfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[61]
+func (x *ResponseImport_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5982,85 +7594,55 @@ func (x *UiUserconfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UiUserconfig.ProtoReflect.Descriptor instead.
-func (*UiUserconfig) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ResponseImport_Error.ProtoReflect.Descriptor instead.
+func (*ResponseImport_Error) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35, 0}
 }
 
-func (x *UiUserconfig) GetId() int64 {
+func (x *ResponseImport_Error) GetRow() int64 {
 	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UiUserconfig) GetEmployeeId() int64 {
-	if x != nil && x.EmployeeId != nil {
-		return *x.EmployeeId
+		return x.Row
 	}
 	return 0
 }
 
-func (x *UiUserconfig) GetSection() string {
-	if x != nil && x.Section != nil {
-		return *x.Section
-	}
-	return ""
-}
-
-func (x *UiUserconfig) GetCfgroup() string {
-	if x != nil {
-		return x.Cfgroup
-	}
-	return ""
-}
-
-func (x *UiUserconfig) GetCfname() string {
+func (x *ResponseImport_Error) GetMessage() string {
 	if x != nil {
-		return x.Cfname
-	}
-	return ""
-}
-
-func (x *UiUserconfig) GetCfvalue() string {
-	if x != nil && x.Cfvalue != nil {
-		return *x.Cfvalue
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UiUserconfig) GetOrderby() int64 {
-	if x != nil {
-		return x.Orderby
-	}
-	return 0
-}
-
-type ResponseRows_Item struct {
+type ResponseBankImport_Statement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values map[string]*Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The statement ID (ref_transnumber of the bank document)
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account     string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Rows        int64  `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	TransId     int64  `protobuf:"varint,4,opt,name=trans_id,json=transId,proto3" json:"trans_id,omitempty"`
+	Transnumber string `protobuf:"bytes,5,opt,name=transnumber,proto3" json:"transnumber,omitempty"`
 }
 
-func (x *ResponseRows_Item) Reset() {
-	*x = ResponseRows_Item{}
+func (x *ResponseBankImport_Statement) Reset() {
+	*x = ResponseBankImport_Statement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[62]
+		mi := &file_api_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseRows_Item) String() string {
+func (x *ResponseBankImport_Statement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseRows_Item) ProtoMessage() {}
+func (*ResponseBankImport_Statement) ProtoMessage() {}
 
-func (x *ResponseRows_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[62]
+func (x *ResponseBankImport_Statement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6071,48 +7653,78 @@ func (x *ResponseRows_Item) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseRows_Item.ProtoReflect.Descriptor instead.
-func (*ResponseRows_Item) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ResponseBankImport_Statement.ProtoReflect.Descriptor instead.
+func (*ResponseBankImport_Statement) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37, 0}
 }
 
-func (x *ResponseRows_Item) GetValues() map[string]*Value {
+func (x *ResponseBankImport_Statement) GetId() string {
 	if x != nil {
-		return x.Values
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type RequestView_Query struct {
+func (x *ResponseBankImport_Statement) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *ResponseBankImport_Statement) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ResponseBankImport_Statement) GetTransId() int64 {
+	if x != nil {
+		return x.TransId
+	}
+	return 0
+}
+
+func (x *ResponseBankImport_Statement) GetTransnumber() string {
+	if x != nil {
+		return x.Transnumber
+	}
+	return ""
+}
+
+// A payment-invoice link of a statement line
+type ResponseBankImport_Match struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Give the query a unique name
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// The SQL query as a string
-	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	// The array of parameter values
-	Values []*Value `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Statement string `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	Row       int64  `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	// The invoice number
+	Transnumber string  `protobuf:"bytes,3,opt,name=transnumber,proto3" json:"transnumber,omitempty"`
+	Amount      float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The invoice is fully paid
+	Paid bool `protobuf:"varint,5,opt,name=paid,proto3" json:"paid,omitempty"`
 }
 
-func (x *RequestView_Query) Reset() {
-	*x = RequestView_Query{}
+func (x *ResponseBankImport_Match) Reset() {
+	*x = ResponseBankImport_Match{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[64]
+		mi := &file_api_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RequestView_Query) String() string {
+func (x *ResponseBankImport_Match) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RequestView_Query) ProtoMessage() {}
+func (*ResponseBankImport_Match) ProtoMessage() {}
 
-func (x *RequestView_Query) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[64]
+func (x *ResponseBankImport_Match) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6123,63 +7735,77 @@ func (x *RequestView_Query) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RequestView_Query.ProtoReflect.Descriptor instead.
-func (*RequestView_Query) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use ResponseBankImport_Match.ProtoReflect.Descriptor instead.
+func (*ResponseBankImport_Match) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37, 1}
 }
 
-func (x *RequestView_Query) GetKey() string {
+func (x *ResponseBankImport_Match) GetStatement() string {
 	if x != nil {
-		return x.Key
+		return x.Statement
 	}
 	return ""
 }
 
-func (x *RequestView_Query) GetText() string {
+func (x *ResponseBankImport_Match) GetRow() int64 {
 	if x != nil {
-		return x.Text
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ResponseBankImport_Match) GetTransnumber() string {
+	if x != nil {
+		return x.Transnumber
 	}
 	return ""
 }
 
-func (x *RequestView_Query) GetValues() []*Value {
+func (x *ResponseBankImport_Match) GetAmount() float64 {
 	if x != nil {
-		return x.Values
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-type ResponseReportList_Info struct {
+func (x *ResponseBankImport_Match) GetPaid() bool {
+	if x != nil {
+		return x.Paid
+	}
+	return false
+}
+
+// A not matched statement line for manual review
+type ResponseBankImport_Line struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reportkey   string `protobuf:"bytes,1,opt,name=reportkey,proto3" json:"reportkey,omitempty"`
-	Repname     string `protobuf:"bytes,2,opt,name=repname,proto3" json:"repname,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Label       string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
-	Reptype     string `protobuf:"bytes,5,opt,name=reptype,proto3" json:"reptype,omitempty"`
-	Filename    string `protobuf:"bytes,6,opt,name=filename,proto3" json:"filename,omitempty"`
-	Installed   bool   `protobuf:"varint,7,opt,name=installed,proto3" json:"installed,omitempty"`
+	Statement string  `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	Row       int64   `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Paiddate  string  `protobuf:"bytes,3,opt,name=paiddate,proto3" json:"paiddate,omitempty"`
+	Amount    float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference string  `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	Name      string  `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ResponseReportList_Info) Reset() {
-	*x = ResponseReportList_Info{}
+func (x *ResponseBankImport_Line) Reset() {
+	*x = ResponseBankImport_Line{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[67]
+		mi := &file_api_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResponseReportList_Info) String() string {
+func (x *ResponseBankImport_Line) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResponseReportList_Info) ProtoMessage() {}
+func (*ResponseBankImport_Line) ProtoMessage() {}
 
-func (x *ResponseReportList_Info) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[67]
+func (x *ResponseBankImport_Line) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6190,58 +7816,51 @@ func (x *ResponseReportList_Info) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResponseReportList_Info.ProtoReflect.Descriptor instead.
-func (*ResponseReportList_Info) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{19, 0}
-}
-
-func (x *ResponseReportList_Info) GetReportkey() string {
-	if x != nil {
-		return x.Reportkey
-	}
-	return ""
+// Deprecated: Use ResponseBankImport_Line.ProtoReflect.Descriptor instead.
+func (*ResponseBankImport_Line) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37, 2}
 }
 
-func (x *ResponseReportList_Info) GetRepname() string {
+func (x *ResponseBankImport_Line) GetStatement() string {
 	if x != nil {
-		return x.Repname
+		return x.Statement
 	}
 	return ""
 }
 
-func (x *ResponseReportList_Info) GetDescription() string {
+func (x *ResponseBankImport_Line) GetRow() int64 {
 	if x != nil {
-		return x.Description
+		return x.Row
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseReportList_Info) GetLabel() string {
+func (x *ResponseBankImport_Line) GetPaiddate() string {
 	if x != nil {
-		return x.Label
+		return x.Paiddate
 	}
 	return ""
 }
 
-func (x *ResponseReportList_Info) GetReptype() string {
+func (x *ResponseBankImport_Line) GetAmount() float64 {
 	if x != nil {
-		return x.Reptype
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *ResponseReportList_Info) GetFilename() string {
+func (x *ResponseBankImport_Line) GetReference() string {
 	if x != nil {
-		return x.Filename
+		return x.Reference
 	}
 	return ""
 }
 
-func (x *ResponseReportList_Info) GetInstalled() bool {
+func (x *ResponseBankImport_Line) GetName() string {
 	if x != nil {
-		return x.Installed
+		return x.Name
 	}
-	return false
+	return ""
 }
 
 type RequestUpdate_Item struct {
@@ -6256,7 +7875,7 @@ type RequestUpdate_Item struct {
 func (x *RequestUpdate_Item) Reset() {
 	*x = RequestUpdate_Item{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[69]
+		mi := &file_api_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6269,7 +7888,7 @@ func (x *RequestUpdate_Item) String() string {
 func (*RequestUpdate_Item) ProtoMessage() {}
 
 func (x *RequestUpdate_Item) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[69]
+	mi := &file_api_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6282,7 +7901,7 @@ func (x *RequestUpdate_Item) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestUpdate_Item.ProtoReflect.Descriptor instead.
 func (*RequestUpdate_Item) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{25, 0}
+	return file_api_proto_rawDescGZIP(), []int{38, 0}
 }
 
 func (x *RequestUpdate_Item) GetValues() map[string]*Value {
@@ -6343,7 +7962,7 @@ type ResponseGet_Value struct {
 func (x *ResponseGet_Value) Reset() {
 	*x = ResponseGet_Value{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[72]
+		mi := &file_api_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6356,7 +7975,7 @@ func (x *ResponseGet_Value) String() string {
 func (*ResponseGet_Value) ProtoMessage() {}
 
 func (x *ResponseGet_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[72]
+	mi := &file_api_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6369,7 +7988,7 @@ func (x *ResponseGet_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseGet_Value.ProtoReflect.Descriptor instead.
 func (*ResponseGet_Value) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{28, 0}
+	return file_api_proto_rawDescGZIP(), []int{43, 0}
 }
 
 func (m *ResponseGet_Value) GetValue() isResponseGet_Value_Value {
@@ -6879,931 +8498,1172 @@ var file_api_proto_rawDesc = []byte{
 	0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x22, 0x64, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x75, 0x72, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6e, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x24, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x25,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x69,
-	0x65, 0x77, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x1a, 0x57, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
-	0x12, 0x28, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x3b, 0x0a, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65,
+	0x69, 0x6c, 0x73, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x22, 0x2e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4c, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x47, 0x0a, 0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0x4c, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65,
 	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x56, 0x69, 0x65, 0x77, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x52, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77,
-	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a,
-	0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56,
+	0x52, 0x6f, 0x77, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x64, 0x0a,
+	0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x31,
+	0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x69, 0x65, 0x77, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x57, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x69, 0x65, 0x77, 0x12, 0x3b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x69, 0x65, 0x77, 0x2e, 0x56,
 	0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x65, 0x73, 0x1a, 0x52, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x28, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x22, 0x9b, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xca, 0x01, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
-	0x65, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18,
-	0x0a, 0x07, 0x72, 0x65, 0x70, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x72, 0x65, 0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x22, 0x34, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x22, 0x27, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x33, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x22, 0xbd, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6e, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72,
-	0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
-	0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x66, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x66, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x4c, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x98,
-	0x03, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x31, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x9e, 0x02, 0x0a, 0x04, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x41, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x74, 0x65,
-	0x6d, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x1a, 0x4b, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49,
-	0x0a, 0x09, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x0b,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e,
 	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x28, 0x0a, 0x0e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47,
-	0x65, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
-	0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03,
-	0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd7, 0x0c, 0x0a, 0x0b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x47, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x28, 0x0a, 0x10, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x89, 0x04, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65,
 	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x47, 0x65, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x1a, 0x91, 0x0c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x62,
-	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x42, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65,
-	0x48, 0x00, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x31,
-	0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x44, 0x65, 0x66, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65, 0x66, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
-	0x72, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
-	0x72, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x12, 0x37, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
-	0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0a,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x48, 0x00, 0x52,
-	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
-	0x61, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x25,
-	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x22, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4c,
-	0x6f, 0x67, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x6f, 0x76,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x09,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x64, 0x65, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x64, 0x65, 0x66, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x64,
-	0x65, 0x66, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x75, 0x72, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x75, 0x72, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
-	0x2e, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
-	0x03, 0x74, 0x61, 0x78, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x54, 0x61, 0x78, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61,
-	0x78, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x54, 0x6f, 0x6f, 0x6c,
-	0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x75, 0x72, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x05, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x75, 0x69, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x1a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
-	0x2e, 0x55, 0x69, 0x41, 0x75, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x07, 0x75, 0x69, 0x41, 0x75,
-	0x64, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x1b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
-	0x2e, 0x55, 0x69, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x4d, 0x65, 0x6e,
-	0x75, 0x12, 0x3e, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x55, 0x69, 0x4d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x69, 0x4d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x35, 0x0a, 0x0a, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
-	0x61, 0x2e, 0x55, 0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x75,
-	0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x70,
-	0x72, 0x69, 0x6e, 0x74, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x55, 0x69, 0x50, 0x72,
-	0x69, 0x6e, 0x74, 0x71, 0x75, 0x65, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x69, 0x50, 0x72,
-	0x69, 0x6e, 0x74, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x75, 0x69, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x55, 0x69, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x48, 0x00, 0x52, 0x08, 0x75, 0x69, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x0d,
-	0x75, 0x69, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x20, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x55, 0x69, 0x55, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c,
-	0x75, 0x69, 0x55, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x07, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x07, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x7a,
-	0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69,
-	0x70, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
-	0x65, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbc, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x72,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x72,
-	0x63, 0x6f, 0x64, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x71,
-	0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x65, 0x66, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x64, 0x65, 0x66, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x66, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x61, 0x78,
-	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
-	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0xc9, 0x01, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x75, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x75, 0x72, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x67, 0x69, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x69, 0x67, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x64, 0x65, 0x66, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64,
-	0x65, 0x66, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x6f, 0x75, 0x6e, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2f,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74,
-	0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xf7, 0x02, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x75, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x63, 0x75, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75,
-	0x73, 0x74, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x78, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x78, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x74, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x74,
-	0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63,
-	0x72, 0x65, 0x64, 0x69, 0x74, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x02, 0x0a, 0x08, 0x44, 0x65,
-	0x66, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x6e, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x61, 0x64, 0x64, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62,
-	0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8c, 0x03, 0x0a, 0x08, 0x45, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x70, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x70, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64,
-	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x64, 0x61,
-	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xf7, 0x02, 0x0a, 0x05, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x15,
-	0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08,
-	0x66, 0x72, 0x6f, 0x6d, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x66, 0x72, 0x6f, 0x6d, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x6f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x64, 0x61,
-	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x6f, 0x64, 0x61,
-	0x74, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x00, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x66, 0x5f,
-	0x69, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xc2, 0x03, 0x0a, 0x04, 0x49, 0x74,
-	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71,
-	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x78, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x78, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x6e, 0x65, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x09, 0x6e, 0x65, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x61, 0x78, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x76, 0x61, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x09, 0x76, 0x61, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbd,
-	0x01, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x79, 0x70, 0x65, 0x5f, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x18, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f,
-	0x69, 0x64, 0x5f, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x66, 0x49,
-	0x64, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x5f,
-	0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79,
-	0x70, 0x65, 0x32, 0x12, 0x18, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x5f, 0x32, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x66, 0x49, 0x64, 0x32, 0x12, 0x2f, 0x0a,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xf3,
-	0x01, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
-	0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x21, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
-	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
-	0x66, 0x5f, 0x69, 0x64, 0x22, 0xfc, 0x02, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x1c, 0x0a, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e,
-	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x02, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x10,
-	0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x74, 0x79,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x74,
-	0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x22, 0x9b, 0x02, 0x0a, 0x09, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x64, 0x65,
-	0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x6b, 0x65, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x75, 0x72, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x65, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x70, 0x12, 0x10, 0x0a,
-	0x03, 0x6c, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
-	0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62,
-	0x79, 0x22, 0x8f, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x6b, 0x0a, 0x03, 0x41, 0x72, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x59, 0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74,
+	0x1a, 0xec, 0x01, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
 	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x70, 0x61, 0x74, 0x74,
-	0x65, 0x72, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x41,
+	0x72, 0x67, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x05,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22,
+	0x29, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x9b, 0x02, 0x0a, 0x12, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x2e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0xca, 0x01, 0x0a, 0x04,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x22, 0x27,
+	0x0a, 0x15, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x22, 0xbd, 0x03, 0x0a,
+	0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x0b,
+	0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x6f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x7a,
+	0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x4c,
+	0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x26, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x3a, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xc4, 0x01, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x33, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x78, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x6e,
+	0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xd5, 0x05, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x12, 0x47, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x61, 0x6e, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x75, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x09, 0x75, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x1a, 0x86, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x1a, 0x85, 0x01,
+	0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x70, 0x61, 0x69, 0x64, 0x1a, 0x9c, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x69, 0x64, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x98, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x9e,
+	0x02, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x41, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x1a, 0x4b, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x28, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x10, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x33,
+	0x0a, 0x0a, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a,
+	0x4c, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe4, 0x02,
+	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x69, 0x64, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x69, 0x64, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x31, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb0, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x47, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x54, 0x72, 0x65, 0x65, 0x22, 0xed, 0x0c, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x47, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x47, 0x65, 0x74, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x1a, 0x91, 0x0c, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a,
+	0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x42, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x31, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x31, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x44, 0x65, 0x66, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x08, 0x64, 0x65,
+	0x66, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x48, 0x00, 0x52,
+	0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x48,
+	0x00, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x12, 0x25, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x22, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
+	0x2e, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x31, 0x0a, 0x08, 0x6d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x34,
+	0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x64, 0x65, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x64, 0x65, 0x66, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x64, 0x65, 0x66, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x48, 0x00, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12,
+	0x22, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x54, 0x61, 0x78, 0x48, 0x00, 0x52, 0x03,
+	0x74, 0x61, 0x78, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x54, 0x6f,
+	0x6f, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x05, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x75, 0x69, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x55, 0x69, 0x41, 0x75, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x07, 0x75, 0x69,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x55, 0x69, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x4d,
+	0x65, 0x6e, 0x75, 0x12, 0x3e, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x55, 0x69, 0x4d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x69, 0x4d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x55, 0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x75, 0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x75, 0x69,
+	0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x55, 0x69,
+	0x50, 0x72, 0x69, 0x6e, 0x74, 0x71, 0x75, 0x65, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x69,
+	0x50, 0x72, 0x69, 0x6e, 0x74, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x75, 0x69,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x55, 0x69, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x48, 0x00, 0x52, 0x08, 0x75, 0x69, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3e,
+	0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x55, 0x69, 0x55, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x0c, 0x75, 0x69, 0x55, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x02, 0x0a,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x7a, 0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x7a, 0x69, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xbc, 0x01, 0x0a, 0x07, 0x42,
+	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x62,
+	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x71, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x66, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66,
+	0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
 	0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
 	0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x96, 0x02, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc9, 0x01, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x75, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x75, 0x72, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x67, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x69, 0x67, 0x69, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x66, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x07, 0x64, 0x65, 0x66, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0xf7, 0x02, 0x0a, 0x08, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x63, 0x75, 0x73, 0x74, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75,
+	0x73, 0x74, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x75, 0x73, 0x74, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x78, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x78, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x61, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x02, 0x0a, 0x08,
+	0x44, 0x65, 0x66, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x6e, 0x65, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x64, 0x64, 0x6e, 0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x22, 0x8c, 0x03, 0x0a, 0x08,
+	0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x70, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x70,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x0a, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xf7, 0x02, 0x0a, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6c, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x88, 0x01, 0x01, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x6f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x74, 0x6f,
+	0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x6f,
+	0x64, 0x61, 0x74, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65,
+	0x66, 0x5f, 0x69, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
-	0x04, 0x63, 0x75, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x63,
-	0x75, 0x72, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x66, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
-	0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x22, 0x98,
-	0x02, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x74, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x74,
-	0x6f, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x75, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x75, 0x72, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x65,
-	0x6e, 0x64, 0x6f, 0x72, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x74, 0x6f, 0x22, 0x9d, 0x02, 0x0a, 0x07, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x61, 0x78, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xce, 0x02, 0x0a, 0x07, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d,
-	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
-	0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xc2, 0x03, 0x0a, 0x04,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e,
+	0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x71, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x78, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x78, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x65, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x61, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x61, 0x78, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x61, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x76, 0x61, 0x74, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65,
 	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x04, 0x52,
-	0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x75, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x75, 0x72, 0x72, 0x12,
-	0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x21, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x88,
-	0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xb2, 0x01, 0x0a,
-	0x03, 0x54, 0x61, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x78, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x78, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
-	0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d,
-	0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x83, 0x02, 0x0a, 0x04, 0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x6f,
-	0x6f, 0x6c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xd9, 0x06, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x72, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x64, 0x75, 0x65, 0x64, 0x61, 0x74, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c,
-	0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52,
-	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23,
-	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x07, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x08, 0x70, 0x61, 0x69,
-	0x64, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x75, 0x72, 0x72,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x04, 0x63, 0x75, 0x72, 0x72, 0x88, 0x01,
-	0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x61, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x63, 0x72, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x63, 0x72,
-	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f,
-	0x73, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x73, 0x65,
-	0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x18, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x75, 0x65, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f,
-	0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
-	0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63,
-	0x75, 0x72, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x55, 0x69, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12,
+	0x22, 0xbd, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x18, 0x0a, 0x08, 0x72, 0x65,
+	0x66, 0x5f, 0x69, 0x64, 0x5f, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65,
+	0x66, 0x49, 0x64, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x18, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x5f,
+	0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x66, 0x49, 0x64, 0x32, 0x12,
+	0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xf3, 0x01, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x72, 0x65, 0x66, 0x5f, 0x69, 0x64, 0x22, 0xfc, 0x02, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x02, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71,
+	0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x9b, 0x02, 0x0a, 0x09, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x64, 0x65, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x6b, 0x65,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x79, 0x65, 0x61, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x62, 0x79, 0x22, 0x8f, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1c, 0x0a,
-	0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x73,
-	0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07,
-	0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x06, 0x55, 0x69, 0x4d,
-	0x65, 0x6e, 0x75, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0xaf, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x69, 0x64, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x69, 0x64, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x96, 0x02, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x55, 0x69, 0x4d, 0x65,
-	0x6e, 0x75, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x6e, 0x75,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6e, 0x75, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x17, 0x0a, 0x04, 0x63, 0x75, 0x72, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x04, 0x63, 0x75, 0x72, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x66, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x22, 0x98, 0x02, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x07, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x75, 0x72, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x75, 0x72, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2f,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x74, 0x6f, 0x22, 0x9d, 0x02, 0x0a, 0x07,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x74, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x61, 0x78, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x61, 0x78, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xce, 0x02, 0x0a, 0x07,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x64, 0x64, 0x61, 0x74, 0x65, 0x22, 0x8f, 0x02, 0x0a,
+	0x04, 0x52, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x75, 0x72, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x75, 0x72,
+	0x72, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xb2,
+	0x01, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x78, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x78, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x83, 0x02, 0x0a, 0x04, 0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x6f, 0x6c,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x74, 0x6f, 0x6f, 0x6c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xd9, 0x06, 0x0a, 0x05, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65,
+	0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x64, 0x75, 0x65, 0x64, 0x61, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0a, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x03, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x07, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x69,
+	0x64, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x08, 0x70,
+	0x61, 0x69, 0x64, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x75,
+	0x72, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x04, 0x63, 0x75, 0x72, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x61, 0x78, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x72, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
+	0x63, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6e, 0x74, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x75, 0x65,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x74, 0x79, 0x70, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x63, 0x75, 0x72, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x07, 0x55, 0x69, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x73, 0x75, 0x62, 0x74, 0x79, 0x70, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x06, 0x55,
+	0x69, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x6b, 0x65, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x22, 0x79, 0x0a, 0x09, 0x55, 0x69, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61,
-	0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6d, 0x73, 0x67, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x55, 0x69, 0x50, 0x72, 0x69, 0x6e, 0x74,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x71, 0x74,
-	0x79, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
-	0x65, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xbe, 0x02, 0x0a, 0x08, 0x55,
-	0x69, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74,
-	0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf6, 0x01, 0x0a, 0x0c,
-	0x55, 0x69, 0x55, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0b,
-	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x66, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x66, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x63,
-	0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x66, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x66, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x63, 0x66, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x66, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x2a, 0xaf, 0x03, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x10, 0x06,
-	0x12, 0x09, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x10,
-	0x0a, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x0b, 0x12, 0x07, 0x0a, 0x03, 0x6c,
-	0x6f, 0x67, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x64, 0x65, 0x66, 0x10,
-	0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x10, 0x0f, 0x12, 0x0b,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x10, 0x12, 0x09, 0x0a, 0x05, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x10, 0x11, 0x12, 0x09, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x10,
-	0x12, 0x12, 0x0b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x10, 0x13, 0x12, 0x0b,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x14, 0x12, 0x08, 0x0a, 0x04, 0x72,
-	0x61, 0x74, 0x65, 0x10, 0x15, 0x12, 0x07, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x10, 0x16, 0x12, 0x08,
-	0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x10, 0x17, 0x12, 0x09, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x10, 0x18, 0x12, 0x0c, 0x0a, 0x08, 0x75, 0x69, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x10,
-	0x19, 0x12, 0x0b, 0x0a, 0x07, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x10, 0x1a, 0x12, 0x11,
-	0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10,
-	0x1b, 0x12, 0x0e, 0x0a, 0x0a, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x10,
-	0x1c, 0x12, 0x11, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x10, 0x1d, 0x12, 0x0d, 0x0a, 0x09, 0x75, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x10, 0x1e, 0x12, 0x11, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x10, 0x1f, 0x2a, 0x30, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a, 0x08, 0x70,
-	0x6f, 0x72, 0x74, 0x72, 0x61, 0x69, 0x74, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x6c, 0x61, 0x6e,
-	0x64, 0x73, 0x63, 0x61, 0x70, 0x65, 0x10, 0x01, 0x2a, 0x3b, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x61, 0x33, 0x10, 0x00, 0x12, 0x06,
-	0x0a, 0x02, 0x61, 0x34, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x61, 0x35, 0x10, 0x02, 0x12, 0x0a,
-	0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x6c, 0x65,
-	0x67, 0x61, 0x6c, 0x10, 0x04, 0x2a, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x10, 0x00, 0x12,
-	0x07, 0x0a, 0x03, 0x78, 0x6d, 0x6c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x10, 0x03, 0x2a, 0xb6,
-	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a,
-	0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x13,
-	0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x10, 0x05,
-	0x12, 0x12, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74,
-	0x6f, 0x6f, 0x6c, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x10, 0x08, 0x32, 0xc3, 0x08, 0x0a, 0x03, 0x41, 0x50, 0x49, 0x12,
-	0x48, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a, 0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6e,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x55, 0x69,
+	0x4d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65,
+	0x6e, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6e,
+	0x75, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x22, 0x79, 0x0a, 0x09, 0x55,
+	0x69, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x61, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xe3, 0x01, 0x0a, 0x0c, 0x55, 0x69, 0x50, 0x72, 0x69,
+	0x6e, 0x74, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x71, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x72, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xbe, 0x02, 0x0a,
+	0x08, 0x55, 0x69, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x09, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65,
+	0x70, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x74, 0x79, 0x70, 0x65, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf6, 0x01,
+	0x0a, 0x0c, 0x55, 0x69, 0x55, 0x73, 0x65, 0x72, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24,
+	0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x66, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x66, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x66, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x66, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x63, 0x66, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x62, 0x79, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
+	0x66, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0xaf, 0x03, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x07, 0x12, 0x0e, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x08, 0x12, 0x0a, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x10, 0x09, 0x12, 0x08, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x10, 0x0a, 0x12, 0x08, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x10, 0x0b, 0x12, 0x07, 0x0a,
+	0x03, 0x6c, 0x6f, 0x67, 0x10, 0x0c, 0x12, 0x0c, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x10, 0x0d, 0x12, 0x0d, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x64, 0x65,
+	0x66, 0x10, 0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x10, 0x0f,
+	0x12, 0x0b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x10, 0x12, 0x09, 0x0a,
+	0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x11, 0x12, 0x09, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x10, 0x12, 0x12, 0x0b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x10, 0x13,
+	0x12, 0x0b, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x14, 0x12, 0x08, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x10, 0x15, 0x12, 0x07, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x10, 0x16,
+	0x12, 0x08, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6c, 0x10, 0x17, 0x12, 0x09, 0x0a, 0x05, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x10, 0x18, 0x12, 0x0c, 0x0a, 0x08, 0x75, 0x69, 0x5f, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x10, 0x19, 0x12, 0x0b, 0x0a, 0x07, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x10, 0x1a,
+	0x12, 0x11, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x10, 0x1b, 0x12, 0x0e, 0x0a, 0x0a, 0x75, 0x69, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x10, 0x1c, 0x12, 0x11, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x10, 0x1d, 0x12, 0x0d, 0x0a, 0x09, 0x75, 0x69, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x10, 0x1e, 0x12, 0x11, 0x0a, 0x0d, 0x75, 0x69, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x1f, 0x2a, 0x30, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0c, 0x0a,
+	0x08, 0x70, 0x6f, 0x72, 0x74, 0x72, 0x61, 0x69, 0x74, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x6c,
+	0x61, 0x6e, 0x64, 0x73, 0x63, 0x61, 0x70, 0x65, 0x10, 0x01, 0x2a, 0x3b, 0x0a, 0x0a, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x61, 0x33, 0x10, 0x00,
+	0x12, 0x06, 0x0a, 0x02, 0x61, 0x34, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x61, 0x35, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05,
+	0x6c, 0x65, 0x67, 0x61, 0x6c, 0x10, 0x04, 0x2a, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x78, 0x6d, 0x6c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x10, 0x03,
+	0x12, 0x08, 0x0a, 0x04, 0x78, 0x6c, 0x73, 0x78, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x68, 0x74,
+	0x6d, 0x6c, 0x10, 0x05, 0x2a, 0xb6, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x65, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x10, 0x03,
+	0x12, 0x10, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x10, 0x08, 0x32, 0xb7, 0x0d,
+	0x0a, 0x03, 0x41, 0x50, 0x49, 0x12, 0x48, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x1b, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x1a,
+	0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1e, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a,
+	0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0a, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x6e,
 	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x00, 0x12, 0x4a, 0x0a,
-	0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0x17, 0x2e,
-	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
-	0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0b, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0x1e, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x03, 0x47, 0x65, 0x74,
-	0x12, 0x15, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x1a, 0x16, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x47, 0x65, 0x74, 0x22,
-	0x00, 0x12, 0x3f, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6e, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
-	0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x22, 0x00, 0x12, 0x3e, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6e,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
-	0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x39, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x16, 0x2e, 0x6e, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x69,
-	0x65, 0x77, 0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x69, 0x65, 0x77, 0x22, 0x00, 0x12, 0x45, 0x0a,
-	0x08, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1b, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
-	0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d,
 	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
-	0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0x00, 0x42, 0x32, 0x5a,
-	0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x75, 0x72, 0x61, 0x2f, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0x1e, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x65, 0x74, 0x1a, 0x16, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x47, 0x65, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x19, 0x2e, 0x6e, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x18, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77,
+	0x12, 0x16, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x56, 0x69, 0x65, 0x77, 0x1a, 0x17, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x69, 0x65,
+	0x77, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x08, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x1b, 0x2e, 0x6e, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x19, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72,
+	0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x1a, 0x20, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0c, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0x00, 0x12,
+	0x57, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x12, 0x20, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x1a, 0x21, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x2e, 0x6e, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x22,
+	0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x21, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x22, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x1b, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x1a, 0x18, 0x2e, 0x6e, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x19, 0x2e, 0x6e,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x06, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x19, 0x2e,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0a, 0x42, 0x61,
+	0x6e, 0x6b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x75, 0x72, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x61, 0x6e, 0x6b,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x1d, 0x2e, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75,
+	0x72, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x61, 0x6e, 0x6b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2f,
+	0x6e, 0x65, 0x72, 0x76, 0x61, 0x74, 0x75, 0x72, 0x61, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7819,200 +9679,257 @@ func file_api_proto_rawDescGZIP() []byte {
 }
 
 var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
 var file_api_proto_goTypes = []interface{}{
-	(DataType)(0),                   // 0: nervatura.DataType
-	(ReportOrientation)(0),          // 1: nervatura.ReportOrientation
-	(ReportSize)(0),                 // 2: nervatura.ReportSize
-	(ReportOutput)(0),               // 3: nervatura.ReportOutput
-	(ReportType)(0),                 // 4: nervatura.ReportType
-	(*Value)(nil),                   // 5: nervatura.Value
-	(*ResponseRows)(nil),            // 6: nervatura.ResponseRows
-	(*RequestEmpty)(nil),            // 7: nervatura.RequestEmpty
-	(*ResponseEmpty)(nil),           // 8: nervatura.ResponseEmpty
-	(*RequestUserLogin)(nil),        // 9: nervatura.RequestUserLogin
-	(*ResponseUserLogin)(nil),       // 10: nervatura.ResponseUserLogin
-	(*RequestTokenDecode)(nil),      // 11: nervatura.RequestTokenDecode
-	(*ResponseTokenDecode)(nil),     // 12: nervatura.ResponseTokenDecode
-	(*ResponseTokenRefresh)(nil),    // 13: nervatura.ResponseTokenRefresh
-	(*ResponseTokenLogin)(nil),      // 14: nervatura.ResponseTokenLogin
-	(*RequestUserPassword)(nil),     // 15: nervatura.RequestUserPassword
-	(*RequestDatabaseCreate)(nil),   // 16: nervatura.RequestDatabaseCreate
-	(*ResponseDatabaseCreate)(nil),  // 17: nervatura.ResponseDatabaseCreate
-	(*RequestDelete)(nil),           // 18: nervatura.RequestDelete
-	(*RequestView)(nil),             // 19: nervatura.RequestView
-	(*ResponseView)(nil),            // 20: nervatura.ResponseView
-	(*RequestFunction)(nil),         // 21: nervatura.RequestFunction
-	(*ResponseFunction)(nil),        // 22: nervatura.ResponseFunction
-	(*RequestReportList)(nil),       // 23: nervatura.RequestReportList
-	(*ResponseReportList)(nil),      // 24: nervatura.ResponseReportList
-	(*RequestReportInstall)(nil),    // 25: nervatura.RequestReportInstall
-	(*ResponseReportInstall)(nil),   // 26: nervatura.ResponseReportInstall
-	(*RequestReportDelete)(nil),     // 27: nervatura.RequestReportDelete
-	(*RequestReport)(nil),           // 28: nervatura.RequestReport
-	(*ResponseReport)(nil),          // 29: nervatura.ResponseReport
-	(*RequestUpdate)(nil),           // 30: nervatura.RequestUpdate
-	(*ResponseUpdate)(nil),          // 31: nervatura.ResponseUpdate
-	(*RequestGet)(nil),              // 32: nervatura.RequestGet
-	(*ResponseGet)(nil),             // 33: nervatura.ResponseGet
-	(*MetaData)(nil),                // 34: nervatura.MetaData
-	(*Address)(nil),                 // 35: nervatura.Address
-	(*Barcode)(nil),                 // 36: nervatura.Barcode
-	(*Contact)(nil),                 // 37: nervatura.Contact
-	(*Currency)(nil),                // 38: nervatura.Currency
-	(*Customer)(nil),                // 39: nervatura.Customer
-	(*Deffield)(nil),                // 40: nervatura.Deffield
-	(*Employee)(nil),                // 41: nervatura.Employee
-	(*Event)(nil),                   // 42: nervatura.Event
-	(*Fieldvalue)(nil),              // 43: nervatura.Fieldvalue
-	(*Groups)(nil),                  // 44: nervatura.Groups
-	(*Item)(nil),                    // 45: nervatura.Item
-	(*Link)(nil),                    // 46: nervatura.Link
-	(*Log)(nil),                     // 47: nervatura.Log
-	(*Movement)(nil),                // 48: nervatura.Movement
-	(*Numberdef)(nil),               // 49: nervatura.Numberdef
-	(*Pattern)(nil),                 // 50: nervatura.Pattern
-	(*Payment)(nil),                 // 51: nervatura.Payment
-	(*Place)(nil),                   // 52: nervatura.Place
-	(*Price)(nil),                   // 53: nervatura.Price
-	(*Product)(nil),                 // 54: nervatura.Product
-	(*Project)(nil),                 // 55: nervatura.Project
-	(*Rate)(nil),                    // 56: nervatura.Rate
-	(*Tax)(nil),                     // 57: nervatura.Tax
-	(*Tool)(nil),                    // 58: nervatura.Tool
-	(*Trans)(nil),                   // 59: nervatura.Trans
-	(*UiAudit)(nil),                 // 60: nervatura.UiAudit
-	(*UiMenu)(nil),                  // 61: nervatura.UiMenu
-	(*UiMenufields)(nil),            // 62: nervatura.UiMenufields
-	(*UiMessage)(nil),               // 63: nervatura.UiMessage
-	(*UiPrintqueue)(nil),            // 64: nervatura.UiPrintqueue
-	(*UiReport)(nil),                // 65: nervatura.UiReport
-	(*UiUserconfig)(nil),            // 66: nervatura.UiUserconfig
-	(*ResponseRows_Item)(nil),       // 67: nervatura.ResponseRows.Item
-	nil,                             // 68: nervatura.ResponseRows.Item.ValuesEntry
-	(*RequestView_Query)(nil),       // 69: nervatura.RequestView.Query
-	nil,                             // 70: nervatura.ResponseView.ValuesEntry
-	nil,                             // 71: nervatura.RequestFunction.ValuesEntry
-	(*ResponseReportList_Info)(nil), // 72: nervatura.ResponseReportList.Info
-	nil,                             // 73: nervatura.RequestReport.FiltersEntry
-	(*RequestUpdate_Item)(nil),      // 74: nervatura.RequestUpdate.Item
-	nil,                             // 75: nervatura.RequestUpdate.Item.ValuesEntry
-	nil,                             // 76: nervatura.RequestUpdate.Item.KeysEntry
-	(*ResponseGet_Value)(nil),       // 77: nervatura.ResponseGet.Value
+	(DataType)(0),                        // 0: nervatura.DataType
+	(ReportOrientation)(0),               // 1: nervatura.ReportOrientation
+	(ReportSize)(0),                      // 2: nervatura.ReportSize
+	(ReportOutput)(0),                    // 3: nervatura.ReportOutput
+	(ReportType)(0),                      // 4: nervatura.ReportType
+	(*Value)(nil),                        // 5: nervatura.Value
+	(*ResponseRows)(nil),                 // 6: nervatura.ResponseRows
+	(*RequestEmpty)(nil),                 // 7: nervatura.RequestEmpty
+	(*ResponseEmpty)(nil),                // 8: nervatura.ResponseEmpty
+	(*RequestUserLogin)(nil),             // 9: nervatura.RequestUserLogin
+	(*ResponseUserLogin)(nil),            // 10: nervatura.ResponseUserLogin
+	(*RequestTokenDecode)(nil),           // 11: nervatura.RequestTokenDecode
+	(*ResponseTokenDecode)(nil),          // 12: nervatura.ResponseTokenDecode
+	(*ResponseTokenRefresh)(nil),         // 13: nervatura.ResponseTokenRefresh
+	(*ResponseTokenLogin)(nil),           // 14: nervatura.ResponseTokenLogin
+	(*RequestUserPassword)(nil),          // 15: nervatura.RequestUserPassword
+	(*RequestDatabaseCreate)(nil),        // 16: nervatura.RequestDatabaseCreate
+	(*ResponseDatabaseCreate)(nil),       // 17: nervatura.ResponseDatabaseCreate
+	(*RequestDatabaseBackup)(nil),        // 18: nervatura.RequestDatabaseBackup
+	(*ResponseDatabaseBackup)(nil),       // 19: nervatura.ResponseDatabaseBackup
+	(*RequestDatabaseRestore)(nil),       // 20: nervatura.RequestDatabaseRestore
+	(*ResponseDatabaseRestore)(nil),      // 21: nervatura.ResponseDatabaseRestore
+	(*RequestDatabaseUpgrade)(nil),       // 22: nervatura.RequestDatabaseUpgrade
+	(*ResponseDatabaseUpgrade)(nil),      // 23: nervatura.ResponseDatabaseUpgrade
+	(*RequestDelete)(nil),                // 24: nervatura.RequestDelete
+	(*RequestView)(nil),                  // 25: nervatura.RequestView
+	(*ResponseView)(nil),                 // 26: nervatura.ResponseView
+	(*RequestFunction)(nil),              // 27: nervatura.RequestFunction
+	(*ResponseFunction)(nil),             // 28: nervatura.ResponseFunction
+	(*ResponseFunctionList)(nil),         // 29: nervatura.ResponseFunctionList
+	(*RequestReportList)(nil),            // 30: nervatura.RequestReportList
+	(*ResponseReportList)(nil),           // 31: nervatura.ResponseReportList
+	(*RequestReportInstall)(nil),         // 32: nervatura.RequestReportInstall
+	(*ResponseReportInstall)(nil),        // 33: nervatura.ResponseReportInstall
+	(*RequestReportDelete)(nil),          // 34: nervatura.RequestReportDelete
+	(*RequestReport)(nil),                // 35: nervatura.RequestReport
+	(*ResponseReport)(nil),               // 36: nervatura.ResponseReport
+	(*RequestExport)(nil),                // 37: nervatura.RequestExport
+	(*ResponseExport)(nil),               // 38: nervatura.ResponseExport
+	(*RequestImport)(nil),                // 39: nervatura.RequestImport
+	(*ResponseImport)(nil),               // 40: nervatura.ResponseImport
+	(*RequestBankImport)(nil),            // 41: nervatura.RequestBankImport
+	(*ResponseBankImport)(nil),           // 42: nervatura.ResponseBankImport
+	(*RequestUpdate)(nil),                // 43: nervatura.RequestUpdate
+	(*ResponseUpdate)(nil),               // 44: nervatura.ResponseUpdate
+	(*RequestSubscribe)(nil),             // 45: nervatura.RequestSubscribe
+	(*ResponseEvent)(nil),                // 46: nervatura.ResponseEvent
+	(*RequestGet)(nil),                   // 47: nervatura.RequestGet
+	(*ResponseGet)(nil),                  // 48: nervatura.ResponseGet
+	(*MetaData)(nil),                     // 49: nervatura.MetaData
+	(*Address)(nil),                      // 50: nervatura.Address
+	(*Barcode)(nil),                      // 51: nervatura.Barcode
+	(*Contact)(nil),                      // 52: nervatura.Contact
+	(*Currency)(nil),                     // 53: nervatura.Currency
+	(*Customer)(nil),                     // 54: nervatura.Customer
+	(*Deffield)(nil),                     // 55: nervatura.Deffield
+	(*Employee)(nil),                     // 56: nervatura.Employee
+	(*Event)(nil),                        // 57: nervatura.Event
+	(*Fieldvalue)(nil),                   // 58: nervatura.Fieldvalue
+	(*Groups)(nil),                       // 59: nervatura.Groups
+	(*Item)(nil),                         // 60: nervatura.Item
+	(*Link)(nil),                         // 61: nervatura.Link
+	(*Log)(nil),                          // 62: nervatura.Log
+	(*Movement)(nil),                     // 63: nervatura.Movement
+	(*Numberdef)(nil),                    // 64: nervatura.Numberdef
+	(*Pattern)(nil),                      // 65: nervatura.Pattern
+	(*Payment)(nil),                      // 66: nervatura.Payment
+	(*Place)(nil),                        // 67: nervatura.Place
+	(*Price)(nil),                        // 68: nervatura.Price
+	(*Product)(nil),                      // 69: nervatura.Product
+	(*Project)(nil),                      // 70: nervatura.Project
+	(*Rate)(nil),                         // 71: nervatura.Rate
+	(*Tax)(nil),                          // 72: nervatura.Tax
+	(*Tool)(nil),                         // 73: nervatura.Tool
+	(*Trans)(nil),                        // 74: nervatura.Trans
+	(*UiAudit)(nil),                      // 75: nervatura.UiAudit
+	(*UiMenu)(nil),                       // 76: nervatura.UiMenu
+	(*UiMenufields)(nil),                 // 77: nervatura.UiMenufields
+	(*UiMessage)(nil),                    // 78: nervatura.UiMessage
+	(*UiPrintqueue)(nil),                 // 79: nervatura.UiPrintqueue
+	(*UiReport)(nil),                     // 80: nervatura.UiReport
+	(*UiUserconfig)(nil),                 // 81: nervatura.UiUserconfig
+	(*ResponseRows_Item)(nil),            // 82: nervatura.ResponseRows.Item
+	nil,                                  // 83: nervatura.ResponseRows.Item.ValuesEntry
+	(*RequestView_Query)(nil),            // 84: nervatura.RequestView.Query
+	nil,                                  // 85: nervatura.ResponseView.ValuesEntry
+	nil,                                  // 86: nervatura.RequestFunction.ValuesEntry
+	(*ResponseFunctionList_Arg)(nil),     // 87: nervatura.ResponseFunctionList.Arg
+	(*ResponseFunctionList_Audit)(nil),   // 88: nervatura.ResponseFunctionList.Audit
+	(*ResponseFunctionList_Info)(nil),    // 89: nervatura.ResponseFunctionList.Info
+	(*ResponseReportList_Info)(nil),      // 90: nervatura.ResponseReportList.Info
+	nil,                                  // 91: nervatura.RequestReport.FiltersEntry
+	(*ResponseImport_Error)(nil),         // 92: nervatura.ResponseImport.Error
+	(*ResponseBankImport_Statement)(nil), // 93: nervatura.ResponseBankImport.Statement
+	(*ResponseBankImport_Match)(nil),     // 94: nervatura.ResponseBankImport.Match
+	(*ResponseBankImport_Line)(nil),      // 95: nervatura.ResponseBankImport.Line
+	(*RequestUpdate_Item)(nil),           // 96: nervatura.RequestUpdate.Item
+	nil,                                  // 97: nervatura.RequestUpdate.Item.ValuesEntry
+	nil,                                  // 98: nervatura.RequestUpdate.Item.KeysEntry
+	nil,                                  // 99: nervatura.RequestSubscribe.FiltersEntry
+	nil,                                  // 100: nervatura.ResponseEvent.ValuesEntry
+	(*ResponseGet_Value)(nil),            // 101: nervatura.ResponseGet.Value
 }
 var file_api_proto_depIdxs = []int32{
-	67, // 0: nervatura.ResponseRows.items:type_name -> nervatura.ResponseRows.Item
-	6,  // 1: nervatura.ResponseDatabaseCreate.details:type_name -> nervatura.ResponseRows
-	0,  // 2: nervatura.RequestDelete.nervatype:type_name -> nervatura.DataType
-	69, // 3: nervatura.RequestView.options:type_name -> nervatura.RequestView.Query
-	70, // 4: nervatura.ResponseView.values:type_name -> nervatura.ResponseView.ValuesEntry
-	71, // 5: nervatura.RequestFunction.values:type_name -> nervatura.RequestFunction.ValuesEntry
-	72, // 6: nervatura.ResponseReportList.items:type_name -> nervatura.ResponseReportList.Info
-	1,  // 7: nervatura.RequestReport.orientation:type_name -> nervatura.ReportOrientation
-	2,  // 8: nervatura.RequestReport.size:type_name -> nervatura.ReportSize
-	3,  // 9: nervatura.RequestReport.output:type_name -> nervatura.ReportOutput
-	4,  // 10: nervatura.RequestReport.type:type_name -> nervatura.ReportType
-	73, // 11: nervatura.RequestReport.filters:type_name -> nervatura.RequestReport.FiltersEntry
-	0,  // 12: nervatura.RequestUpdate.nervatype:type_name -> nervatura.DataType
-	74, // 13: nervatura.RequestUpdate.items:type_name -> nervatura.RequestUpdate.Item
-	0,  // 14: nervatura.RequestGet.nervatype:type_name -> nervatura.DataType
-	77, // 15: nervatura.ResponseGet.values:type_name -> nervatura.ResponseGet.Value
-	34, // 16: nervatura.Address.metadata:type_name -> nervatura.MetaData
-	34, // 17: nervatura.Contact.metadata:type_name -> nervatura.MetaData
-	34, // 18: nervatura.Currency.metadata:type_name -> nervatura.MetaData
-	34, // 19: nervatura.Customer.metadata:type_name -> nervatura.MetaData
-	34, // 20: nervatura.Employee.metadata:type_name -> nervatura.MetaData
-	34, // 21: nervatura.Event.metadata:type_name -> nervatura.MetaData
-	34, // 22: nervatura.Item.metadata:type_name -> nervatura.MetaData
-	34, // 23: nervatura.Link.metadata:type_name -> nervatura.MetaData
-	34, // 24: nervatura.Log.metadata:type_name -> nervatura.MetaData
-	34, // 25: nervatura.Movement.metadata:type_name -> nervatura.MetaData
-	34, // 26: nervatura.Payment.metadata:type_name -> nervatura.MetaData
-	34, // 27: nervatura.Place.metadata:type_name -> nervatura.MetaData
-	34, // 28: nervatura.Price.metadata:type_name -> nervatura.MetaData
-	34, // 29: nervatura.Product.metadata:type_name -> nervatura.MetaData
-	34, // 30: nervatura.Project.metadata:type_name -> nervatura.MetaData
-	34, // 31: nervatura.Rate.metadata:type_name -> nervatura.MetaData
-	34, // 32: nervatura.Tax.metadata:type_name -> nervatura.MetaData
-	34, // 33: nervatura.Tool.metadata:type_name -> nervatura.MetaData
-	34, // 34: nervatura.Trans.metadata:type_name -> nervatura.MetaData
-	68, // 35: nervatura.ResponseRows.Item.values:type_name -> nervatura.ResponseRows.Item.ValuesEntry
-	5,  // 36: nervatura.ResponseRows.Item.ValuesEntry.value:type_name -> nervatura.Value
-	5,  // 37: nervatura.RequestView.Query.values:type_name -> nervatura.Value
-	6,  // 38: nervatura.ResponseView.ValuesEntry.value:type_name -> nervatura.ResponseRows
-	5,  // 39: nervatura.RequestFunction.ValuesEntry.value:type_name -> nervatura.Value
-	5,  // 40: nervatura.RequestReport.FiltersEntry.value:type_name -> nervatura.Value
-	75, // 41: nervatura.RequestUpdate.Item.values:type_name -> nervatura.RequestUpdate.Item.ValuesEntry
-	76, // 42: nervatura.RequestUpdate.Item.keys:type_name -> nervatura.RequestUpdate.Item.KeysEntry
-	5,  // 43: nervatura.RequestUpdate.Item.ValuesEntry.value:type_name -> nervatura.Value
-	5,  // 44: nervatura.RequestUpdate.Item.KeysEntry.value:type_name -> nervatura.Value
-	35, // 45: nervatura.ResponseGet.Value.address:type_name -> nervatura.Address
-	36, // 46: nervatura.ResponseGet.Value.barcode:type_name -> nervatura.Barcode
-	37, // 47: nervatura.ResponseGet.Value.contact:type_name -> nervatura.Contact
-	38, // 48: nervatura.ResponseGet.Value.currency:type_name -> nervatura.Currency
-	39, // 49: nervatura.ResponseGet.Value.customer:type_name -> nervatura.Customer
-	40, // 50: nervatura.ResponseGet.Value.deffield:type_name -> nervatura.Deffield
-	41, // 51: nervatura.ResponseGet.Value.employee:type_name -> nervatura.Employee
-	42, // 52: nervatura.ResponseGet.Value.event:type_name -> nervatura.Event
-	43, // 53: nervatura.ResponseGet.Value.fieldvalue:type_name -> nervatura.Fieldvalue
-	44, // 54: nervatura.ResponseGet.Value.groups:type_name -> nervatura.Groups
-	45, // 55: nervatura.ResponseGet.Value.item:type_name -> nervatura.Item
-	46, // 56: nervatura.ResponseGet.Value.link:type_name -> nervatura.Link
-	47, // 57: nervatura.ResponseGet.Value.log:type_name -> nervatura.Log
-	48, // 58: nervatura.ResponseGet.Value.movement:type_name -> nervatura.Movement
-	49, // 59: nervatura.ResponseGet.Value.numberdef:type_name -> nervatura.Numberdef
-	50, // 60: nervatura.ResponseGet.Value.pattern:type_name -> nervatura.Pattern
-	51, // 61: nervatura.ResponseGet.Value.payment:type_name -> nervatura.Payment
-	52, // 62: nervatura.ResponseGet.Value.place:type_name -> nervatura.Place
-	53, // 63: nervatura.ResponseGet.Value.price:type_name -> nervatura.Price
-	54, // 64: nervatura.ResponseGet.Value.product:type_name -> nervatura.Product
-	55, // 65: nervatura.ResponseGet.Value.project:type_name -> nervatura.Project
-	56, // 66: nervatura.ResponseGet.Value.rate:type_name -> nervatura.Rate
-	57, // 67: nervatura.ResponseGet.Value.tax:type_name -> nervatura.Tax
-	58, // 68: nervatura.ResponseGet.Value.tool:type_name -> nervatura.Tool
-	59, // 69: nervatura.ResponseGet.Value.trans:type_name -> nervatura.Trans
-	60, // 70: nervatura.ResponseGet.Value.ui_audit:type_name -> nervatura.UiAudit
-	61, // 71: nervatura.ResponseGet.Value.ui_menu:type_name -> nervatura.UiMenu
-	62, // 72: nervatura.ResponseGet.Value.ui_menufields:type_name -> nervatura.UiMenufields
-	63, // 73: nervatura.ResponseGet.Value.ui_message:type_name -> nervatura.UiMessage
-	64, // 74: nervatura.ResponseGet.Value.ui_printqueue:type_name -> nervatura.UiPrintqueue
-	65, // 75: nervatura.ResponseGet.Value.ui_report:type_name -> nervatura.UiReport
-	66, // 76: nervatura.ResponseGet.Value.ui_userconfig:type_name -> nervatura.UiUserconfig
-	9,  // 77: nervatura.API.UserLogin:input_type -> nervatura.RequestUserLogin
-	15, // 78: nervatura.API.UserPassword:input_type -> nervatura.RequestUserPassword
-	7,  // 79: nervatura.API.TokenLogin:input_type -> nervatura.RequestEmpty
-	7,  // 80: nervatura.API.TokenRefresh:input_type -> nervatura.RequestEmpty
-	11, // 81: nervatura.API.TokenDecode:input_type -> nervatura.RequestTokenDecode
-	32, // 82: nervatura.API.Get:input_type -> nervatura.RequestGet
-	30, // 83: nervatura.API.Update:input_type -> nervatura.RequestUpdate
-	18, // 84: nervatura.API.Delete:input_type -> nervatura.RequestDelete
-	19, // 85: nervatura.API.View:input_type -> nervatura.RequestView
-	21, // 86: nervatura.API.Function:input_type -> nervatura.RequestFunction
-	28, // 87: nervatura.API.Report:input_type -> nervatura.RequestReport
-	23, // 88: nervatura.API.ReportList:input_type -> nervatura.RequestReportList
-	25, // 89: nervatura.API.ReportInstall:input_type -> nervatura.RequestReportInstall
-	27, // 90: nervatura.API.ReportDelete:input_type -> nervatura.RequestReportDelete
-	16, // 91: nervatura.API.DatabaseCreate:input_type -> nervatura.RequestDatabaseCreate
-	10, // 92: nervatura.API.UserLogin:output_type -> nervatura.ResponseUserLogin
-	8,  // 93: nervatura.API.UserPassword:output_type -> nervatura.ResponseEmpty
-	14, // 94: nervatura.API.TokenLogin:output_type -> nervatura.ResponseTokenLogin
-	13, // 95: nervatura.API.TokenRefresh:output_type -> nervatura.ResponseTokenRefresh
-	12, // 96: nervatura.API.TokenDecode:output_type -> nervatura.ResponseTokenDecode
-	33, // 97: nervatura.API.Get:output_type -> nervatura.ResponseGet
-	31, // 98: nervatura.API.Update:output_type -> nervatura.ResponseUpdate
-	8,  // 99: nervatura.API.Delete:output_type -> nervatura.ResponseEmpty
-	20, // 100: nervatura.API.View:output_type -> nervatura.ResponseView
-	22, // 101: nervatura.API.Function:output_type -> nervatura.ResponseFunction
-	29, // 102: nervatura.API.Report:output_type -> nervatura.ResponseReport
-	24, // 103: nervatura.API.ReportList:output_type -> nervatura.ResponseReportList
-	26, // 104: nervatura.API.ReportInstall:output_type -> nervatura.ResponseReportInstall
-	8,  // 105: nervatura.API.ReportDelete:output_type -> nervatura.ResponseEmpty
-	17, // 106: nervatura.API.DatabaseCreate:output_type -> nervatura.ResponseDatabaseCreate
-	92, // [92:107] is the sub-list for method output_type
-	77, // [77:92] is the sub-list for method input_type
-	77, // [77:77] is the sub-list for extension type_name
-	77, // [77:77] is the sub-list for extension extendee
-	0,  // [0:77] is the sub-list for field type_name
+	82,  // 0: nervatura.ResponseRows.items:type_name -> nervatura.ResponseRows.Item
+	6,   // 1: nervatura.ResponseDatabaseCreate.details:type_name -> nervatura.ResponseRows
+	6,   // 2: nervatura.ResponseDatabaseRestore.details:type_name -> nervatura.ResponseRows
+	6,   // 3: nervatura.ResponseDatabaseUpgrade.details:type_name -> nervatura.ResponseRows
+	0,   // 4: nervatura.RequestDelete.nervatype:type_name -> nervatura.DataType
+	84,  // 5: nervatura.RequestView.options:type_name -> nervatura.RequestView.Query
+	85,  // 6: nervatura.ResponseView.values:type_name -> nervatura.ResponseView.ValuesEntry
+	86,  // 7: nervatura.RequestFunction.values:type_name -> nervatura.RequestFunction.ValuesEntry
+	89,  // 8: nervatura.ResponseFunctionList.items:type_name -> nervatura.ResponseFunctionList.Info
+	90,  // 9: nervatura.ResponseReportList.items:type_name -> nervatura.ResponseReportList.Info
+	1,   // 10: nervatura.RequestReport.orientation:type_name -> nervatura.ReportOrientation
+	2,   // 11: nervatura.RequestReport.size:type_name -> nervatura.ReportSize
+	3,   // 12: nervatura.RequestReport.output:type_name -> nervatura.ReportOutput
+	4,   // 13: nervatura.RequestReport.type:type_name -> nervatura.ReportType
+	91,  // 14: nervatura.RequestReport.filters:type_name -> nervatura.RequestReport.FiltersEntry
+	0,   // 15: nervatura.RequestExport.nervatype:type_name -> nervatura.DataType
+	0,   // 16: nervatura.RequestImport.nervatype:type_name -> nervatura.DataType
+	92,  // 17: nervatura.ResponseImport.errors:type_name -> nervatura.ResponseImport.Error
+	93,  // 18: nervatura.ResponseBankImport.statements:type_name -> nervatura.ResponseBankImport.Statement
+	94,  // 19: nervatura.ResponseBankImport.matches:type_name -> nervatura.ResponseBankImport.Match
+	95,  // 20: nervatura.ResponseBankImport.unmatched:type_name -> nervatura.ResponseBankImport.Line
+	0,   // 21: nervatura.RequestUpdate.nervatype:type_name -> nervatura.DataType
+	96,  // 22: nervatura.RequestUpdate.items:type_name -> nervatura.RequestUpdate.Item
+	0,   // 23: nervatura.RequestSubscribe.nervatypes:type_name -> nervatura.DataType
+	99,  // 24: nervatura.RequestSubscribe.filters:type_name -> nervatura.RequestSubscribe.FiltersEntry
+	0,   // 25: nervatura.ResponseEvent.nervatype:type_name -> nervatura.DataType
+	100, // 26: nervatura.ResponseEvent.values:type_name -> nervatura.ResponseEvent.ValuesEntry
+	0,   // 27: nervatura.RequestGet.nervatype:type_name -> nervatura.DataType
+	101, // 28: nervatura.ResponseGet.values:type_name -> nervatura.ResponseGet.Value
+	49,  // 29: nervatura.Address.metadata:type_name -> nervatura.MetaData
+	49,  // 30: nervatura.Contact.metadata:type_name -> nervatura.MetaData
+	49,  // 31: nervatura.Currency.metadata:type_name -> nervatura.MetaData
+	49,  // 32: nervatura.Customer.metadata:type_name -> nervatura.MetaData
+	49,  // 33: nervatura.Employee.metadata:type_name -> nervatura.MetaData
+	49,  // 34: nervatura.Event.metadata:type_name -> nervatura.MetaData
+	49,  // 35: nervatura.Item.metadata:type_name -> nervatura.MetaData
+	49,  // 36: nervatura.Link.metadata:type_name -> nervatura.MetaData
+	49,  // 37: nervatura.Log.metadata:type_name -> nervatura.MetaData
+	49,  // 38: nervatura.Movement.metadata:type_name -> nervatura.MetaData
+	49,  // 39: nervatura.Payment.metadata:type_name -> nervatura.MetaData
+	49,  // 40: nervatura.Place.metadata:type_name -> nervatura.MetaData
+	49,  // 41: nervatura.Price.metadata:type_name -> nervatura.MetaData
+	49,  // 42: nervatura.Product.metadata:type_name -> nervatura.MetaData
+	49,  // 43: nervatura.Project.metadata:type_name -> nervatura.MetaData
+	49,  // 44: nervatura.Rate.metadata:type_name -> nervatura.MetaData
+	49,  // 45: nervatura.Tax.metadata:type_name -> nervatura.MetaData
+	49,  // 46: nervatura.Tool.metadata:type_name -> nervatura.MetaData
+	49,  // 47: nervatura.Trans.metadata:type_name -> nervatura.MetaData
+	83,  // 48: nervatura.ResponseRows.Item.values:type_name -> nervatura.ResponseRows.Item.ValuesEntry
+	5,   // 49: nervatura.ResponseRows.Item.ValuesEntry.value:type_name -> nervatura.Value
+	5,   // 50: nervatura.RequestView.Query.values:type_name -> nervatura.Value
+	6,   // 51: nervatura.ResponseView.ValuesEntry.value:type_name -> nervatura.ResponseRows
+	5,   // 52: nervatura.RequestFunction.ValuesEntry.value:type_name -> nervatura.Value
+	87,  // 53: nervatura.ResponseFunctionList.Info.args:type_name -> nervatura.ResponseFunctionList.Arg
+	88,  // 54: nervatura.ResponseFunctionList.Info.audit:type_name -> nervatura.ResponseFunctionList.Audit
+	5,   // 55: nervatura.RequestReport.FiltersEntry.value:type_name -> nervatura.Value
+	97,  // 56: nervatura.RequestUpdate.Item.values:type_name -> nervatura.RequestUpdate.Item.ValuesEntry
+	98,  // 57: nervatura.RequestUpdate.Item.keys:type_name -> nervatura.RequestUpdate.Item.KeysEntry
+	5,   // 58: nervatura.RequestUpdate.Item.ValuesEntry.value:type_name -> nervatura.Value
+	5,   // 59: nervatura.RequestUpdate.Item.KeysEntry.value:type_name -> nervatura.Value
+	5,   // 60: nervatura.RequestSubscribe.FiltersEntry.value:type_name -> nervatura.Value
+	5,   // 61: nervatura.ResponseEvent.ValuesEntry.value:type_name -> nervatura.Value
+	50,  // 62: nervatura.ResponseGet.Value.address:type_name -> nervatura.Address
+	51,  // 63: nervatura.ResponseGet.Value.barcode:type_name -> nervatura.Barcode
+	52,  // 64: nervatura.ResponseGet.Value.contact:type_name -> nervatura.Contact
+	53,  // 65: nervatura.ResponseGet.Value.currency:type_name -> nervatura.Currency
+	54,  // 66: nervatura.ResponseGet.Value.customer:type_name -> nervatura.Customer
+	55,  // 67: nervatura.ResponseGet.Value.deffield:type_name -> nervatura.Deffield
+	56,  // 68: nervatura.ResponseGet.Value.employee:type_name -> nervatura.Employee
+	57,  // 69: nervatura.ResponseGet.Value.event:type_name -> nervatura.Event
+	58,  // 70: nervatura.ResponseGet.Value.fieldvalue:type_name -> nervatura.Fieldvalue
+	59,  // 71: nervatura.ResponseGet.Value.groups:type_name -> nervatura.Groups
+	60,  // 72: nervatura.ResponseGet.Value.item:type_name -> nervatura.Item
+	61,  // 73: nervatura.ResponseGet.Value.link:type_name -> nervatura.Link
+	62,  // 74: nervatura.ResponseGet.Value.log:type_name -> nervatura.Log
+	63,  // 75: nervatura.ResponseGet.Value.movement:type_name -> nervatura.Movement
+	64,  // 76: nervatura.ResponseGet.Value.numberdef:type_name -> nervatura.Numberdef
+	65,  // 77: nervatura.ResponseGet.Value.pattern:type_name -> nervatura.Pattern
+	66,  // 78: nervatura.ResponseGet.Value.payment:type_name -> nervatura.Payment
+	67,  // 79: nervatura.ResponseGet.Value.place:type_name -> nervatura.Place
+	68,  // 80: nervatura.ResponseGet.Value.price:type_name -> nervatura.Price
+	69,  // 81: nervatura.ResponseGet.Value.product:type_name -> nervatura.Product
+	70,  // 82: nervatura.ResponseGet.Value.project:type_name -> nervatura.Project
+	71,  // 83: nervatura.ResponseGet.Value.rate:type_name -> nervatura.Rate
+	72,  // 84: nervatura.ResponseGet.Value.tax:type_name -> nervatura.Tax
+	73,  // 85: nervatura.ResponseGet.Value.tool:type_name -> nervatura.Tool
+	74,  // 86: nervatura.ResponseGet.Value.trans:type_name -> nervatura.Trans
+	75,  // 87: nervatura.ResponseGet.Value.ui_audit:type_name -> nervatura.UiAudit
+	76,  // 88: nervatura.ResponseGet.Value.ui_menu:type_name -> nervatura.UiMenu
+	77,  // 89: nervatura.ResponseGet.Value.ui_menufields:type_name -> nervatura.UiMenufields
+	78,  // 90: nervatura.ResponseGet.Value.ui_message:type_name -> nervatura.UiMessage
+	79,  // 91: nervatura.ResponseGet.Value.ui_printqueue:type_name -> nervatura.UiPrintqueue
+	80,  // 92: nervatura.ResponseGet.Value.ui_report:type_name -> nervatura.UiReport
+	81,  // 93: nervatura.ResponseGet.Value.ui_userconfig:type_name -> nervatura.UiUserconfig
+	9,   // 94: nervatura.API.UserLogin:input_type -> nervatura.RequestUserLogin
+	15,  // 95: nervatura.API.UserPassword:input_type -> nervatura.RequestUserPassword
+	7,   // 96: nervatura.API.TokenLogin:input_type -> nervatura.RequestEmpty
+	7,   // 97: nervatura.API.TokenRefresh:input_type -> nervatura.RequestEmpty
+	11,  // 98: nervatura.API.TokenDecode:input_type -> nervatura.RequestTokenDecode
+	47,  // 99: nervatura.API.Get:input_type -> nervatura.RequestGet
+	43,  // 100: nervatura.API.Update:input_type -> nervatura.RequestUpdate
+	24,  // 101: nervatura.API.Delete:input_type -> nervatura.RequestDelete
+	25,  // 102: nervatura.API.View:input_type -> nervatura.RequestView
+	27,  // 103: nervatura.API.Function:input_type -> nervatura.RequestFunction
+	7,   // 104: nervatura.API.FunctionList:input_type -> nervatura.RequestEmpty
+	35,  // 105: nervatura.API.Report:input_type -> nervatura.RequestReport
+	30,  // 106: nervatura.API.ReportList:input_type -> nervatura.RequestReportList
+	32,  // 107: nervatura.API.ReportInstall:input_type -> nervatura.RequestReportInstall
+	34,  // 108: nervatura.API.ReportDelete:input_type -> nervatura.RequestReportDelete
+	16,  // 109: nervatura.API.DatabaseCreate:input_type -> nervatura.RequestDatabaseCreate
+	18,  // 110: nervatura.API.DatabaseBackup:input_type -> nervatura.RequestDatabaseBackup
+	20,  // 111: nervatura.API.DatabaseRestore:input_type -> nervatura.RequestDatabaseRestore
+	22,  // 112: nervatura.API.DatabaseUpgrade:input_type -> nervatura.RequestDatabaseUpgrade
+	45,  // 113: nervatura.API.Subscribe:input_type -> nervatura.RequestSubscribe
+	37,  // 114: nervatura.API.Export:input_type -> nervatura.RequestExport
+	39,  // 115: nervatura.API.Import:input_type -> nervatura.RequestImport
+	41,  // 116: nervatura.API.BankImport:input_type -> nervatura.RequestBankImport
+	10,  // 117: nervatura.API.UserLogin:output_type -> nervatura.ResponseUserLogin
+	8,   // 118: nervatura.API.UserPassword:output_type -> nervatura.ResponseEmpty
+	14,  // 119: nervatura.API.TokenLogin:output_type -> nervatura.ResponseTokenLogin
+	13,  // 120: nervatura.API.TokenRefresh:output_type -> nervatura.ResponseTokenRefresh
+	12,  // 121: nervatura.API.TokenDecode:output_type -> nervatura.ResponseTokenDecode
+	48,  // 122: nervatura.API.Get:output_type -> nervatura.ResponseGet
+	44,  // 123: nervatura.API.Update:output_type -> nervatura.ResponseUpdate
+	8,   // 124: nervatura.API.Delete:output_type -> nervatura.ResponseEmpty
+	26,  // 125: nervatura.API.View:output_type -> nervatura.ResponseView
+	28,  // 126: nervatura.API.Function:output_type -> nervatura.ResponseFunction
+	29,  // 127: nervatura.API.FunctionList:output_type -> nervatura.ResponseFunctionList
+	36,  // 128: nervatura.API.Report:output_type -> nervatura.ResponseReport
+	31,  // 129: nervatura.API.ReportList:output_type -> nervatura.ResponseReportList
+	33,  // 130: nervatura.API.ReportInstall:output_type -> nervatura.ResponseReportInstall
+	8,   // 131: nervatura.API.ReportDelete:output_type -> nervatura.ResponseEmpty
+	17,  // 132: nervatura.API.DatabaseCreate:output_type -> nervatura.ResponseDatabaseCreate
+	19,  // 133: nervatura.API.DatabaseBackup:output_type -> nervatura.ResponseDatabaseBackup
+	21,  // 134: nervatura.API.DatabaseRestore:output_type -> nervatura.ResponseDatabaseRestore
+	23,  // 135: nervatura.API.DatabaseUpgrade:output_type -> nervatura.ResponseDatabaseUpgrade
+	46,  // 136: nervatura.API.Subscribe:output_type -> nervatura.ResponseEvent
+	38,  // 137: nervatura.API.Export:output_type -> nervatura.ResponseExport
+	40,  // 138: nervatura.API.Import:output_type -> nervatura.ResponseImport
+	42,  // 139: nervatura.API.BankImport:output_type -> nervatura.ResponseBankImport
+	117, // [117:140] is the sub-list for method output_type
+	94,  // [94:117] is the sub-list for method input_type
+	94,  // [94:94] is the sub-list for extension type_name
+	94,  // [94:94] is the sub-list for extension extendee
+	0,   // [0:94] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -8033,8 +9950,152 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseRows); i {
+		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseRows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestEmpty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseEmpty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestUserLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseUserLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestTokenDecode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseTokenDecode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseTokenRefresh); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseTokenLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestUserPassword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDatabaseCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseDatabaseCreate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDatabaseBackup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8045,8 +10106,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestEmpty); i {
+		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseDatabaseBackup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8057,8 +10118,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseEmpty); i {
+		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDatabaseRestore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8069,8 +10130,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestUserLogin); i {
+		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseDatabaseRestore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8081,8 +10142,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseUserLogin); i {
+		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDatabaseUpgrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8093,8 +10154,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestTokenDecode); i {
+		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseDatabaseUpgrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8105,8 +10166,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseTokenDecode); i {
+		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestDelete); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8117,8 +10178,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseTokenRefresh); i {
+		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestView); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8129,8 +10190,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseTokenLogin); i {
+		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseView); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8141,8 +10202,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestUserPassword); i {
+		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestFunction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8153,8 +10214,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestDatabaseCreate); i {
+		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseFunction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8165,8 +10226,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseDatabaseCreate); i {
+		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseFunctionList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8177,8 +10238,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestDelete); i {
+		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReportList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8189,8 +10250,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestView); i {
+		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReportList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8201,8 +10262,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseView); i {
+		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReportInstall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8213,8 +10274,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestFunction); i {
+		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReportInstall); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8225,8 +10286,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseFunction); i {
+		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReportDelete); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8237,8 +10298,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestReportList); i {
+		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8249,8 +10310,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReportList); i {
+		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8261,8 +10322,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestReportInstall); i {
+		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestExport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8273,8 +10334,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReportInstall); i {
+		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseExport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8285,8 +10346,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestReportDelete); i {
+		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestImport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8297,8 +10358,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestReport); i {
+		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseImport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8309,8 +10370,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseReport); i {
+		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestBankImport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8321,7 +10382,19 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseBankImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestUpdate); i {
 			case 0:
 				return &v.state
@@ -8333,7 +10406,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseUpdate); i {
 			case 0:
 				return &v.state
@@ -8345,7 +10418,31 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestSubscribe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestGet); i {
 			case 0:
 				return &v.state
@@ -8357,7 +10454,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseGet); i {
 			case 0:
 				return &v.state
@@ -8369,7 +10466,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetaData); i {
 			case 0:
 				return &v.state
@@ -8381,7 +10478,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Address); i {
 			case 0:
 				return &v.state
@@ -8393,7 +10490,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Barcode); i {
 			case 0:
 				return &v.state
@@ -8405,7 +10502,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Contact); i {
 			case 0:
 				return &v.state
@@ -8417,7 +10514,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Currency); i {
 			case 0:
 				return &v.state
@@ -8429,7 +10526,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Customer); i {
 			case 0:
 				return &v.state
@@ -8441,7 +10538,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Deffield); i {
 			case 0:
 				return &v.state
@@ -8453,7 +10550,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Employee); i {
 			case 0:
 				return &v.state
@@ -8465,7 +10562,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
@@ -8477,7 +10574,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Fieldvalue); i {
 			case 0:
 				return &v.state
@@ -8489,7 +10586,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Groups); i {
 			case 0:
 				return &v.state
@@ -8501,7 +10598,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Item); i {
 			case 0:
 				return &v.state
@@ -8513,7 +10610,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Link); i {
 			case 0:
 				return &v.state
@@ -8525,7 +10622,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
@@ -8537,7 +10634,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Movement); i {
 			case 0:
 				return &v.state
@@ -8549,7 +10646,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Numberdef); i {
 			case 0:
 				return &v.state
@@ -8561,7 +10658,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Pattern); i {
 			case 0:
 				return &v.state
@@ -8573,7 +10670,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Payment); i {
 			case 0:
 				return &v.state
@@ -8585,7 +10682,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Place); i {
 			case 0:
 				return &v.state
@@ -8597,7 +10694,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Price); i {
 			case 0:
 				return &v.state
@@ -8609,7 +10706,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Product); i {
 			case 0:
 				return &v.state
@@ -8621,7 +10718,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Project); i {
 			case 0:
 				return &v.state
@@ -8633,7 +10730,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Rate); i {
 			case 0:
 				return &v.state
@@ -8645,7 +10742,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Tax); i {
 			case 0:
 				return &v.state
@@ -8657,7 +10754,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Tool); i {
 			case 0:
 				return &v.state
@@ -8669,7 +10766,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Trans); i {
 			case 0:
 				return &v.state
@@ -8681,7 +10778,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UiAudit); i {
 			case 0:
 				return &v.state
@@ -8693,7 +10790,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UiMenu); i {
 			case 0:
 				return &v.state
@@ -8705,7 +10802,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UiMenufields); i {
 			case 0:
 				return &v.state
@@ -8717,7 +10814,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UiMessage); i {
 			case 0:
 				return &v.state
@@ -8729,7 +10826,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UiPrintqueue); i {
 			case 0:
 				return &v.state
@@ -8741,7 +10838,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UiReport); i {
 			case 0:
 				return &v.state
@@ -8753,7 +10850,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UiUserconfig); i {
 			case 0:
 				return &v.state
@@ -8765,7 +10862,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseRows_Item); i {
 			case 0:
 				return &v.state
@@ -8777,7 +10874,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestView_Query); i {
 			case 0:
 				return &v.state
@@ -8789,7 +10886,43 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseFunctionList_Arg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseFunctionList_Audit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseFunctionList_Info); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseReportList_Info); i {
 			case 0:
 				return &v.state
@@ -8801,7 +10934,55 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseImport_Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseBankImport_Statement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseBankImport_Match); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseBankImport_Line); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RequestUpdate_Item); i {
 			case 0:
 				return &v.state
@@ -8813,7 +10994,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResponseGet_Value); i {
 			case 0:
 				return &v.state
@@ -8831,23 +11012,23 @@ func file_api_proto_init() {
 		(*Value_Number)(nil),
 		(*Value_Text)(nil),
 	}
-	file_api_proto_msgTypes[35].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[36].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[37].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[38].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[42].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[43].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[47].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[48].OneofWrappers = []interface{}{}
 	file_api_proto_msgTypes[50].OneofWrappers = []interface{}{}
 	file_api_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[52].OneofWrappers = []interface{}{}
 	file_api_proto_msgTypes[53].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[54].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[55].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[59].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[60].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[61].OneofWrappers = []interface{}{}
-	file_api_proto_msgTypes[72].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[57].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[65].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[74].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[75].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[76].OneofWrappers = []interface{}{}
+	file_api_proto_msgTypes[96].OneofWrappers = []interface{}{
 		(*ResponseGet_Value_Address)(nil),
 		(*ResponseGet_Value_Barcode)(nil),
 		(*ResponseGet_Value_Contact)(nil),
@@ -8887,7 +11068,7 @@ func file_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   73,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,6 +38,8 @@ type APIClient interface {
 	View(ctx context.Context, in *RequestView, opts ...grpc.CallOption) (*ResponseView, error)
 	// Call a server-side function
 	Function(ctx context.Context, in *RequestFunction, opts ...grpc.CallOption) (*ResponseFunction, error)
+	// List the registered server-side functions and the function scripts with their argument schema, required object rights and transaction handling
+	FunctionList(ctx context.Context, in *RequestEmpty, opts ...grpc.CallOption) (*ResponseFunctionList, error)
 	// Create and download a Nervatura Report
 	Report(ctx context.Context, in *RequestReport, opts ...grpc.CallOption) (*ResponseReport, error)
 	// List all available Nervatura Report. Admin user group membership required.
@@ -48,6 +50,20 @@ type APIClient interface {
 	ReportDelete(ctx context.Context, in *RequestReportDelete, opts ...grpc.CallOption) (*ResponseEmpty, error)
 	// Create a new Nervatura database
 	DatabaseCreate(ctx context.Context, in *RequestDatabaseCreate, opts ...grpc.CallOption) (*ResponseDatabaseCreate, error)
+	// Write all tables, the password hashtable and the report templates to a gzip compressed, engine neutral archive
+	DatabaseBackup(ctx context.Context, in *RequestDatabaseBackup, opts ...grpc.CallOption) (*ResponseDatabaseBackup, error)
+	// Load a DatabaseBackup archive into a new, empty database (created by the DatabaseCreate)
+	DatabaseRestore(ctx context.Context, in *RequestDatabaseRestore, opts ...grpc.CallOption) (*ResponseDatabaseRestore, error)
+	// Create the missing tables, fields and indexes of the current DataModel in an existing database
+	DatabaseUpgrade(ctx context.Context, in *RequestDatabaseUpgrade, opts ...grpc.CallOption) (*ResponseDatabaseUpgrade, error)
+	// Streams the record change events (insert, update, delete) of the database. Requires NT_EVENT_ENABLED.
+	Subscribe(ctx context.Context, in *RequestSubscribe, opts ...grpc.CallOption) (API_SubscribeClient, error)
+	// Export the records of a nervatype to CSV or JSON Lines format. The reference ids are replaced by public keys.
+	Export(ctx context.Context, in *RequestExport, opts ...grpc.CallOption) (*ResponseExport, error)
+	// Add or update the records of a CSV or JSON Lines file in batches, with a per-row error report
+	Import(ctx context.Context, in *RequestImport, opts ...grpc.CallOption) (*ResponseImport, error)
+	// Import CAMT.053 or MT940 bank statements and match the payments to the open invoices
+	BankImport(ctx context.Context, in *RequestBankImport, opts ...grpc.CallOption) (*ResponseBankImport, error)
 }
 
 type aPIClient struct {
@@ -148,6 +164,15 @@ func (c *aPIClient) Function(ctx context.Context, in *RequestFunction, opts ...g
 	return out, nil
 }
 
+func (c *aPIClient) FunctionList(ctx context.Context, in *RequestEmpty, opts ...grpc.CallOption) (*ResponseFunctionList, error) {
+	out := new(ResponseFunctionList)
+	err := c.cc.Invoke(ctx, "/nervatura.API/FunctionList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) Report(ctx context.Context, in *RequestReport, opts ...grpc.CallOption) (*ResponseReport, error) {
 	out := new(ResponseReport)
 	err := c.cc.Invoke(ctx, "/nervatura.API/Report", in, out, opts...)
@@ -193,6 +218,92 @@ func (c *aPIClient) DatabaseCreate(ctx context.Context, in *RequestDatabaseCreat
 	return out, nil
 }
 
+func (c *aPIClient) DatabaseBackup(ctx context.Context, in *RequestDatabaseBackup, opts ...grpc.CallOption) (*ResponseDatabaseBackup, error) {
+	out := new(ResponseDatabaseBackup)
+	err := c.cc.Invoke(ctx, "/nervatura.API/DatabaseBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DatabaseRestore(ctx context.Context, in *RequestDatabaseRestore, opts ...grpc.CallOption) (*ResponseDatabaseRestore, error) {
+	out := new(ResponseDatabaseRestore)
+	err := c.cc.Invoke(ctx, "/nervatura.API/DatabaseRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DatabaseUpgrade(ctx context.Context, in *RequestDatabaseUpgrade, opts ...grpc.CallOption) (*ResponseDatabaseUpgrade, error) {
+	out := new(ResponseDatabaseUpgrade)
+	err := c.cc.Invoke(ctx, "/nervatura.API/DatabaseUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Subscribe(ctx context.Context, in *RequestSubscribe, opts ...grpc.CallOption) (API_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &API_ServiceDesc.Streams[0], "/nervatura.API/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPISubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_SubscribeClient interface {
+	Recv() (*ResponseEvent, error)
+	grpc.ClientStream
+}
+
+type aPISubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPISubscribeClient) Recv() (*ResponseEvent, error) {
+	m := new(ResponseEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Export(ctx context.Context, in *RequestExport, opts ...grpc.CallOption) (*ResponseExport, error) {
+	out := new(ResponseExport)
+	err := c.cc.Invoke(ctx, "/nervatura.API/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Import(ctx context.Context, in *RequestImport, opts ...grpc.CallOption) (*ResponseImport, error) {
+	out := new(ResponseImport)
+	err := c.cc.Invoke(ctx, "/nervatura.API/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) BankImport(ctx context.Context, in *RequestBankImport, opts ...grpc.CallOption) (*ResponseBankImport, error) {
+	out := new(ResponseBankImport)
+	err := c.cc.Invoke(ctx, "/nervatura.API/BankImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // APIServer is the server API for API service.
 // All implementations must embed UnimplementedAPIServer
 // for forward compatibility
@@ -217,6 +328,8 @@ type APIServer interface {
 	View(context.Context, *RequestView) (*ResponseView, error)
 	// Call a server-side function
 	Function(context.Context, *RequestFunction) (*ResponseFunction, error)
+	// List the registered server-side functions and the function scripts with their argument schema, required object rights and transaction handling
+	FunctionList(context.Context, *RequestEmpty) (*ResponseFunctionList, error)
 	// Create and download a Nervatura Report
 	Report(context.Context, *RequestReport) (*ResponseReport, error)
 	// List all available Nervatura Report. Admin user group membership required.
@@ -227,6 +340,20 @@ type APIServer interface {
 	ReportDelete(context.Context, *RequestReportDelete) (*ResponseEmpty, error)
 	// Create a new Nervatura database
 	DatabaseCreate(context.Context, *RequestDatabaseCreate) (*ResponseDatabaseCreate, error)
+	// Write all tables, the password hashtable and the report templates to a gzip compressed, engine neutral archive
+	DatabaseBackup(context.Context, *RequestDatabaseBackup) (*ResponseDatabaseBackup, error)
+	// Load a DatabaseBackup archive into a new, empty database (created by the DatabaseCreate)
+	DatabaseRestore(context.Context, *RequestDatabaseRestore) (*ResponseDatabaseRestore, error)
+	// Create the missing tables, fields and indexes of the current DataModel in an existing database
+	DatabaseUpgrade(context.Context, *RequestDatabaseUpgrade) (*ResponseDatabaseUpgrade, error)
+	// Streams the record change events (insert, update, delete) of the database. Requires NT_EVENT_ENABLED.
+	Subscribe(*RequestSubscribe, API_SubscribeServer) error
+	// Export the records of a nervatype to CSV or JSON Lines format. The reference ids are replaced by public keys.
+	Export(context.Context, *RequestExport) (*ResponseExport, error)
+	// Add or update the records of a CSV or JSON Lines file in batches, with a per-row error report
+	Import(context.Context, *RequestImport) (*ResponseImport, error)
+	// Import CAMT.053 or MT940 bank statements and match the payments to the open invoices
+	BankImport(context.Context, *RequestBankImport) (*ResponseBankImport, error)
 	mustEmbedUnimplementedAPIServer()
 }
 
@@ -264,6 +391,9 @@ func (UnimplementedAPIServer) View(context.Context, *RequestView) (*ResponseView
 func (UnimplementedAPIServer) Function(context.Context, *RequestFunction) (*ResponseFunction, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Function not implemented")
 }
+func (UnimplementedAPIServer) FunctionList(context.Context, *RequestEmpty) (*ResponseFunctionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunctionList not implemented")
+}
 func (UnimplementedAPIServer) Report(context.Context, *RequestReport) (*ResponseReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
 }
@@ -279,6 +409,27 @@ func (UnimplementedAPIServer) ReportDelete(context.Context, *RequestReportDelete
 func (UnimplementedAPIServer) DatabaseCreate(context.Context, *RequestDatabaseCreate) (*ResponseDatabaseCreate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DatabaseCreate not implemented")
 }
+func (UnimplementedAPIServer) DatabaseBackup(context.Context, *RequestDatabaseBackup) (*ResponseDatabaseBackup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseBackup not implemented")
+}
+func (UnimplementedAPIServer) DatabaseRestore(context.Context, *RequestDatabaseRestore) (*ResponseDatabaseRestore, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseRestore not implemented")
+}
+func (UnimplementedAPIServer) DatabaseUpgrade(context.Context, *RequestDatabaseUpgrade) (*ResponseDatabaseUpgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatabaseUpgrade not implemented")
+}
+func (UnimplementedAPIServer) Subscribe(*RequestSubscribe, API_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedAPIServer) Export(context.Context, *RequestExport) (*ResponseExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedAPIServer) Import(context.Context, *RequestImport) (*ResponseImport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedAPIServer) BankImport(context.Context, *RequestBankImport) (*ResponseBankImport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BankImport not implemented")
+}
 func (UnimplementedAPIServer) mustEmbedUnimplementedAPIServer() {}
 
 // UnsafeAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -472,6 +623,24 @@ func _API_Function_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_FunctionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).FunctionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nervatura.API/FunctionList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FunctionList(ctx, req.(*RequestEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RequestReport)
 	if err := dec(in); err != nil {
@@ -562,6 +731,135 @@ func _API_DatabaseCreate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_DatabaseBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDatabaseBackup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DatabaseBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nervatura.API/DatabaseBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DatabaseBackup(ctx, req.(*RequestDatabaseBackup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DatabaseRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDatabaseRestore)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DatabaseRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nervatura.API/DatabaseRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DatabaseRestore(ctx, req.(*RequestDatabaseRestore))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DatabaseUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDatabaseUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DatabaseUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nervatura.API/DatabaseUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DatabaseUpgrade(ctx, req.(*RequestDatabaseUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RequestSubscribe)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).Subscribe(m, &aPISubscribeServer{stream})
+}
+
+type API_SubscribeServer interface {
+	Send(*ResponseEvent) error
+	grpc.ServerStream
+}
+
+type aPISubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISubscribeServer) Send(m *ResponseEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestExport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nervatura.API/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Export(ctx, req.(*RequestExport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nervatura.API/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Import(ctx, req.(*RequestImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_BankImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestBankImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).BankImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nervatura.API/BankImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).BankImport(ctx, req.(*RequestBankImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // API_ServiceDesc is the grpc.ServiceDesc for API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -609,6 +907,10 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Function",
 			Handler:    _API_Function_Handler,
 		},
+		{
+			MethodName: "FunctionList",
+			Handler:    _API_FunctionList_Handler,
+		},
 		{
 			MethodName: "Report",
 			Handler:    _API_Report_Handler,
@@ -629,7 +931,37 @@ var API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DatabaseCreate",
 			Handler:    _API_DatabaseCreate_Handler,
 		},
+		{
+			MethodName: "DatabaseBackup",
+			Handler:    _API_DatabaseBackup_Handler,
+		},
+		{
+			MethodName: "DatabaseRestore",
+			Handler:    _API_DatabaseRestore_Handler,
+		},
+		{
+			MethodName: "DatabaseUpgrade",
+			Handler:    _API_DatabaseUpgrade_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _API_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _API_Import_Handler,
+		},
+		{
+			MethodName: "BankImport",
+			Handler:    _API_BankImport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _API_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
 }
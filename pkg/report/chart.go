@@ -0,0 +1,306 @@
+package report
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+// the default series colors
+var chartPalette = []color.RGBA{
+	{78, 121, 167, 0}, {242, 142, 43, 0}, {225, 87, 89, 0}, {118, 183, 178, 0}, {89, 161, 79, 0},
+	{237, 201, 72, 0}, {176, 122, 161, 0}, {255, 157, 167, 0}, {156, 117, 95, 0}, {186, 176, 172, 0},
+}
+
+// chartScale returns the axis range and the tick step of the values (the range includes zero)
+func chartScale(minValue, maxValue float64) (float64, float64, float64) {
+	minValue, maxValue = math.Min(minValue, 0), math.Max(maxValue, 0)
+	if maxValue == minValue {
+		maxValue = minValue + 1
+	}
+	raw := (maxValue - minValue) / 5
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	step := 10 * magnitude
+	for _, factor := range []float64{1, 2, 2.5, 5} {
+		if raw <= factor*magnitude {
+			step = factor * magnitude
+			break
+		}
+	}
+	return math.Floor(minValue/step) * step, math.Ceil(maxValue/step) * step, step
+}
+
+// chartDigits returns the decimal places of the axis labels
+func chartDigits(step float64) int {
+	digits := 0
+	for value := step; math.Abs(value-math.Round(value)) > 1e-9 && digits < 6; value *= 10 {
+		digits++
+	}
+	return digits
+}
+
+// chartLighten mixes the color with white
+func chartLighten(value color.RGBA, ratio float64) color.RGBA {
+	mix := func(c uint8) uint8 {
+		return uint8(float64(c) + (255-float64(c))*ratio)
+	}
+	return color.RGBA{mix(value.R), mix(value.G), mix(value.B), value.A}
+}
+
+func (rpt *Report) chartColors(v *Chart) []color.RGBA {
+	colors := make([]color.RGBA, 0)
+	for _, value := range strings.Split(v.Colors, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			colors = append(colors, ut.ToRGBA(value, chartPalette[len(colors)%len(chartPalette)]))
+		}
+	}
+	return append(colors, chartPalette...)
+}
+
+func (rpt *Report) chartText(text string, x, y, width, height float64, align string) {
+	rpt.pdf.SetXY(x, y)
+	rpt.pdf.Cell(IM{
+		"w": width, "h": height, "padding": float64(0), "txtStr": text,
+		"borderStr": "", "alignStr": align, "fill": false, "ln": false})
+}
+
+func (rpt *Report) chartFill(value color.RGBA) {
+	rpt.pdf.SetFillColor(int(value.R), int(value.G), int(value.B))
+}
+
+func (rpt *Report) chartDraw(value color.RGBA) {
+	rpt.pdf.SetDrawColor(int(value.R), int(value.G), int(value.B))
+}
+
+// chartLegend prints the colored legend items from the y position and returns the legend height
+func (rpt *Report) chartLegend(items []string, colors []color.RGBA, x, y, width float64, virtual bool) float64 {
+	if len(items) == 0 {
+		return 0
+	}
+	lineHt := rpt.pdf.GetFontSize() + _padding
+	box := rpt.pdf.GetFontSize() * 0.8
+	cx, cy := x, y
+	for index, item := range items {
+		itemWidth := box + rpt.pdf.GetTextWidth(item) + 3*_padding
+		if cx > x && cx+itemWidth > x+width {
+			cx, cy = x, cy+lineHt
+		}
+		if !virtual {
+			rpt.chartFill(colors[index%len(colors)])
+			rpt.pdf.Rect(cx, cy+(lineHt-box)/2, box, box, "F")
+			rpt.chartText(item, cx+box+_padding, cy, itemWidth-box-_padding, lineHt, "L")
+		}
+		cx += itemWidth
+	}
+	return cy + lineHt - y
+}
+
+// chartAxis draws a bar, line or stacked bar chart into the area
+func (rpt *Report) chartAxis(v *Chart, chartType string, values [][]float64, labels []string, colors []color.RGBA,
+	x, top, width, bottom float64) {
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	for index := range labels {
+		pos, neg := float64(0), float64(0)
+		for si := range values {
+			value := values[si][index]
+			if chartType == "stacked" {
+				if value > 0 {
+					pos += value
+				} else {
+					neg += value
+				}
+				minValue, maxValue = math.Min(minValue, neg), math.Max(maxValue, pos)
+			} else {
+				minValue, maxValue = math.Min(minValue, value), math.Max(maxValue, value)
+			}
+		}
+	}
+	lo, hi, step := chartScale(minValue, maxValue)
+	digits := chartDigits(step)
+
+	lineHt := rpt.pdf.GetFontSize() + _padding
+	ticks, axisWidth := make([]float64, 0), float64(0)
+	for tick := lo; tick <= hi+step/2; tick += step {
+		ticks = append(ticks, tick)
+		axisWidth = math.Max(axisWidth, rpt.pdf.GetTextWidth(strconv.FormatFloat(tick, 'f', digits, 64)))
+	}
+	px, py := x+axisWidth+_padding, top+lineHt/2
+	pw, ph := x+width-px, bottom-lineHt-_padding-py
+	if pw <= 0 || ph <= 0 {
+		return
+	}
+	yPos := func(value float64) float64 {
+		return py + ph - (value-lo)/(hi-lo)*ph
+	}
+
+	rpt.chartDraw(chartLighten(v.BorderColor, 0.8))
+	for _, tick := range ticks {
+		rpt.pdf.Line(px, yPos(tick), px+pw, yPos(tick))
+		rpt.chartText(strconv.FormatFloat(tick, 'f', digits, 64), x, yPos(tick)-lineHt/2, axisWidth, lineHt, "R")
+	}
+
+	groupWidth := pw / float64(len(labels))
+	labelStep, labelWidth := 1, float64(0)
+	for _, label := range labels {
+		labelWidth = math.Max(labelWidth, rpt.pdf.GetTextWidth(label)+_padding)
+	}
+	for labelWidth > groupWidth*float64(labelStep) && labelStep < len(labels) {
+		labelStep++
+	}
+	for index, label := range labels {
+		center := px + (float64(index)+0.5)*groupWidth
+		if index%labelStep == 0 {
+			rpt.chartText(label, center-groupWidth*float64(labelStep)/2, py+ph+_padding, groupWidth*float64(labelStep), lineHt, "C")
+		}
+		switch chartType {
+		case "line":
+			for si := range values {
+				rpt.chartFill(colors[si%len(colors)])
+				rpt.pdf.Rect(center-1.5, yPos(values[si][index])-1.5, 3, 3, "F")
+				if index > 0 {
+					rpt.chartDraw(colors[si%len(colors)])
+					rpt.pdf.Line(center-groupWidth, yPos(values[si][index-1]), center, yPos(values[si][index]))
+				}
+			}
+		case "stacked":
+			pos, neg := float64(0), float64(0)
+			for si := range values {
+				value := values[si][index]
+				rpt.chartFill(colors[si%len(colors)])
+				if value > 0 {
+					rpt.pdf.Rect(center-groupWidth*0.3, yPos(pos+value), groupWidth*0.6, yPos(pos)-yPos(pos+value), "F")
+					pos += value
+				} else if value < 0 {
+					rpt.pdf.Rect(center-groupWidth*0.3, yPos(neg), groupWidth*0.6, yPos(neg+value)-yPos(neg), "F")
+					neg += value
+				}
+			}
+		default:
+			barWidth := groupWidth * 0.8 / float64(len(values))
+			for si := range values {
+				value := values[si][index]
+				rpt.chartFill(colors[si%len(colors)])
+				rpt.pdf.Rect(center-groupWidth*0.4+float64(si)*barWidth, yPos(math.Max(value, 0)),
+					barWidth, math.Abs(yPos(value)-yPos(0)), "F")
+			}
+		}
+	}
+
+	rpt.chartDraw(v.BorderColor)
+	rpt.pdf.Line(px, py, px, py+ph)
+	rpt.pdf.Line(px, yPos(0), px+pw, yPos(0))
+}
+
+// chartPie draws a pie chart of the positive values into the area.
+// The sectors are filled with radial lines.
+func (rpt *Report) chartPie(values []float64, colors []color.RGBA, x, top, width, bottom float64) {
+	total := float64(0)
+	for _, value := range values {
+		total += math.Max(value, 0)
+	}
+	radius := math.Min(width, bottom-top)/2 - _padding
+	if total == 0 || radius <= 0 {
+		return
+	}
+	cx, cy := x+width/2, top+(bottom-top)/2
+	step := 0.6 / radius
+	angle, bounds := -math.Pi/2, make([]float64, 0)
+	for index, value := range values {
+		if value <= 0 {
+			continue
+		}
+		sweep := value / total * 2 * math.Pi
+		rpt.chartDraw(colors[index%len(colors)])
+		for a := angle; a < angle+sweep; a += step {
+			rpt.pdf.Line(cx, cy, cx+radius*math.Cos(a), cy+radius*math.Sin(a))
+		}
+		bounds = append(bounds, angle)
+		angle += sweep
+	}
+	if len(bounds) > 1 {
+		rpt.chartDraw(color.RGBA{255, 255, 255, 0})
+		for _, a := range bounds {
+			rpt.pdf.Line(cx, cy, cx+radius*math.Cos(a), cy+radius*math.Sin(a))
+		}
+	}
+}
+
+// createChart - a chart element with the title, the plot area and the legend
+func (rpt *Report) createChart(v *Chart) {
+	rows, valid := rpt.data[v.Databind].([]SM)
+	series := make([]*Column, 0)
+	for _, item := range rpt.visibleItems(v.Columns) {
+		if column, valid := item.Item.(*Column); valid {
+			series = append(series, column)
+		}
+	}
+	if !valid || len(rows) == 0 || len(series) == 0 {
+		return
+	}
+	pageWidth, _ := rpt.pdf.GetPageSize()
+	width := pageWidth - rpt.RightMargin - rpt.LeftMargin
+	if widthStr := ut.ToString(v.Width, "100%"); strings.HasSuffix(widthStr, "%") {
+		width = width * ut.ToFloat(strings.Replace(widthStr, "%", "", -1), 100) / 100
+	} else {
+		width = math.Min(ut.ToFloat(widthStr, width), width)
+	}
+	height := v.Height
+	if height == 0 {
+		height = 60 * _mmPt
+	}
+	if rpt.checkPageBreak(height) {
+		rpt.addPage()
+	}
+	x, y := rpt.LeftMargin, rpt.pdf.GetY()
+	rpt.setPageStyle(IM{"fontStyle": "B", "fontSize": v.FontSize, "textColor": v.TextColor, "borderColor": v.BorderColor})
+	lineHt := rpt.pdf.GetFontSize() + _padding
+	top := y
+	if title := rpt.setValue(v.Title); title != "" {
+		rpt.chartText(title, x, top, width, lineHt, "C")
+		top += lineHt
+	}
+	rpt.setPageStyle(IM{"fontStyle": "", "fontSize": v.FontSize})
+
+	chartType := strings.ToLower(v.ChartType)
+	if strings.HasPrefix(chartType, "stacked") {
+		chartType = "stacked"
+	}
+	labels, values := make([]string, 0), make([][]float64, len(series))
+	for index, row := range rows {
+		labels = append(labels, ut.ToString(row[v.Label], strconv.Itoa(index+1)))
+		for si, column := range series {
+			values[si] = append(values[si], gridNumber(row[column.Fieldname]))
+		}
+	}
+	colors := rpt.chartColors(v)
+	legend := make([]string, 0)
+	if chartType == "pie" {
+		total := float64(0)
+		for _, value := range values[0] {
+			total += math.Max(value, 0)
+		}
+		for index, label := range labels {
+			if total > 0 {
+				label += " (" + strconv.FormatFloat(math.Max(values[0][index], 0)/total*100, 'f', 1, 64) + "%)"
+			}
+			legend = append(legend, label)
+		}
+	} else if len(series) > 1 {
+		for _, column := range series {
+			legend = append(legend, ut.ToString(rpt.setValue(column.Label), column.Fieldname))
+		}
+	}
+	bottom := y + height - rpt.chartLegend(legend, colors, x, y, width, true)
+
+	if chartType == "pie" {
+		rpt.chartPie(values[0], colors, x, top, width, bottom)
+	} else {
+		rpt.chartAxis(v, chartType, values, labels, colors, x, top, width, bottom)
+	}
+	rpt.chartLegend(legend, colors, x, bottom, width, false)
+	rpt.setPageStyle(IM{"textColor": rpt.TextColor, "borderColor": rpt.BorderColor, "backgroundColor": rpt.BackgroundColor})
+	rpt.pdf.SetXY(rpt.LeftMargin, y+height)
+}
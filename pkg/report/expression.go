@@ -0,0 +1,526 @@
+package report
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+/*
+Template expressions
+
+An element with an "if" attribute is rendered only when the expression is true, and a
+"={{= expression}}" value is replaced by the result of the expression. Example:
+
+	<row if="head.notax == 1"> ... </row>
+	{"cell": {"value": "={{= number(head.netamount * 1.27, 2, 'hu')}} HUF"}}
+
+Operands: numbers, 'text' or "text" strings, true, false, page (the current page number) and
+the databind values (e.g. head.notax, labels.vat, items.0.amount).
+Operators: + - * / % == != < <= > >= && || ! (and, or, not) and the cond ? a : b conditional.
+The + concatenates the non-numeric values, the comparison of two numeric values is numeric.
+Functions: if, round, abs, number, date, upper, lower, trim, len, substr, replace, concat,
+empty, coalesce, count, sum.
+*/
+
+var exprLocales = map[string][]string{
+	// thousands separator, decimal separator, date layout
+	"en": {",", ".", "01/02/2006"}, "de": {".", ",", "02.01.2006"}, "nl": {".", ",", "02-01-2006"},
+	"es": {".", ",", "02/01/2006"}, "it": {".", ",", "02/01/2006"}, "pt": {".", ",", "02/01/2006"},
+	"da": {".", ",", "02.01.2006"}, "id": {".", ",", "02/01/2006"}, "tr": {".", ",", "02.01.2006"},
+	"fr": {" ", ",", "02/01/2006"}, "hu": {" ", ",", "2006.01.02."}, "cs": {" ", ",", "02.01.2006"},
+	"sk": {" ", ",", "02.01.2006"}, "pl": {" ", ",", "02.01.2006"}, "ru": {" ", ",", "02.01.2006"},
+	"uk": {" ", ",", "02.01.2006"}, "fi": {" ", ",", "02.01.2006"}, "no": {" ", ",", "02.01.2006"},
+	"nb": {" ", ",", "02.01.2006"}, "sv": {" ", ",", "2006-01-02"}, "ch": {"'", ".", "02.01.2006"},
+}
+
+var exprDateTokens = strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02",
+	"hh", "15", "mm", "04", "ss", "05")
+
+type exprToken struct {
+	kind  byte // 'n' number, 's' string, 'i' identifier, 'o' operator or punctuation
+	value string
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+	lookup func(name string) interface{}
+}
+
+// exprTokens splits an expression to number, string, identifier and operator tokens
+func exprTokens(expr string) ([]exprToken, error) {
+	tokens := make([]exprToken, 0)
+	for pos := 0; pos < len(expr); {
+		ch := expr[pos]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			pos++
+		case ch >= '0' && ch <= '9' || (ch == '.' && pos+1 < len(expr) && expr[pos+1] >= '0' && expr[pos+1] <= '9'):
+			start := pos
+			for pos < len(expr) && (expr[pos] >= '0' && expr[pos] <= '9' || expr[pos] == '.') {
+				pos++
+			}
+			tokens = append(tokens, exprToken{kind: 'n', value: expr[start:pos]})
+		case ch == '\'' || ch == '"':
+			end := strings.IndexByte(expr[pos+1:], ch)
+			if end == -1 {
+				return nil, errors.New("unterminated string in expression")
+			}
+			tokens = append(tokens, exprToken{kind: 's', value: expr[pos+1 : pos+1+end]})
+			pos += end + 2
+		case ch == '_' || unicode.IsLetter(rune(ch)) || ch >= utf8.RuneSelf:
+			start := pos
+			for pos < len(expr) {
+				r, size := utf8.DecodeRuneInString(expr[pos:])
+				if r != '_' && r != '.' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				pos += size
+			}
+			if start == pos {
+				return nil, fmt.Errorf("invalid character in expression: %q", expr[pos:pos+1])
+			}
+			tokens = append(tokens, exprToken{kind: 'i', value: expr[start:pos]})
+		default:
+			op := expr[pos : pos+1]
+			if pos+1 < len(expr) && ut.Contains([]string{"==", "!=", "<=", ">=", "&&", "||"}, expr[pos:pos+2]) {
+				op = expr[pos : pos+2]
+			}
+			if !strings.Contains("+-*/%=!<>()?:,", op[:1]) || op == "=" {
+				return nil, fmt.Errorf("invalid operator in expression: %s", op)
+			}
+			tokens = append(tokens, exprToken{kind: 'o', value: op})
+			pos += len(op)
+		}
+	}
+	return tokens, nil
+}
+
+// evalExpression evaluates an expression with the lookup function of the identifier values.
+// The result type is string, float64 or bool.
+func evalExpression(expr string, lookup func(name string) interface{}) (interface{}, error) {
+	tokens, err := exprTokens(expr)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return "", nil
+	}
+	parser := &exprParser{tokens: tokens, lookup: lookup}
+	result, err := parser.ternary()
+	if err == nil && parser.pos < len(parser.tokens) {
+		err = fmt.Errorf("unexpected token in expression: %s", parser.tokens[parser.pos].value)
+	}
+	return result, err
+}
+
+func (p *exprParser) peek(ops ...string) string {
+	if p.pos < len(p.tokens) {
+		token := p.tokens[p.pos]
+		if (token.kind == 'o' || token.kind == 'i') && ut.Contains(ops, token.value) {
+			return token.value
+		}
+	}
+	return ""
+}
+
+func (p *exprParser) expect(op string) error {
+	if p.peek(op) == "" {
+		return fmt.Errorf("missing %s in expression", op)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *exprParser) ternary() (interface{}, error) {
+	cond, err := p.binary(0)
+	if err != nil || p.peek("?") == "" {
+		return cond, err
+	}
+	p.pos++
+	valueTrue, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(":"); err != nil {
+		return nil, err
+	}
+	valueFalse, err := p.ternary()
+	if err != nil {
+		return nil, err
+	}
+	if exprBool(cond) {
+		return valueTrue, nil
+	}
+	return valueFalse, nil
+}
+
+// the binary operators by precedence level (lowest first)
+var exprLevels = [][]string{
+	{"||", "or"}, {"&&", "and"}, {"==", "!="}, {"<", "<=", ">", ">="}, {"+", "-"}, {"*", "/", "%"},
+}
+
+func (p *exprParser) binary(level int) (interface{}, error) {
+	if level == len(exprLevels) {
+		return p.unary()
+	}
+	left, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for op := p.peek(exprLevels[level]...); op != ""; op = p.peek(exprLevels[level]...) {
+		p.pos++
+		right, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = exprOperate(op, left, right)
+	}
+	return left, nil
+}
+
+func (p *exprParser) unary() (interface{}, error) {
+	if op := p.peek("-", "!", "not"); op != "" {
+		p.pos++
+		value, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		if op == "-" {
+			return -exprNumber(value), nil
+		}
+		return !exprBool(value), nil
+	}
+	return p.primary()
+}
+
+func (p *exprParser) primary() (interface{}, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, errors.New("unexpected end of expression")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case 'n':
+		return ut.ToFloat(token.value, 0), nil
+	case 's':
+		return token.value, nil
+	case 'i':
+		if p.peek("(") != "" {
+			p.pos++
+			args := make([]interface{}, 0)
+			for p.peek(")") == "" {
+				if len(args) > 0 {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+				arg, err := p.ternary()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+			}
+			p.pos++
+			return p.call(token.value, args)
+		}
+		switch token.value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+		return p.lookup(token.value), nil
+	}
+	if token.value == "(" {
+		value, err := p.ternary()
+		if err != nil {
+			return nil, err
+		}
+		return value, p.expect(")")
+	}
+	return nil, fmt.Errorf("unexpected token in expression: %s", token.value)
+}
+
+func (p *exprParser) call(name string, args []interface{}) (interface{}, error) {
+	arg := func(index int) interface{} {
+		if index < len(args) {
+			return args[index]
+		}
+		return ""
+	}
+	argCount := map[string][]int{
+		"if": {3, 3}, "round": {1, 2}, "abs": {1, 1}, "number": {1, 3}, "date": {1, 2},
+		"upper": {1, 1}, "lower": {1, 1}, "trim": {1, 1}, "len": {1, 1}, "substr": {2, 3}, "replace": {3, 3},
+		"concat": {0, -1}, "empty": {1, 1}, "coalesce": {1, -1}, "count": {1, 1}, "sum": {2, 2},
+	}
+	limits, found := argCount[name]
+	if !found {
+		return nil, fmt.Errorf("unknown function in expression: %s", name)
+	}
+	if len(args) < limits[0] || (limits[1] > -1 && len(args) > limits[1]) {
+		return nil, fmt.Errorf("invalid number of arguments: %s", name)
+	}
+	switch name {
+	case "if":
+		if exprBool(arg(0)) {
+			return arg(1), nil
+		}
+		return arg(2), nil
+	case "round":
+		scale := math.Pow(10, float64(ut.ToInteger(exprString(arg(1)), 0)))
+		return math.Round(exprNumber(arg(0))*scale) / scale, nil
+	case "abs":
+		return math.Abs(exprNumber(arg(0))), nil
+	case "number":
+		return exprFormatNumber(exprNumber(arg(0)), int(ut.ToInteger(exprString(arg(1)), -1)), exprString(arg(2))), nil
+	case "date":
+		return exprFormatDate(exprString(arg(0)), exprString(arg(1))), nil
+	case "upper":
+		return strings.ToUpper(exprString(arg(0))), nil
+	case "lower":
+		return strings.ToLower(exprString(arg(0))), nil
+	case "trim":
+		return strings.TrimSpace(exprString(arg(0))), nil
+	case "len":
+		return float64(utf8.RuneCountInString(exprString(arg(0)))), nil
+	case "substr":
+		runes := []rune(exprString(arg(0)))
+		start := int(math.Max(0, math.Min(exprNumber(arg(1)), float64(len(runes)))))
+		end := len(runes)
+		if len(args) > 2 {
+			end = int(math.Max(float64(start), math.Min(float64(start)+exprNumber(arg(2)), float64(len(runes)))))
+		}
+		return string(runes[start:end]), nil
+	case "replace":
+		return strings.ReplaceAll(exprString(arg(0)), exprString(arg(1)), exprString(arg(2))), nil
+	case "concat":
+		var sb strings.Builder
+		for index := range args {
+			sb.WriteString(exprString(args[index]))
+		}
+		return sb.String(), nil
+	case "empty":
+		return strings.TrimSpace(exprString(arg(0))) == "", nil
+	case "coalesce":
+		for index := range args {
+			if strings.TrimSpace(exprString(args[index])) != "" {
+				return args[index], nil
+			}
+		}
+		return "", nil
+	}
+	// count and sum: the list name is a string value, e.g. sum('items', 'amount')
+	rows, _ := p.lookup("[]" + exprString(arg(0))).([]SM)
+	if name == "count" {
+		return float64(len(rows)), nil
+	}
+	total := float64(0)
+	for _, row := range rows {
+		total += exprNumber(row[exprString(arg(1))])
+	}
+	return total, nil
+}
+
+func exprOperate(op string, left, right interface{}) interface{} {
+	switch op {
+	case "||", "or":
+		return exprBool(left) || exprBool(right)
+	case "&&", "and":
+		return exprBool(left) && exprBool(right)
+	case "==", "!=", "<", "<=", ">", ">=":
+		cmp := 0
+		if exprIsNumber(left) && exprIsNumber(right) {
+			if ln, rn := exprNumber(left), exprNumber(right); ln < rn {
+				cmp = -1
+			} else if ln > rn {
+				cmp = 1
+			}
+		} else {
+			cmp = strings.Compare(exprString(left), exprString(right))
+		}
+		return map[string]bool{"==": cmp == 0, "!=": cmp != 0, "<": cmp < 0, "<=": cmp <= 0,
+			">": cmp > 0, ">=": cmp >= 0}[op]
+	case "+":
+		if !exprIsNumber(left) || !exprIsNumber(right) {
+			return exprString(left) + exprString(right)
+		}
+		return exprNumber(left) + exprNumber(right)
+	case "-":
+		return exprNumber(left) - exprNumber(right)
+	case "*":
+		return exprNumber(left) * exprNumber(right)
+	}
+	// the division by zero result is 0
+	if exprNumber(right) == 0 {
+		return float64(0)
+	}
+	if op == "%" {
+		return math.Mod(exprNumber(left), exprNumber(right))
+	}
+	return exprNumber(left) / exprNumber(right)
+}
+
+func exprIsNumber(value interface{}) bool {
+	switch v := value.(type) {
+	case float64:
+		return true
+	case string:
+		_, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(v), " ", ""), 64)
+		return err == nil
+	}
+	return false
+}
+
+func exprNumber(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	}
+	return gridNumber(exprString(value))
+}
+
+func exprString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func exprBool(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	}
+	svalue := strings.TrimSpace(exprString(value))
+	if exprIsNumber(svalue) {
+		return exprNumber(svalue) != 0
+	}
+	return svalue != "" && strings.ToLower(svalue) != "false"
+}
+
+func exprLocale(locale string) []string {
+	locale = strings.ToLower(strings.SplitN(strings.ReplaceAll(locale, "_", "-"), "-", 2)[0])
+	if values, found := exprLocales[locale]; found {
+		return values
+	}
+	return nil
+}
+
+// exprFormatNumber formats a number with the digits (-1: the shortest representation)
+// and the thousands and decimal separators of the locale
+func exprFormatNumber(value float64, digits int, locale string) string {
+	svalue := strconv.FormatFloat(value, 'f', digits, 64)
+	separators := exprLocale(locale)
+	if separators == nil {
+		return svalue
+	}
+	sign := ""
+	if strings.HasPrefix(svalue, "-") {
+		sign, svalue = "-", svalue[1:]
+	}
+	intPart, decPart := svalue, ""
+	if index := strings.Index(svalue, "."); index > -1 {
+		intPart, decPart = svalue[:index], separators[1]+svalue[index+1:]
+	}
+	for index := len(intPart) - 3; index > 0; index -= 3 {
+		intPart = intPart[:index] + separators[0] + intPart[index:]
+	}
+	return sign + intPart + decPart
+}
+
+// exprFormatDate formats a date value with the date layout of a locale or
+// with a YYYY, YY, MM, DD, hh, mm, ss pattern (e.g. 'DD.MM.YYYY hh:mm')
+func exprFormatDate(value, format string) string {
+	tm, err := ut.StringToDateTime(strings.TrimSpace(value))
+	if err != nil {
+		return value
+	}
+	if format == "" {
+		format = "YYYY-MM-DD"
+	}
+	if separators := exprLocale(format); separators != nil {
+		return tm.Format(separators[2])
+	}
+	return tm.Format(exprDateTokens.Replace(format))
+}
+
+// exprValue returns the value of an identifier: the current page number or a databind value
+func (rpt *Report) exprValue(name string) interface{} {
+	if strings.HasPrefix(name, "[]") {
+		rows, _ := rpt.data[strings.TrimPrefix(name, "[]")].([]SM)
+		return rows
+	}
+	if name == "page" {
+		return float64(rpt.pdf.PageNo())
+	}
+	if value, found := rpt.dataValue(name); found {
+		return value
+	}
+	return ""
+}
+
+// evalValue returns the string result of a template expression. The result of an invalid expression is empty.
+func (rpt *Report) evalValue(expr string) string {
+	result, err := evalExpression(expr, rpt.exprValue)
+	if err != nil {
+		return ""
+	}
+	return exprString(result)
+}
+
+// itemVisible - the if expression of the element and the Visible data source of the row
+func (rpt *Report) itemVisible(pi PageItem) bool {
+	if pi.If != "" {
+		if result, err := evalExpression(pi.If, rpt.exprValue); err != nil || !exprBool(result) {
+			return false
+		}
+	}
+	if row, valid := pi.Item.(*Row); valid {
+		return rpt.rowVisible(row)
+	}
+	return true
+}
+
+// visibleItems returns the visible elements of a row or a datagrid
+func (rpt *Report) visibleItems(items []PageItem) []PageItem {
+	result := make([]PageItem, 0)
+	for _, item := range items {
+		if rpt.itemVisible(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// checkExpression - the syntax check of an expression
+func checkExpression(expr string) error {
+	_, err := evalExpression(expr, func(name string) interface{} { return "" })
+	return err
+}
+
+// visibleGrid returns a copy of the datagrid with the visible columns
+func (rpt *Report) visibleGrid(grid *Datagrid) *Datagrid {
+	vgrid := *grid
+	vgrid.Columns = rpt.visibleItems(grid.Columns)
+	return &vgrid
+}
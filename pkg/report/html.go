@@ -0,0 +1,412 @@
+package report
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"image/color"
+	"image/png"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+const _htmlStyle = `*{box-sizing:border-box}
+body{margin:0;background:#e6e6e6}
+.report{margin:12pt auto;background:#ffffff;box-shadow:0 0 6pt rgba(0,0,0,.25)}
+.row{display:flex;align-items:stretch}
+.cell{flex:0 0 auto;padding:0 3pt;overflow-wrap:anywhere}
+.cell.extend{flex:1 1 auto}
+.multiline{white-space:pre-line}
+.image img,.barcode img{display:block}
+.barcode{margin:0;text-align:center}
+.barcode img{image-rendering:pixelated}
+.separator{flex:0 0 auto;border-left:1px solid currentColor}
+.datagrid{border-collapse:collapse;margin:0}
+.datagrid th,.datagrid td{padding:3pt;vertical-align:top}
+.datagrid thead{display:table-header-group}
+.datagrid tfoot{display:table-footer-group}
+.html{padding:3pt 0}
+hr{border:0;margin:0}
+@media print{
+body{background:none}
+.report{margin:0;box-shadow:none;padding:0 !important}
+.datagrid tr,.row{break-inside:avoid;page-break-inside:avoid}
+}`
+
+// htmlColor - CSS hex color value
+func htmlColor(value color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", value.R, value.G, value.B)
+}
+
+// htmlAlign - CSS text-align value of the template align values
+func htmlAlign(align string) string {
+	if value := xlsxAlign(align); value != "" {
+		return value
+	}
+	return "left"
+}
+
+// htmlWidth - CSS width value of a number (pt) or percent template value
+func htmlWidth(width string, padding float64) string {
+	if strings.HasSuffix(width, "%") {
+		if value := ut.ToFloat(strings.TrimSuffix(width, "%"), 0); value > 0 {
+			return strconv.FormatFloat(value, 'f', -1, 64) + "%"
+		}
+		return ""
+	}
+	if value := ut.ToFloat(width, 0); value > 0 {
+		return htmlPt(value + padding)
+	}
+	return ""
+}
+
+func htmlPt(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64) + "pt"
+}
+
+// htmlBorder - CSS border declarations of the template border values ("1" or some of "LTRB")
+func htmlBorder(border string, borderColor color.RGBA) string {
+	sides := map[string]string{"L": "left", "T": "top", "R": "right", "B": "bottom"}
+	style := ""
+	if border == "1" {
+		return "border:1px solid " + htmlColor(borderColor) + ";"
+	}
+	for _, side := range []string{"L", "T", "R", "B"} {
+		if strings.Contains(strings.ToUpper(border), side) {
+			style += "border-" + sides[side] + ":1px solid " + htmlColor(borderColor) + ";"
+		}
+	}
+	return style
+}
+
+// htmlFont - CSS font declarations of the font-style and font-size values
+func (rpt *Report) htmlFont(fontStyle string, fontSize float64) string {
+	style := ""
+	fontStyle = strings.ToUpper(fontStyle)
+	if strings.Contains(fontStyle, "B") {
+		style += "font-weight:bold;"
+	}
+	if strings.Contains(fontStyle, "I") {
+		style += "font-style:italic;"
+	}
+	if strings.Contains(fontStyle, "U") {
+		style += "text-decoration:underline;"
+	}
+	if fontSize > 0 && fontSize != rpt.FontSize {
+		style += "font-size:" + htmlPt(fontSize) + ";"
+	}
+	return style
+}
+
+// htmlColors - CSS text and background colors, if they differ from the report default values
+func (rpt *Report) htmlColors(textColor, backgroundColor color.RGBA) string {
+	style := ""
+	if textColor != rpt.TextColor {
+		style += "color:" + htmlColor(textColor) + ";"
+	}
+	if backgroundColor != rpt.BackgroundColor {
+		style += "background-color:" + htmlColor(backgroundColor) + ";"
+	}
+	return style
+}
+
+func htmlAttr(name, value string) string {
+	if value == "" {
+		return ""
+	}
+	return fmt.Sprintf(` %s="%s"`, name, html.EscapeString(value))
+}
+
+// htmlValue - the HTML element text with the escaped databind values
+func (rpt *Report) htmlValue(value string) string {
+	return regexp.MustCompile(_regValue).ReplaceAllStringFunc(value, func(valueKey string) string {
+		return html.EscapeString(rpt.setValue(valueKey))
+	})
+}
+
+// htmlImageSrc - an inline data URL of the image, so the HTML document has no external dependencies
+func (rpt *Report) htmlImageSrc(v *Image) string {
+	data := rpt.setValue(v.Src)
+	if strings.HasPrefix(data, "data:image") {
+		return data
+	}
+	if v.Data != nil {
+		return "data:" + http.DetectContentType(v.Data) + ";base64," + base64.StdEncoding.EncodeToString(v.Data)
+	}
+	src := v.Src
+	if rpt.ImagePath != "" {
+		src = path.Join(rpt.ImagePath, v.Src)
+	}
+	if content, err := os.ReadFile(src); err == nil {
+		return "data:" + http.DetectContentType(content) + ";base64," + base64.StdEncoding.EncodeToString(content)
+	}
+	return ""
+}
+
+func (rpt *Report) htmlImage(sb *strings.Builder, v *Image, rowHeight float64) {
+	src := rpt.htmlImageSrc(v)
+	if src == "" {
+		return
+	}
+	height := v.Height
+	if height <= 0 && rowHeight > 0 {
+		height = rowHeight - _padding/3
+	}
+	style := ""
+	if height > 0 {
+		style = "height:" + htmlPt(height) + ";"
+	}
+	if v.Width > 0 {
+		style += "width:" + htmlPt(v.Width) + ";"
+	}
+	sb.WriteString(`<div class="image"><img` + htmlAttr("src", src) + htmlAttr("style", style) + ` alt=""></div>`)
+}
+
+func (rpt *Report) htmlBarcode(sb *strings.Builder, v *Barcode, ln bool) {
+	bcode := encodeBarcode(v)
+	if bcode == nil {
+		return
+	}
+	buf := new(bytes.Buffer)
+	if err := png.Encode(buf, bcode); err != nil {
+		return
+	}
+	height := v.Height
+	if height == 0 {
+		height = 10 * _mmPt
+	}
+	width := htmlPt(v.Width)
+	switch {
+	case v.CodeType == "QR" || v.CodeType == "qr":
+		width = htmlPt(height)
+	case ln && v.Extend:
+		width = "100%"
+	case v.Width == 0:
+		width = htmlPt(rpt.pdf.GetTextWidth(v.Value) + 1.5*_padding)
+	}
+	class := "barcode"
+	if ln && v.Extend {
+		class += " extend"
+	}
+	sb.WriteString(`<figure class="` + class + `"><img src="data:image/png;base64,` +
+		base64.StdEncoding.EncodeToString(buf.Bytes()) + `" style="width:` + width + `;height:` + htmlPt(height) + `"` +
+		htmlAttr("alt", v.Value) + `>`)
+	if v.VisibleValue {
+		sb.WriteString(`<figcaption>` + html.EscapeString(v.Value) + `</figcaption>`)
+	}
+	sb.WriteString(`</figure>`)
+}
+
+func (rpt *Report) htmlRow(sb *strings.Builder, section string, v *Row) {
+	style := ""
+	if v.Height > 0 {
+		style += "min-height:" + htmlPt(v.Height) + ";"
+	}
+	if v.HGap > 0 {
+		style += "column-gap:" + htmlPt(v.HGap) + ";"
+	}
+	sb.WriteString(`<div class="row"` + htmlAttr("style", style) + `>`)
+	columns := rpt.visibleItems(v.Columns)
+	for index := 0; index < len(columns); index++ {
+		ln := len(columns)-1 == index
+		switch col := columns[index].Item.(type) {
+		case *Cell:
+			if section != "details" && strings.Contains(col.Value, "{{page}}") {
+				continue
+			}
+			class := "cell"
+			width := htmlWidth(col.Width, _padding)
+			if ln && width == "" {
+				class += " extend"
+			}
+			if col.Multiline && section == "details" {
+				class += " multiline"
+			}
+			cstyle := "text-align:" + htmlAlign(col.Align) + ";" + rpt.htmlFont(col.FontStyle, col.FontSize) +
+				rpt.htmlColors(col.TextColor, col.BackgroundColor) + htmlBorder(col.Border, col.BorderColor)
+			if width != "" {
+				cstyle += "flex-basis:" + width + ";"
+			}
+			sb.WriteString(`<div class="` + class + `"` + htmlAttr("data-name", col.Name) + htmlAttr("style", cstyle) + `>` +
+				html.EscapeString(rpt.setValue(col.Value)) + `</div>`)
+		case *Image:
+			if col.Src != "" {
+				rpt.htmlImage(sb, col, v.Height)
+			}
+		case *Barcode:
+			rpt.htmlBarcode(sb, col, ln)
+		case *Separator:
+			sb.WriteString(`<div class="separator" style="margin:0 ` + htmlPt(col.Gap/2) + `"></div>`)
+		}
+	}
+	sb.WriteString(`</div>`)
+}
+
+func (rpt *Report) htmlDatagrid(sb *strings.Builder, grid *Datagrid) {
+	grid = rpt.visibleGrid(grid)
+	rows, valid := rpt.data[grid.Databind].([]SM)
+	if len(grid.Columns) == 0 || !valid || len(rows) == 0 {
+		return
+	}
+	columns := []*Column{}
+	for index := 0; index < len(grid.Columns); index++ {
+		if column, valid := grid.Columns[index].Item.(*Column); valid {
+			columns = append(columns, column)
+		}
+	}
+	border := htmlBorder(ut.ToString(grid.Border, "1"), grid.BorderColor)
+	style := "width:" + ut.ToString(htmlWidth(ut.ToString(grid.Width, "100%"), 0), "100%") + ";" +
+		rpt.htmlFont("", grid.FontSize) + rpt.htmlColors(grid.TextColor, grid.BackgroundColor)
+	sb.WriteString(`<table class="datagrid"` + htmlAttr("data-name", ut.ToString(grid.Name, "items")) +
+		htmlAttr("style", style) + `>`)
+
+	if grid.Merge {
+		sb.WriteString(`<tbody>`)
+		for _, row := range rpt.gridRows(grid, columns) {
+			sb.WriteString(`<tr><td` + htmlAttr("style", border) + `>` +
+				html.EscapeString(strings.TrimSpace(strings.Join(row.values, " "))) + `</td></tr>`)
+		}
+		sb.WriteString(`</tbody></table>`)
+		return
+	}
+
+	headerStyle := border + rpt.htmlColors(grid.TextColor, ut.ToRGBA(grid.HeaderBackground, grid.BackgroundColor))
+	sb.WriteString(`<thead><tr>`)
+	for _, column := range columns {
+		thStyle := headerStyle + "text-align:" + htmlAlign(column.HeaderAlign) + ";"
+		if width := htmlWidth(column.Width, 0); width != "" {
+			thStyle += "width:" + width + ";"
+		}
+		sb.WriteString(`<th` + htmlAttr("style", thStyle) + `>` + html.EscapeString(rpt.setValue(column.Label)) + `</th>`)
+	}
+	sb.WriteString(`</tr></thead><tbody>`)
+	sumStyle := border + "font-weight:bold;" +
+		rpt.htmlColors(grid.TextColor, ut.ToRGBA(grid.FooterBackground, grid.BackgroundColor))
+	for _, row := range rpt.gridRows(grid, columns) {
+		switch row.kind {
+		case "group":
+			sb.WriteString(`<tr class="group"><td` + htmlAttr("colspan", strconv.Itoa(len(columns))) +
+				htmlAttr("style", headerStyle+"font-weight:bold;") + `>` + html.EscapeString(row.label) + `</td></tr>`)
+		case "subtotal":
+			// the label extends up to the first subtotal value
+			span := 0
+			for span < len(columns) && row.values[span] == "" {
+				span++
+			}
+			sb.WriteString(`<tr class="subtotal">`)
+			if span > 0 {
+				sb.WriteString(`<td` + htmlAttr("colspan", strconv.Itoa(span)) + htmlAttr("style", sumStyle) + `>` +
+					html.EscapeString(row.label) + `</td>`)
+			}
+			for ci := span; ci < len(columns); ci++ {
+				sb.WriteString(`<td` + htmlAttr("style", sumStyle+"text-align:"+htmlAlign(columns[ci].Align)+";") + `>` +
+					html.EscapeString(row.values[ci]) + `</td>`)
+			}
+			sb.WriteString(`</tr>`)
+		default:
+			sb.WriteString(`<tr>`)
+			for ci, column := range columns {
+				sb.WriteString(`<td` + htmlAttr("style", border+"text-align:"+htmlAlign(column.Align)+";") +
+					htmlAttr("data-name", column.Fieldname) + `>` + html.EscapeString(row.values[ci]) + `</td>`)
+			}
+			sb.WriteString(`</tr>`)
+		}
+	}
+	sb.WriteString(`</tbody>`)
+
+	// the footer values extend to the previous empty columns, like in the PDF output
+	type footerCell struct {
+		text, align string
+		span        int
+	}
+	footers, empty := []footerCell{}, 0
+	for _, column := range columns {
+		value := rpt.setValue(column.Footer)
+		if value == "" {
+			empty++
+			continue
+		}
+		if len(footers) == 0 {
+			footers = append(footers, footerCell{text: value, align: column.FooterAlign, span: empty + 1})
+		} else {
+			footers[len(footers)-1].span += empty
+			footers = append(footers, footerCell{text: value, align: column.FooterAlign, span: 1})
+		}
+		empty = 0
+	}
+	if len(footers) > 0 {
+		footers[len(footers)-1].span += empty
+		footerStyle := border + "font-weight:bold;" +
+			rpt.htmlColors(grid.TextColor, ut.ToRGBA(grid.FooterBackground, grid.BackgroundColor))
+		sb.WriteString(`<tfoot><tr>`)
+		for _, footer := range footers {
+			span := ""
+			if footer.span > 1 {
+				span = htmlAttr("colspan", strconv.Itoa(footer.span))
+			}
+			sb.WriteString(`<td` + span + htmlAttr("style", footerStyle+"text-align:"+htmlAlign(footer.align)+";") + `>` +
+				html.EscapeString(footer.text) + `</td>`)
+		}
+		sb.WriteString(`</tr></tfoot>`)
+	}
+	sb.WriteString(`</table>`)
+}
+
+func (rpt *Report) htmlElements(sb *strings.Builder, section string, elements []PageItem) {
+	for index := 0; index < len(elements); index++ {
+		if !rpt.itemVisible(elements[index]) {
+			continue
+		}
+		switch v := elements[index].Item.(type) {
+		case *Row:
+			rpt.htmlRow(sb, section, v)
+		case *VGap:
+			sb.WriteString(`<div class="vgap" style="height:` + htmlPt(v.Height) + `"></div>`)
+		case *HLine:
+			style := "width:" + ut.ToString(htmlWidth(v.Width, 0), "100%") + ";border-top:1px solid " +
+				htmlColor(v.BorderColor) + ";"
+			if v.Gap > 0 {
+				style += "height:" + htmlPt(v.Gap+1) + ";border-bottom:1px solid " + htmlColor(v.BorderColor) + ";"
+			}
+			sb.WriteString(`<hr style="` + style + `">`)
+		case *HTML:
+			sb.WriteString(`<div class="html">` + rpt.htmlValue(v.Value) + `</div>`)
+		case *Datagrid:
+			rpt.htmlDatagrid(sb, v)
+		}
+	}
+}
+
+// Save2Html creates an HTML output. A standalone document with print CSS for a browser preview or
+// an email body: the rows are flex containers, the datagrids are tables and the images and barcodes
+// are inline data URLs. The page number cells of the header and footer are omitted.
+func (rpt *Report) Save2Html() string {
+	pageWidth, pageHeight := rpt.pdf.GetPageSize()
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	sb.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	sb.WriteString("<title>" + html.EscapeString(rpt.Title) + "</title>\n")
+	sb.WriteString("<style>\n" + _htmlStyle + "\n")
+	sb.WriteString(fmt.Sprintf("@page{size:%s %s;margin:%s %s %s %s}\n",
+		htmlPt(pageWidth), htmlPt(pageHeight), htmlPt(rpt.TopMargin), htmlPt(rpt.RightMargin),
+		htmlPt(rpt.BottomMargin), htmlPt(rpt.LeftMargin)))
+	sb.WriteString(fmt.Sprintf(".report{max-width:%s;padding:%s %s %s %s;font-family:\"%s\",Helvetica,Arial,sans-serif;"+
+		"font-size:%s;color:%s}\n</style>\n</head>\n<body>\n",
+		htmlPt(pageWidth), htmlPt(rpt.TopMargin), htmlPt(rpt.RightMargin), htmlPt(rpt.BottomMargin), htmlPt(rpt.LeftMargin),
+		rpt.FontFamily, htmlPt(rpt.FontSize), htmlColor(rpt.TextColor)))
+	sb.WriteString("<div class=\"report\">\n<header>")
+	rpt.htmlElements(&sb, "header", rpt.header)
+	sb.WriteString("</header>\n<main>")
+	rpt.htmlElements(&sb, "details", rpt.details)
+	sb.WriteString("</main>\n<footer>")
+	rpt.htmlElements(&sb, "footer", rpt.footer)
+	sb.WriteString("</footer>\n</div>\n</body>\n</html>\n")
+	return sb.String()
+}
@@ -128,6 +128,13 @@ var propMap SM = SM{
 	"footer-background": "FooterBackground", "footerbackground": "FooterBackground", "label": "Label",
 	"header-align": "HeaderAlign", "headeralign": "HeaderAlign",
 	"footer-align": "FooterAlign", "footeralign": "FooterAlign", "footer": "Footer",
+	"group-by": "GroupBy", "groupby": "GroupBy", "group-label": "GroupLabel", "grouplabel": "GroupLabel",
+	"subtotal-label": "SubtotalLabel", "subtotallabel": "SubtotalLabel",
+	"carry-forward": "CarryForward", "carryforward": "CarryForward",
+	"brought-label": "BroughtLabel", "broughtlabel": "BroughtLabel",
+	"carried-label": "CarriedLabel", "carriedlabel": "CarriedLabel",
+	"subtotal": "Subtotal", "running": "Running", "if": "If",
+	"chart-type": "ChartType", "charttype": "ChartType", "colors": "Colors",
 	"title": "Title", "author": "Author", "creator": "Creator",
 	"subject": "Subject", "keywords": "Keywords", "leftmargin": "LeftMargin", "left-margin": "LeftMargin",
 	"topmargin": "TopMargin", "top-margin": "TopMargin", "rightmargin": "RightMargin", "right-margin": "RightMargin",
@@ -144,9 +151,14 @@ func invalidErr(etype, evalue string) string {
 type PageItem struct {
 	ItemType string
 	Item     interface{}
+	If       string //the element is visible if the expression value is true (e.g. "head.notax == 1")
 }
 
 func (pi *PageItem) setPageItem(fieldname string, value interface{}) error {
+	if propMap[strings.ToLower(fieldname)] == "If" {
+		pi.If = ut.ToString(value, "")
+		return checkExpression(pi.If)
+	}
 	vmap := map[string]map[string]func(value interface{}){
 		"row": {
 			"Height": func(value interface{}) {
@@ -291,6 +303,56 @@ func (pi *PageItem) setPageItem(fieldname string, value interface{}) error {
 			"FooterBackground": func(value interface{}) {
 				pi.Item.(*Datagrid).FooterBackground = ut.ToRGBA(value, pi.Item.(*Datagrid).FooterBackground)
 			},
+			"GroupBy": func(value interface{}) {
+				pi.Item.(*Datagrid).GroupBy = ut.ToString(value, "")
+			},
+			"GroupLabel": func(value interface{}) {
+				pi.Item.(*Datagrid).GroupLabel = ut.ToString(value, "")
+			},
+			"SubtotalLabel": func(value interface{}) {
+				pi.Item.(*Datagrid).SubtotalLabel = ut.ToString(value, "")
+			},
+			"CarryForward": func(value interface{}) {
+				pi.Item.(*Datagrid).CarryForward = ut.ToBoolean(value, false)
+			},
+			"BroughtLabel": func(value interface{}) {
+				pi.Item.(*Datagrid).BroughtLabel = ut.ToString(value, "")
+			},
+			"CarriedLabel": func(value interface{}) {
+				pi.Item.(*Datagrid).CarriedLabel = ut.ToString(value, "")
+			},
+		},
+		"chart": {
+			"Databind": func(value interface{}) {
+				pi.Item.(*Chart).Databind = ut.ToString(value, "")
+			},
+			"ChartType": func(value interface{}) {
+				pi.Item.(*Chart).ChartType = ut.ToString(value, "bar")
+			},
+			"Label": func(value interface{}) {
+				pi.Item.(*Chart).Label = ut.ToString(value, "")
+			},
+			"Title": func(value interface{}) {
+				pi.Item.(*Chart).Title = ut.ToString(value, "")
+			},
+			"Width": func(value interface{}) {
+				pi.Item.(*Chart).Width = ut.ToString(value, "")
+			},
+			"Height": func(value interface{}) {
+				pi.Item.(*Chart).Height = ut.ToFloat(value, 0)
+			},
+			"Colors": func(value interface{}) {
+				pi.Item.(*Chart).Colors = ut.ToString(value, "")
+			},
+			"FontSize": func(value interface{}) {
+				pi.Item.(*Chart).FontSize = ut.ToFloat(value, pi.Item.(*Chart).FontSize)
+			},
+			"TextColor": func(value interface{}) {
+				pi.Item.(*Chart).TextColor = ut.ToRGBA(value, pi.Item.(*Chart).TextColor)
+			},
+			"BorderColor": func(value interface{}) {
+				pi.Item.(*Chart).BorderColor = ut.ToRGBA(value, pi.Item.(*Chart).BorderColor)
+			},
 		},
 		"column": {
 			"Fieldname": func(value interface{}) {
@@ -314,6 +376,12 @@ func (pi *PageItem) setPageItem(fieldname string, value interface{}) error {
 			"Footer": func(value interface{}) {
 				pi.Item.(*Column).Footer = ut.ToString(value, "")
 			},
+			"Subtotal": func(value interface{}) {
+				pi.Item.(*Column).Subtotal = ut.ToBoolean(value, false)
+			},
+			"Running": func(value interface{}) {
+				pi.Item.(*Column).Running = ut.ToBoolean(value, false)
+			},
 		},
 	}
 
@@ -342,6 +410,15 @@ func (rpt *Report) getPageItem(etype string) (PageItem, error) {
 				TextColor:       rpt.TextColor,
 				BorderColor:     rpt.BorderColor,
 				BackgroundColor: rpt.BackgroundColor}}, nil
+	case "chart":
+		return PageItem{
+			ItemType: etype,
+			Item: &Chart{
+				ChartType:   "bar",
+				FontSize:    rpt.FontSize,
+				TextColor:   rpt.TextColor,
+				BorderColor: rpt.BorderColor,
+				Columns:     make([]PageItem, 0)}}, nil
 	case "column":
 		return PageItem{
 			ItemType: etype,
@@ -471,6 +548,12 @@ type Datagrid struct {
 	BackgroundColor  color.RGBA `xml:"background-color,attr" json:"background-color"`   //JSON or XML value: integer gray color (in range from 0 "black" to 255 "white"), default "black"
 	HeaderBackground color.RGBA `xml:"header-background,attr" json:"header-background"` //JSON or XML value: integer gray color (in range from 0 "black" to 255 "white"), default "black"
 	FooterBackground color.RGBA `xml:"footer-background,attr" json:"footer-background"` //JSON or XML value: integer gray color (in range from 0 "black" to 255 "white"), default "black"
+	GroupBy          string     `xml:"group-by,attr" json:"group-by"`                   //group the (sorted) rows by the fieldname value: group header and subtotal rows
+	GroupLabel       string     `xml:"group-label,attr" json:"group-label"`             //group header caption before the group value (static text or databind value)
+	SubtotalLabel    string     `xml:"subtotal-label,attr" json:"subtotal-label"`       //subtotal row caption before the group value (static text or databind value)
+	CarryForward     bool       `xml:"carry-forward,attr" json:"carry-forward"`         //if true then the subtotal column sums are carried forward to the next page (default false)
+	BroughtLabel     string     `xml:"brought-label,attr" json:"brought-label"`         //default "Brought forward"
+	CarriedLabel     string     `xml:"carried-label,attr" json:"carried-label"`         //default "Carried forward"
 	Columns          []PageItem `xml:"columns" json:"columns"`                          //columns list of the datagrid
 }
 
@@ -483,6 +566,23 @@ type Column struct {
 	HeaderAlign string `xml:"header-align,attr" json:"header-align"` //values: "L" (default) or "left", "R" or "right", "C" or "center"
 	FooterAlign string `xml:"footer-align,attr" json:"footer-align"` //values: "L" (default) or "left", "R" or "right", "C" or "center"
 	Footer      string `xml:"footer,attr" json:"footer"`             //static text or databind value
+	Subtotal    bool   `xml:"subtotal,attr" json:"subtotal"`         //sum of the column values in the group subtotal and carry forward rows
+	Running     bool   `xml:"running,attr" json:"running"`           //the running total of the column values (restarts at every group)
+}
+
+// Chart - a bar, line, pie or stacked bar chart from a data list. The columns are the data series.
+type Chart struct {
+	Databind    string     `xml:"databind,attr" json:"databind"`         //chart data source name
+	ChartType   string     `xml:"chart-type,attr" json:"chart-type"`     //values: "bar" (default), "line", "pie" (first column values), "stacked" (stacked bar)
+	Label       string     `xml:"label,attr" json:"label"`               //fieldname of the category labels (x axis or pie slices)
+	Title       string     `xml:"title,attr" json:"title"`               //static text or databind value
+	Width       string     `xml:"width,attr" json:"width"`               //number or percent value (e.g. "10" or "10%"), default "100%"
+	Height      float64    `xml:"height,attr" json:"height"`             //chart height with the title and the legend (default 60)
+	Colors      string     `xml:"colors,attr" json:"colors"`             //comma separated hexadecimal series colors (e.g. "#4E79A7,#F28E2B")
+	FontSize    float64    `xml:"font-size,attr" json:"font-size"`       //Default value: Report.FontSize
+	TextColor   color.RGBA `xml:"color,attr" json:"color"`               //JSON or XML value: in hexadecimal (e.g. #A0522D) or in decimal (e.g 10506797), default "black"
+	BorderColor color.RGBA `xml:"border-color,attr" json:"border-color"` //axis color, JSON or XML value: integer gray color (in range from 0 "black" to 255 "white"), default "black"
+	Columns     []PageItem `xml:"columns" json:"columns"`                //data series: column fieldname and label (legend)
 }
 
 // Report is the principal structure for creating a single PDF document
@@ -576,7 +676,9 @@ func (rpt *Report) createHeaderAndFooter() {
 		for index := 0; index < len(elements); index++ {
 			switch elements[index].Item.(type) {
 			case *Row, *VGap, *HLine:
-				rpt.createElement(section, elements[index].Item)
+				if rpt.itemVisible(elements[index]) {
+					rpt.createElement(section, elements[index].Item)
+				}
 			}
 		}
 	}
@@ -603,6 +705,9 @@ func (rpt *Report) checkPageBreak(nextHeight float64) bool {
 
 func (rpt *Report) getFooterHeight() (fHeight float64) {
 	for index := 0; index < len(rpt.footer); index++ {
+		if !rpt.itemVisible(rpt.footer[index]) {
+			continue
+		}
 		switch v := rpt.footer[index].Item.(type) {
 		case *Row:
 			fHeight += rpt.createRow("footer", v, true)
@@ -632,42 +737,52 @@ func (rpt *Report) setHTMLValue(value, fieldname string) string {
 	return value
 }
 
-func (rpt *Report) setValue(value string) string {
-	var getValue = func(valueGet string) string {
-		if matched, _ := regexp.MatchString("{{page}}", valueGet); matched {
-			valueGet = strings.ReplaceAll(valueGet, "{{page}}", strconv.Itoa(rpt.pdf.PageNo()))
-		}
-		dbv := strings.Split(valueGet, ".")
-		storeData, isData := rpt.data[dbv[0]]
-		if isData {
-			if data, valid := storeData.([]SM); valid {
-				if len(dbv) > 2 {
-					row := ut.ToInteger(dbv[1], 0)
-					if len(data) > int(row) {
-						rowValue, isData := data[row][dbv[2]]
-						if isData {
-							return rowValue
-						}
-					}
-					return ""
-				}
-				return valueGet
-			}
-			if data, valid := storeData.(SM); valid {
-				if len(dbv) > 1 {
-					dictValue, isData := data[dbv[1]]
+// dataValue returns the databind value of a key (e.g. head.notax, items.0.amount).
+// The found result is false if the key is not a data value.
+func (rpt *Report) dataValue(key string) (string, bool) {
+	dbv := strings.Split(key, ".")
+	storeData, isData := rpt.data[dbv[0]]
+	if isData {
+		if data, valid := storeData.([]SM); valid {
+			if len(dbv) > 2 {
+				row := ut.ToInteger(dbv[1], 0)
+				if len(data) > int(row) {
+					rowValue, isData := data[row][dbv[2]]
 					if isData {
-						return dictValue
+						return rowValue, true
 					}
-					return ""
 				}
-				return valueGet
+				return "", true
 			}
-			if data, valid := storeData.(string); valid {
-				return data
+			return key, false
+		}
+		if data, valid := storeData.(SM); valid {
+			if len(dbv) > 1 {
+				dictValue, isData := data[dbv[1]]
+				if isData {
+					return dictValue, true
+				}
+				return "", true
 			}
+			return key, false
+		}
+		if data, valid := storeData.(string); valid {
+			return data, true
 		}
-		return valueGet
+	}
+	return key, false
+}
+
+func (rpt *Report) setValue(value string) string {
+	var getValue = func(valueGet string) string {
+		if strings.HasPrefix(valueGet, "=") {
+			return rpt.evalValue(valueGet[1:])
+		}
+		if matched, _ := regexp.MatchString("{{page}}", valueGet); matched {
+			valueGet = strings.ReplaceAll(valueGet, "{{page}}", strconv.Itoa(rpt.pdf.PageNo()))
+		}
+		dataValue, _ := rpt.dataValue(valueGet)
+		return dataValue
 	}
 	if matched, _ := regexp.MatchString(_regValue, value); matched {
 		valueSet := value[strings.Index(value, "={{")+3 : strings.Index(value, "}}")]
@@ -709,7 +824,94 @@ func (rpt *Report) createGridHeader(headerOptions IM) {
 	}
 }
 
+// gridRow - a datagrid output row: a "group" header, a data "row" or a group "subtotal"
+type gridRow struct {
+	kind, label   string
+	values, carry []string // column values and the carried forward sums after the data row
+}
+
+// gridNumber - the numeric value of a datagrid text value
+func gridNumber(value string) float64 {
+	return ut.ToFloat(strings.ReplaceAll(value, " ", ""), 0)
+}
+
+// gridDigits - the number of decimal places of a numeric text value
+func gridDigits(value string) int {
+	if index := strings.LastIndex(value, "."); index > -1 {
+		if _, err := strconv.ParseFloat(strings.ReplaceAll(value, " ", ""), 64); err == nil {
+			return len(value) - index - 1
+		}
+	}
+	return 0
+}
+
+// gridRows returns the output rows of a datagrid: the group headers and the subtotals of the
+// GroupBy fieldname, the running totals and the carried forward sums of the Subtotal columns.
+func (rpt *Report) gridRows(grid *Datagrid, columns []*Column) (result []gridRow) {
+	rows, _ := rpt.data[grid.Databind].([]SM)
+	digits := make([]int, len(columns))
+	subtotal := false
+	for ci, column := range columns {
+		subtotal = subtotal || column.Subtotal
+		for _, row := range rows {
+			if value := gridDigits(row[column.Fieldname]); value > digits[ci] {
+				digits[ci] = value
+			}
+		}
+	}
+	sumValues := func(sums []float64) []string {
+		values := make([]string, len(columns))
+		for ci, column := range columns {
+			if column.Subtotal {
+				values[ci] = strconv.FormatFloat(sums[ci], 'f', digits[ci], 64)
+			}
+		}
+		return values
+	}
+	groupBy := grid.GroupBy
+	if grid.Merge {
+		groupBy = ""
+	}
+	groupValue := ""
+	groupSums, runSums, carrySums := make([]float64, len(columns)), make([]float64, len(columns)), make([]float64, len(columns))
+	for rowIndex, row := range rows {
+		if groupBy != "" && (rowIndex == 0 || row[groupBy] != groupValue) {
+			if rowIndex > 0 && subtotal {
+				result = append(result, gridRow{kind: "subtotal",
+					label: strings.TrimSpace(rpt.setValue(grid.SubtotalLabel) + " " + groupValue), values: sumValues(groupSums)})
+			}
+			groupValue = row[groupBy]
+			groupSums, runSums = make([]float64, len(columns)), make([]float64, len(columns))
+			result = append(result, gridRow{kind: "group", values: make([]string, len(columns)),
+				label: strings.TrimSpace(rpt.setValue(grid.GroupLabel) + " " + groupValue)})
+		}
+		values := make([]string, len(columns))
+		for ci, column := range columns {
+			values[ci] = row[column.Fieldname]
+			if column.Fieldname == "counter" {
+				values[ci] = strconv.Itoa(rowIndex + 1)
+			}
+			value := gridNumber(values[ci])
+			if column.Subtotal {
+				groupSums[ci] += value
+				carrySums[ci] += value
+			}
+			if column.Running {
+				runSums[ci] += value
+				values[ci] = strconv.FormatFloat(runSums[ci], 'f', digits[ci], 64)
+			}
+		}
+		result = append(result, gridRow{kind: "row", values: values, carry: sumValues(carrySums)})
+	}
+	if groupBy != "" && subtotal && len(rows) > 0 {
+		result = append(result, gridRow{kind: "subtotal",
+			label: strings.TrimSpace(rpt.setValue(grid.SubtotalLabel) + " " + groupValue), values: sumValues(groupSums)})
+	}
+	return result
+}
+
 func (rpt *Report) createDatagrid(gridElement *Datagrid) bool {
+	gridElement = rpt.visibleGrid(gridElement)
 	if len(gridElement.Columns) == 0 {
 		return false
 	}
@@ -829,22 +1031,98 @@ func (rpt *Report) createDatagrid(gridElement *Datagrid) bool {
 		rpt.createGridHeader(headerOptions)
 	}
 
-	for rowIndex := 0; rowIndex < len(rows); rowIndex++ {
-		row := rows[rowIndex]
+	gridColumns := make([]*Column, 0)
+	for index := 0; index < len(gridElement.Columns); index++ {
+		gridColumns = append(gridColumns, gridElement.Columns[index].Item.(*Column))
+	}
+	sumOptions := IM{
+		"fontSize": gridOptions["fontSize"], "textColor": gridOptions["textColor"],
+		"borderColor": gridOptions["borderColor"], "border": gridOptions["border"],
+		"fontFamily": gridOptions["fontFamily"], "fontStyle": "B", "multiline": false,
+		"backgroundColor": footerOptions["backgroundColor"]}
+	groupOptions := IM{
+		"fontSize": gridOptions["fontSize"], "textColor": gridOptions["textColor"],
+		"borderColor": gridOptions["borderColor"], "border": gridOptions["border"],
+		"fontFamily": gridOptions["fontFamily"], "fontStyle": "B", "multiline": false,
+		"backgroundColor": headerOptions["backgroundColor"]}
+	// a group header or a subtotal row: the label extends up to the first subtotal value
+	createGridSum := func(options IM, label string, values []string, virtual bool) float64 {
+		cells := make([]IM, 0)
+		for colIndex := 0; colIndex < len(headerOptions["columns"].([]IM)); colIndex++ {
+			column := headerOptions["columns"].([]IM)[colIndex]
+			text := values[colIndex]
+			if len(cells) == 1 && cells[0]["label"].(bool) && text == "" {
+				cells[0]["columnWidth"] = cells[0]["columnWidth"].(float64) + column["columnWidth"].(float64)
+				continue
+			}
+			cell := IM{"text": text, "align": column["align"], "columnWidth": column["columnWidth"],
+				"xCol": column["xCol"], "label": false}
+			if len(cells) == 0 && text == "" {
+				cell["text"], cell["align"], cell["label"] = label, "L", true
+			}
+			cells = append(cells, cell)
+		}
+		height := float64(0)
+		for index := 0; index < len(cells); index++ {
+			cheight := rpt.getCellHeight(cells[index]["text"].(string), cells[index]["columnWidth"].(float64), options)
+			if cheight > height {
+				height = cheight
+			}
+		}
+		if !virtual {
+			for index := 0; index < len(cells); index++ {
+				options["text"] = cells[index]["text"]
+				options["align"] = cells[index]["align"]
+				options["columnWidth"] = cells[index]["columnWidth"]
+				options["xCol"] = cells[index]["xCol"]
+				options["height"] = height
+				options["ln"] = (len(cells)-1 == index)
+				rpt.createCell(options)
+			}
+		}
+		return height
+	}
+	carryForward := gridElement.CarryForward && !headerOptions["merge"].(bool)
+	carriedLabel := rpt.setValue(ut.ToString(gridElement.CarriedLabel, "Carried forward"))
+	// the space of the carried forward line must be kept free at the bottom of the page
+	carryHeight := func(values []string) float64 {
+		if !carryForward || values == nil {
+			return 0
+		}
+		return createGridSum(sumOptions, carriedLabel, values, true)
+	}
+	var carried []string
+	breakPage := func() {
+		if carryForward && carried != nil {
+			createGridSum(sumOptions, carriedLabel, carried, false)
+		}
+		rpt.addPage()
+		if !headerOptions["merge"].(bool) {
+			rpt.createGridHeader(headerOptions)
+		}
+		if carryForward && carried != nil {
+			createGridSum(sumOptions, rpt.setValue(ut.ToString(gridElement.BroughtLabel, "Brought forward")), carried, false)
+		}
+	}
+
+	for _, gridRow := range rpt.gridRows(gridElement, gridColumns) {
+		if gridRow.kind != "row" {
+			options := sumOptions
+			if gridRow.kind == "group" {
+				options = groupOptions
+			}
+			if rpt.checkPageBreak(createGridSum(options, gridRow.label, gridRow.values, true) + carryHeight(carried)) {
+				breakPage()
+			}
+			createGridSum(options, gridRow.label, gridRow.values, false)
+			continue
+		}
 		rpt.addToXML("details", []string{gridOptions["xname"].(string)})
 		gridOptions["height"] = float64(0)
 		gridOptions["text"] = ""
 		for colIndex := 0; colIndex < len(headerOptions["columns"].([]IM)); colIndex++ {
 			column := headerOptions["columns"].([]IM)[colIndex]
-			if column["fieldname"] == "counter" {
-				column["text"] = strconv.Itoa(rowIndex + 1)
-			} else {
-				if value, found := row[column["fieldname"].(string)]; found {
-					column["text"] = value
-				} else {
-					column["text"] = ""
-				}
-			}
+			column["text"] = gridRow.values[colIndex]
 			if !headerOptions["merge"].(bool) {
 				cheight := rpt.getCellHeight(column["text"].(string), column["columnWidth"].(float64), gridOptions)
 				if cheight > gridOptions["height"].(float64) {
@@ -855,11 +1133,8 @@ func (rpt *Report) createDatagrid(gridElement *Datagrid) bool {
 				rpt.addToXML("details", []string{column["fieldname"].(string), column["text"].(string), column["fieldname"].(string)})
 			}
 		}
-		if rpt.checkPageBreak(gridOptions["height"].(float64)) {
-			rpt.addPage()
-			if !headerOptions["merge"].(bool) {
-				rpt.createGridHeader(headerOptions)
-			}
+		if rpt.checkPageBreak(gridOptions["height"].(float64) + carryHeight(gridRow.carry)) {
+			breakPage()
 		}
 		if !headerOptions["merge"].(bool) {
 			for colIndex := 0; colIndex < len(headerOptions["columns"].([]IM)); colIndex++ {
@@ -882,6 +1157,7 @@ func (rpt *Report) createDatagrid(gridElement *Datagrid) bool {
 			rpt.createCell(gridOptions)
 		}
 		rpt.addToXML("details", []string{gridOptions["xname"].(string)})
+		carried = gridRow.carry
 	}
 	if !headerOptions["merge"].(bool) {
 		for colIndex := 0; colIndex < len(footers); colIndex++ {
@@ -1076,6 +1352,28 @@ func (rpt *Report) createImage(v *Image, rowHeight float64, virtual bool) (float
 	return rowHeight, v.Width
 }
 
+// encodeBarcode returns the barcode image of the code type and value.
+func encodeBarcode(v *Barcode) (bcode barcode.Barcode) {
+	switch v.CodeType {
+	case "CODE_39", "code39":
+		bcode, _ = code39.Encode(v.Value, true, true)
+
+	case "ITF", "i2of5":
+		bcode, _ = twooffive.Encode(v.Value, true)
+
+	case "CODE_128", "code128":
+		bcode, _ = code128.Encode(v.Value)
+
+	case "EAN", "ean":
+		bcode, _ = ean.Encode(v.Value)
+
+	case "QR", "qr":
+		bcode, _ = qr.Encode(v.Value, qr.H, qr.Unicode)
+
+	}
+	return bcode
+}
+
 func (rpt *Report) createBarcode(v *Barcode, virtual, ln bool) (float64, float64) {
 	pageWidth, _ := rpt.pdf.GetPageSize()
 	rpt.pdf.SetTextColor(int(rpt.TextColor.R), int(rpt.TextColor.G), int(rpt.TextColor.B))
@@ -1099,27 +1397,10 @@ func (rpt *Report) createBarcode(v *Barcode, virtual, ln bool) (float64, float64
 	if rpt.checkPageBreak(height) && !virtual {
 		rpt.addPage()
 	}
-	var bcode barcode.Barcode
-	switch v.CodeType {
-	case "CODE_39", "code39":
-		bcode, _ = code39.Encode(v.Value, true, true)
-
-	case "ITF", "i2of5":
-		bcode, _ = twooffive.Encode(v.Value, true)
-
-	case "CODE_128", "code128":
-		bcode, _ = code128.Encode(v.Value)
-
-	case "EAN", "ean":
-		bcode, _ = ean.Encode(v.Value)
-
-	case "QR", "qr":
+	if v.CodeType == "QR" || v.CodeType == "qr" {
 		width = height
-		bcode, _ = qr.Encode(v.Value, qr.H, qr.Unicode)
-
 	}
-
-	if bcode != nil {
+	if bcode := encodeBarcode(v); bcode != nil {
 		buf := new(bytes.Buffer)
 		err := jpeg.Encode(buf, bcode, &jpeg.Options{Quality: 100})
 		if err == nil {
@@ -1154,14 +1435,15 @@ func (rpt *Report) addToXML(section string, values []string) {
 
 func (rpt *Report) createRow(section string, rowElement *Row, virtual bool) float64 {
 	maxHeight := rowElement.Height
-	for index := 0; index < len(rowElement.Columns); index++ {
+	columns := rpt.visibleItems(rowElement.Columns)
+	for index := 0; index < len(columns); index++ {
 		startY := rpt.pdf.GetY()
 		if rpt.pdf.GetX() != rpt.LeftMargin {
 			rpt.pdf.SetX(rpt.pdf.GetX() + rowElement.HGap)
 		}
 		startX := rpt.pdf.GetX()
-		ln := len(rowElement.Columns)-1 == index
-		element := rowElement.Columns[index].Item
+		ln := len(columns)-1 == index
+		element := columns[index].Item
 		switch v := element.(type) {
 		case *Cell:
 			options := IM{
@@ -1201,7 +1483,7 @@ func (rpt *Report) createRow(section string, rowElement *Row, virtual bool) floa
 				if height > maxHeight {
 					maxHeight = height
 				}
-				if len(rowElement.Columns)-1 == index {
+				if len(columns)-1 == index {
 					rpt.pdf.SetXY(rpt.LeftMargin, startY+maxHeight)
 				} else {
 					rpt.pdf.SetXY(startX+width, startY)
@@ -1212,7 +1494,7 @@ func (rpt *Report) createRow(section string, rowElement *Row, virtual bool) floa
 			if height > maxHeight || maxHeight == 0 {
 				maxHeight = height
 			}
-			if len(rowElement.Columns)-1 == index {
+			if len(columns)-1 == index {
 				rpt.pdf.SetXY(rpt.LeftMargin, startY+maxHeight)
 			} else {
 				rpt.pdf.SetXY(startX+width+_padding, startY)
@@ -1221,7 +1503,7 @@ func (rpt *Report) createRow(section string, rowElement *Row, virtual bool) floa
 			if !virtual {
 				rpt.pdf.Line(rpt.pdf.GetX()+v.Gap, rpt.pdf.GetY(), rpt.pdf.GetX()+v.Gap, rpt.pdf.GetY()+maxHeight)
 			}
-			if len(rowElement.Columns)-1 == index {
+			if len(columns)-1 == index {
 				rpt.pdf.SetX(rpt.pdf.GetX() + v.Gap)
 			}
 			if v.Gap > maxHeight || maxHeight == 0 {
@@ -1269,15 +1551,23 @@ func (rpt *Report) createLine(v *HLine) {
 	}
 }
 
+// rowVisible - a row with a Visible data source is hidden when the data list is empty
+func (rpt *Report) rowVisible(v *Row) bool {
+	if v.Visible != "" {
+		if _, found := rpt.data[v.Visible]; found {
+			if srows, valid := rpt.data[v.Visible].([]SM); !valid || len(srows) == 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (rpt *Report) createElement(section string, element interface{}) {
 	switch v := element.(type) {
 	case *Row:
-		if v.Visible != "" {
-			if _, found := rpt.data[v.Visible]; found {
-				if srows, valid := rpt.data[v.Visible].([]SM); !valid || len(srows) == 0 {
-					return
-				}
-			}
+		if !rpt.rowVisible(v) {
+			return
 		}
 		rpt.createRow(section, v, false)
 	case *VGap:
@@ -1291,6 +1581,8 @@ func (rpt *Report) createElement(section string, element interface{}) {
 		rpt.createHTML(v)
 	case *Datagrid:
 		rpt.createDatagrid(v)
+	case *Chart:
+		rpt.createChart(v)
 	}
 }
 
@@ -1366,6 +1658,15 @@ func (rpt *Report) parseValue(vname string, value interface{}) interface{} {
 		"Merge": func(value interface{}) interface{} {
 			return ut.ToBoolean(value, false)
 		},
+		"CarryForward": func(value interface{}) interface{} {
+			return ut.ToBoolean(value, false)
+		},
+		"Subtotal": func(value interface{}) interface{} {
+			return ut.ToBoolean(value, false)
+		},
+		"Running": func(value interface{}) interface{} {
+			return ut.ToBoolean(value, false)
+		},
 		"VisibleValue": func(value interface{}) interface{} {
 			return ut.ToBoolean(value, false)
 		},
@@ -1540,7 +1841,9 @@ func (rpt *Report) CreateReport() bool {
 	rpt.footerHeight = rpt.getFooterHeight()
 	rpt.addPage()
 	for index := 0; index < len(rpt.details); index++ {
-		rpt.createElement("details", rpt.details[index].Item)
+		if rpt.itemVisible(rpt.details[index]) {
+			rpt.createElement("details", rpt.details[index].Item)
+		}
 	}
 	return true
 }
@@ -1576,9 +1879,12 @@ func (rpt *Report) getXMLElements(xdata etree.Element) (PageItem, error) {
 						return el, err
 					}
 				}
-				if xdata.Tag == "row" {
+				switch xdata.Tag {
+				case "row":
 					el.Item.(*Row).Columns = append(el.Item.(*Row).Columns, el2)
-				} else {
+				case "chart":
+					el.Item.(*Chart).Columns = append(el.Item.(*Chart).Columns, el2)
+				default:
 					el.Item.(*Datagrid).Columns = append(el.Item.(*Datagrid).Columns, el2)
 				}
 			default:
@@ -1625,7 +1931,7 @@ func (rpt *Report) LoadDefinition(xmlString string) (bool, error) {
 		for index := 0; index < len(detailsChilds); index++ {
 			xElement := detailsChilds[index]
 			switch xElement.Tag {
-			case "row", "vgap", "hline", "html", "datagrid":
+			case "row", "vgap", "hline", "html", "datagrid", "chart":
 				el, err := rpt.getXMLElements(*xElement)
 				if err != nil {
 					return false, err
@@ -1705,9 +2011,12 @@ func (rpt *Report) getJSONElements(edata interface{}) (el PageItem, err error) {
 									return el, err
 								}
 							}
-							if eName == "row" {
+							switch eName {
+							case "row":
 								el.Item.(*Row).Columns = append(el.Item.(*Row).Columns, el2)
-							} else {
+							case "chart":
+								el.Item.(*Chart).Columns = append(el.Item.(*Chart).Columns, el2)
+							default:
 								el.Item.(*Datagrid).Columns = append(el.Item.(*Datagrid).Columns, el2)
 							}
 						default:
@@ -1808,7 +2117,7 @@ func (rpt *Report) LoadJSONDefinition(jsonString string) error {
 /*
 AppendElement - Append an element in the template.
  • parent - Optional. The parent elemnt. Values: "header","details","footer" or result value (row, datagrid) Default value: "details"
- • ename - Optional. An Element type: "row", "datagrid", "vgap", "hline", "html", "column", "cell", "image", "separator", "barcode". Default value: "row"
+ • ename - Optional. An Element type: "row", "datagrid", "chart", "vgap", "hline", "html", "column", "cell", "image", "separator", "barcode". Default value: "row"
  • values - Optional. Element attributes
 Example:
  row_data := rpt.AppendElement("header", "row", map[string]interface{}{"height": 10})
@@ -1838,7 +2147,7 @@ func (rpt *Report) AppendElement(options ...interface{}) (*[]PageItem, error) {
 				parent = &rpt.details
 				if len(options) > 1 {
 					ename := ut.ToString(options[1], "")
-					if ut.Contains([]string{"row", "vgap", "hline", "html", "datagrid"}, ename) {
+					if ut.Contains([]string{"row", "vgap", "hline", "html", "datagrid", "chart"}, ename) {
 						el, _ = rpt.getPageItem(ename)
 					} else {
 						return nil, errors.New(invalidErr("Details", ename))
@@ -1889,6 +2198,8 @@ func (rpt *Report) AppendElement(options ...interface{}) (*[]PageItem, error) {
 		return &el.Item.(*Row).Columns, nil
 	} else if el.ItemType == "datagrid" {
 		return &el.Item.(*Datagrid).Columns, nil
+	} else if el.ItemType == "chart" {
+		return &el.Item.(*Chart).Columns, nil
 	}
 	return parent, nil
 }
@@ -0,0 +1,428 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+)
+
+const (
+	_xlsxMain      = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	_xlsxRelations = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	_xlsxPackage   = "http://schemas.openxmlformats.org/package/2006/relationships"
+	_xlsxDate      = 164
+	_xlsxDateTime  = 165
+	_xlsxDecimal   = 4
+	_xlsxMinWidth  = 8
+	_xlsxMaxWidth  = 60
+)
+
+var xlsxNumber = regexp.MustCompile(`^-?(0|[1-9]\d*)(\.\d+)?$`)
+var xlsxDate = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})(?:[T ](\d{2}:\d{2}(?::\d{2})?)(?:Z|[+-]\d{2}:?\d{2})?)?$`)
+var xlsxSheetName = regexp.MustCompile(`[\[\]:*?/\\]`)
+var xlsxTags = regexp.MustCompile(`<[^>]*>`)
+
+// xlsxStyle - the cell format of the styles.xml cellXfs list
+type xlsxStyle struct {
+	bold, fill, border bool
+	numFmt             int
+	align              string
+}
+
+// xlsxCell - a worksheet cell: a number (value is the numeric value), or an inline string
+type xlsxCell struct {
+	value   string
+	numeric bool
+	style   int
+}
+
+// xlsxSheet - a worksheet with the cell rows and the column widths
+type xlsxSheet struct {
+	name   string
+	rows   [][]xlsxCell
+	widths []float64
+	grid   bool // frozen header row and autofilter
+	filter int  // the last row of the autofilter range
+}
+
+// xlsxBook - the worksheets and the cell formats of a spreadsheet
+type xlsxBook struct {
+	sheets []*xlsxSheet
+	styles []xlsxStyle
+}
+
+// getStyle returns the cellXfs index of a cell format.
+func (book *xlsxBook) getStyle(style xlsxStyle) int {
+	for index, value := range book.styles {
+		if value == style {
+			return index
+		}
+	}
+	book.styles = append(book.styles, style)
+	return len(book.styles) - 1
+}
+
+// addSheet appends a new worksheet with a unique, valid sheet name.
+func (book *xlsxBook) addSheet(name string, grid bool) *xlsxSheet {
+	name = strings.TrimSpace(xlsxSheetName.ReplaceAllString(name, " "))
+	if name == "" {
+		name = "Sheet"
+	}
+	if len([]rune(name)) > 31 {
+		name = string([]rune(name)[:31])
+	}
+	sheetName := name
+	for index := 2; ; index++ {
+		found := false
+		for _, sheet := range book.sheets {
+			found = found || strings.EqualFold(sheet.name, sheetName)
+		}
+		if !found {
+			break
+		}
+		suffix := " (" + strconv.Itoa(index) + ")"
+		if runes := []rune(name); len(runes)+len(suffix) > 31 {
+			name = string(runes[:31-len(suffix)])
+		}
+		sheetName = name + suffix
+	}
+	sheet := &xlsxSheet{name: sheetName, grid: grid}
+	book.sheets = append(book.sheets, sheet)
+	return sheet
+}
+
+// xlsxAlign - the horizontal alignment of the template align values
+func xlsxAlign(align string) string {
+	switch strings.ToUpper(align) {
+	case "R", "RIGHT":
+		return "right"
+	case "C", "CENTER":
+		return "center"
+	}
+	return ""
+}
+
+// xlsxSerial - the Excel date serial number of a date or datetime value
+func xlsxSerial(value string) (float64, bool, bool) {
+	match := xlsxDate.FindStringSubmatch(value)
+	if match == nil {
+		return 0, false, false
+	}
+	layout, dateStr := "2006-01-02", match[1]
+	if match[2] != "" {
+		layout, dateStr = "2006-01-02 15:04:05", match[1]+" "+match[2]
+		if len(match[2]) == 5 {
+			dateStr += ":00"
+		}
+	}
+	date, err := time.Parse(layout, dateStr)
+	if err != nil {
+		return 0, false, false
+	}
+	serial := date.Sub(time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)).Hours() / 24
+	return serial, true, serial != math.Floor(serial)
+}
+
+// newCell returns a typed (number, date or string) cell of a text value.
+func (book *xlsxBook) newCell(value string, style xlsxStyle) xlsxCell {
+	value = strings.TrimSpace(value)
+	if xlsxNumber.MatchString(value) && len(value) < 16 {
+		if strings.Contains(value, ".") {
+			style.numFmt = _xlsxDecimal
+		}
+		return xlsxCell{value: value, numeric: true, style: book.getStyle(style)}
+	}
+	if serial, valid, withTime := xlsxSerial(value); valid {
+		style.numFmt = _xlsxDate
+		if withTime {
+			style.numFmt = _xlsxDateTime
+		}
+		return xlsxCell{value: strconv.FormatFloat(serial, 'f', -1, 64), numeric: true, style: book.getStyle(style)}
+	}
+	return xlsxCell{value: value, style: book.getStyle(style)}
+}
+
+// addRow appends a cell row and updates the column widths by the text lengths.
+func (sheet *xlsxSheet) addRow(cells []xlsxCell, values []string) {
+	for index, value := range values {
+		width := 0.0
+		for _, line := range strings.Split(value, "\n") {
+			width = math.Max(width, float64(len([]rune(line)))+2)
+		}
+		for len(sheet.widths) <= index {
+			sheet.widths = append(sheet.widths, _xlsxMinWidth)
+		}
+		sheet.widths[index] = math.Max(sheet.widths[index], math.Min(width, _xlsxMaxWidth))
+	}
+	sheet.rows = append(sheet.rows, cells)
+}
+
+// xlsxColumn - the column name of a zero based column index (A, B ... AA)
+func xlsxColumn(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+func xlsxEscape(value string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
+
+func (sheet *xlsxSheet) xml() string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	sb.WriteString(`<worksheet xmlns="` + _xlsxMain + `" xmlns:r="` + _xlsxRelations + `">`)
+	if sheet.grid && len(sheet.rows) > 1 {
+		sb.WriteString(`<sheetViews><sheetView workbookViewId="0">` +
+			`<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	}
+	if len(sheet.widths) > 0 {
+		sb.WriteString("<cols>")
+		for index, width := range sheet.widths {
+			sb.WriteString(fmt.Sprintf(`<col min="%d" max="%d" width="%g" customWidth="1"/>`, index+1, index+1, width))
+		}
+		sb.WriteString("</cols>")
+	}
+	sb.WriteString("<sheetData>")
+	for ri, row := range sheet.rows {
+		sb.WriteString(fmt.Sprintf(`<row r="%d">`, ri+1))
+		for ci, cell := range row {
+			ref := xlsxColumn(ci) + strconv.Itoa(ri+1)
+			switch {
+			case cell.numeric:
+				sb.WriteString(fmt.Sprintf(`<c r="%s" s="%d"><v>%s</v></c>`, ref, cell.style, cell.value))
+			case cell.value == "":
+				sb.WriteString(fmt.Sprintf(`<c r="%s" s="%d"/>`, ref, cell.style))
+			default:
+				sb.WriteString(fmt.Sprintf(`<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+					ref, cell.style, xlsxEscape(cell.value)))
+			}
+		}
+		sb.WriteString("</row>")
+	}
+	sb.WriteString("</sheetData>")
+	if sheet.grid && sheet.filter > 1 {
+		sb.WriteString(fmt.Sprintf(`<autoFilter ref="A1:%s%d"/>`, xlsxColumn(len(sheet.rows[0])-1), sheet.filter))
+	}
+	sb.WriteString("</worksheet>")
+	return sb.String()
+}
+
+func (book *xlsxBook) stylesXML() string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	sb.WriteString(`<styleSheet xmlns="` + _xlsxMain + `">`)
+	sb.WriteString(fmt.Sprintf(`<numFmts count="2"><numFmt numFmtId="%d" formatCode="yyyy\-mm\-dd"/>`+
+		`<numFmt numFmtId="%d" formatCode="yyyy\-mm\-dd\ hh:mm"/></numFmts>`, _xlsxDate, _xlsxDateTime))
+	sb.WriteString(`<fonts count="2"><font><sz val="10"/><name val="Calibri"/><family val="2"/></font>` +
+		`<font><b/><sz val="10"/><name val="Calibri"/><family val="2"/></font></fonts>`)
+	sb.WriteString(`<fills count="3"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill>` +
+		`<fill><patternFill patternType="solid"><fgColor rgb="FFE6E6E6"/><bgColor indexed="64"/></patternFill></fill></fills>`)
+	sb.WriteString(`<borders count="2"><border><left/><right/><top/><bottom/><diagonal/></border><border>`)
+	for _, side := range []string{"left", "right", "top", "bottom"} {
+		sb.WriteString(`<` + side + ` style="thin"><color rgb="FF646464"/></` + side + `>`)
+	}
+	sb.WriteString(`<diagonal/></border></borders>`)
+	sb.WriteString(`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>`)
+	sb.WriteString(fmt.Sprintf(`<cellXfs count="%d">`, len(book.styles)))
+	for _, style := range book.styles {
+		fontID, fillID, borderID := 0, 0, 0
+		if style.bold {
+			fontID = 1
+		}
+		if style.fill {
+			fillID = 2
+		}
+		if style.border {
+			borderID = 1
+		}
+		sb.WriteString(fmt.Sprintf(`<xf numFmtId="%d" fontId="%d" fillId="%d" borderId="%d" xfId="0"`,
+			style.numFmt, fontID, fillID, borderID))
+		sb.WriteString(` applyNumberFormat="1" applyFont="1" applyFill="1" applyBorder="1"`)
+		if style.align != "" {
+			sb.WriteString(`><alignment horizontal="` + style.align + `"/></xf>`)
+		} else {
+			sb.WriteString(`/>`)
+		}
+	}
+	sb.WriteString(`</cellXfs><cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles></styleSheet>`)
+	return sb.String()
+}
+
+// save writes the OOXML package of the workbook.
+func (book *xlsxBook) save(title string) ([]byte, error) {
+	files := []struct{ name, body string }{}
+	header := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+	types := header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>`
+	workbook := header + `<workbook xmlns="` + _xlsxMain + `" xmlns:r="` + _xlsxRelations + `"><sheets>`
+	rels := header + `<Relationships xmlns="` + _xlsxPackage + `">`
+	for index, sheet := range book.sheets {
+		types += fmt.Sprintf(`<Override PartName="/xl/worksheets/sheet%d.xml" `+
+			`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, index+1)
+		workbook += fmt.Sprintf(`<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, xlsxEscape(sheet.name), index+1, index+1)
+		rels += fmt.Sprintf(`<Relationship Id="rId%d" Type="`+_xlsxRelations+`/worksheet" Target="worksheets/sheet%d.xml"/>`,
+			index+1, index+1)
+		files = append(files, struct{ name, body string }{fmt.Sprintf("xl/worksheets/sheet%d.xml", index+1), sheet.xml()})
+	}
+	rels += fmt.Sprintf(`<Relationship Id="rId%d" Type="`+_xlsxRelations+`/styles" Target="styles.xml"/></Relationships>`,
+		len(book.sheets)+1)
+	files = append(files,
+		struct{ name, body string }{"[Content_Types].xml", types + `</Types>`},
+		struct{ name, body string }{"_rels/.rels", header + `<Relationships xmlns="` + _xlsxPackage + `">` +
+			`<Relationship Id="rId1" Type="` + _xlsxRelations + `/officeDocument" Target="xl/workbook.xml"/>` +
+			`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" ` +
+			`Target="docProps/core.xml"/></Relationships>`},
+		struct{ name, body string }{"docProps/core.xml", header +
+			`<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" ` +
+			`xmlns:dc="http://purl.org/dc/elements/1.1/"><dc:title>` + xlsxEscape(title) + `</dc:title>` +
+			`<dc:creator>Nervatura</dc:creator></cp:coreProperties>`},
+		struct{ name, body string }{"xl/workbook.xml", workbook + `</sheets></workbook>`},
+		struct{ name, body string }{"xl/_rels/workbook.xml.rels", rels},
+		struct{ name, body string }{"xl/styles.xml", book.stylesXML()})
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range files {
+		fw, err := zw.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = fw.Write([]byte(file.body)); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// addXlsxRows adds the cell rows of the header, details or footer elements to the report worksheet.
+func (rpt *Report) addXlsxRows(book *xlsxBook, sheet *xlsxSheet, elements []PageItem) {
+	for index := 0; index < len(elements); index++ {
+		if !rpt.itemVisible(elements[index]) {
+			continue
+		}
+		switch v := elements[index].Item.(type) {
+		case *Row:
+			cells, values := []xlsxCell{}, []string{}
+			columns := rpt.visibleItems(v.Columns)
+			for ci := 0; ci < len(columns); ci++ {
+				if cell, valid := columns[ci].Item.(*Cell); valid && !strings.Contains(cell.Value, "{{page}}") {
+					value := rpt.setValue(cell.Value)
+					style := xlsxStyle{bold: cell.Name == "label" || strings.Contains(cell.FontStyle, "bold"),
+						align: xlsxAlign(cell.Align)}
+					cells = append(cells, book.newCell(value, style))
+					values = append(values, value)
+				}
+			}
+			if len(cells) > 0 {
+				sheet.addRow(cells, values)
+			}
+		case *VGap:
+			if len(sheet.rows) > 0 && len(sheet.rows[len(sheet.rows)-1]) > 0 {
+				sheet.addRow([]xlsxCell{}, []string{})
+			}
+		case *HTML:
+			value := strings.TrimSpace(xlsxTags.ReplaceAllString(rpt.setHTMLValue(v.Value, ut.ToString(v.Fieldname, "head")), " "))
+			if value != "" {
+				sheet.addRow([]xlsxCell{book.newCell(value, xlsxStyle{})}, []string{""})
+			}
+		}
+	}
+}
+
+// addXlsxGrid adds a datagrid worksheet: the styled header row, the typed data rows and the footer (totals) row.
+func (rpt *Report) addXlsxGrid(book *xlsxBook, grid *Datagrid) {
+	grid = rpt.visibleGrid(grid)
+	rows, valid := rpt.data[grid.Databind].([]SM)
+	if !valid || len(rows) == 0 || len(grid.Columns) == 0 {
+		return
+	}
+	sheet := book.addSheet(ut.ToString(grid.Name, grid.Databind), true)
+	columns := []*Column{}
+	cells, values := []xlsxCell{}, []string{}
+	for index := 0; index < len(grid.Columns); index++ {
+		if column, valid := grid.Columns[index].Item.(*Column); valid {
+			columns = append(columns, column)
+			label := rpt.setValue(column.Label)
+			if label == "" {
+				label = column.Fieldname
+			}
+			cells = append(cells, xlsxCell{value: label, style: book.getStyle(xlsxStyle{bold: true, fill: true, border: true,
+				align: xlsxAlign(ut.ToString(column.HeaderAlign, column.Align))})})
+			values = append(values, label)
+		}
+	}
+	sheet.addRow(cells, values)
+	for _, row := range rpt.gridRows(grid, columns) {
+		cells, values := []xlsxCell{}, []string{}
+		for ci, column := range columns {
+			value := row.values[ci]
+			style := xlsxStyle{border: true, align: xlsxAlign(column.Align)}
+			if row.kind != "row" {
+				style.bold, style.fill = true, true
+				if ci == 0 && value == "" {
+					value, style.align = row.label, ""
+				}
+			}
+			cells = append(cells, book.newCell(value, style))
+			values = append(values, value)
+		}
+		sheet.addRow(cells, values)
+	}
+	sheet.filter = len(sheet.rows)
+	cells, values = []xlsxCell{}, []string{}
+	footer := false
+	for _, column := range columns {
+		value := rpt.setValue(column.Footer)
+		footer = footer || value != ""
+		cells = append(cells, book.newCell(value, xlsxStyle{bold: true, fill: true, border: true,
+			align: xlsxAlign(ut.ToString(column.FooterAlign, column.Align))}))
+		values = append(values, value)
+	}
+	if footer {
+		sheet.addRow(cells, values)
+	}
+}
+
+// Save2Xlsx creates an XLSX (Excel spreadsheet) output. The cells of the header, details and footer
+// rows are written to the first worksheet (report title), and every datagrid with data gets a worksheet
+// with a header row, the data rows and the footer values. The number and date values are typed cells.
+func (rpt *Report) Save2Xlsx() ([]byte, error) {
+	book := &xlsxBook{}
+	book.getStyle(xlsxStyle{})
+	sheet := book.addSheet(rpt.Title, false)
+	rpt.addXlsxRows(book, sheet, rpt.header)
+	rpt.addXlsxRows(book, sheet, rpt.details)
+	if len(sheet.rows) > 0 && len(sheet.rows[len(sheet.rows)-1]) > 0 && len(rpt.footer) > 0 {
+		sheet.addRow([]xlsxCell{}, []string{})
+	}
+	rpt.addXlsxRows(book, sheet, rpt.footer)
+	for index := 0; index < len(rpt.details); index++ {
+		if grid, valid := rpt.details[index].Item.(*Datagrid); valid && rpt.itemVisible(rpt.details[index]) {
+			rpt.addXlsxGrid(book, grid)
+		}
+	}
+	if len(sheet.rows) == 0 && len(book.sheets) > 1 {
+		book.sheets = book.sheets[1:]
+	}
+	return book.save(rpt.Title)
+}
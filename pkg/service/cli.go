@@ -1,7 +1,9 @@
 package service
 
 import (
+	"bytes"
 	"errors"
+	"os"
 
 	nt "github.com/nervatura/nervatura-service/pkg/nervatura"
 	ut "github.com/nervatura/nervatura-service/pkg/utils"
@@ -15,6 +17,9 @@ type CLIService struct {
 
 func respondData(code int, data interface{}, errCode int, err error) string {
 	if err != nil {
+		if errors.Is(err, nt.ErrPermissionDenied) {
+			errCode = 403
+		}
 		data = nt.IM{"code": errCode, "message": err.Error()}
 	}
 	if data == nil {
@@ -80,6 +85,14 @@ func (srv *CLIService) TokenRefresh(api *nt.API) string {
 }
 
 func (srv *CLIService) Get(api *nt.API, options nt.IM) string {
+	if ut.ToBoolean(options["total"], false) {
+		total, err := api.GetTotal(options)
+		if err != nil {
+			return respondData(200, nil, 400, err)
+		}
+		results, err := api.Get(options)
+		return respondData(200, nt.IM{"total": total, "items": results}, 400, err)
+	}
 	results, err := api.Get(options)
 	return respondData(200, results, 400, err)
 }
@@ -94,6 +107,11 @@ func (srv *CLIService) Function(api *nt.API, options nt.IM) string {
 	return respondData(200, results, 400, err)
 }
 
+func (srv *CLIService) FunctionList(api *nt.API) string {
+	results, err := api.FunctionList()
+	return respondData(200, results, 400, err)
+}
+
 func (srv *CLIService) Update(api *nt.API, nervatype string, data []nt.IM) string {
 	results, err := api.Update(nervatype, data)
 	return respondData(200, results, 400, err)
@@ -104,6 +122,53 @@ func (srv *CLIService) Delete(api *nt.API, options nt.IM) string {
 	return respondData(204, nil, 400, err)
 }
 
+func (srv *CLIService) Export(api *nt.API, options nt.IM) string {
+	filename := ut.ToString(options["filename"], "")
+	if filename == "" {
+		var buf bytes.Buffer
+		_, err := api.Export(options, &buf)
+		if err != nil {
+			return respondData(0, nil, 400, err)
+		}
+		return buf.String()
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return respondData(0, nil, 400, err)
+	}
+	defer file.Close()
+	rows, err := api.Export(options, file)
+	return respondData(200, nt.IM{"rows": rows, "filename": filename}, 400, err)
+}
+
+func (srv *CLIService) Import(api *nt.API, options nt.IM) string {
+	filename := ut.ToString(options["filename"], "")
+	if filename == "" {
+		return respondData(0, nil, 400, errors.New(ut.GetMessage("missing_required_field")+": filename"))
+	}
+	file, err := os.Open(filename)
+	if err != nil {
+		return respondData(0, nil, 400, err)
+	}
+	defer file.Close()
+	result, err := api.Import(options, file)
+	return respondData(200, result, 400, err)
+}
+
+func (srv *CLIService) BankImport(api *nt.API, options nt.IM) string {
+	filename := ut.ToString(options["filename"], "")
+	if filename == "" {
+		return respondData(0, nil, 400, errors.New(ut.GetMessage("missing_required_field")+": filename"))
+	}
+	file, err := os.Open(filename)
+	if err != nil {
+		return respondData(0, nil, 400, err)
+	}
+	defer file.Close()
+	result, err := api.BankImport(options, file)
+	return respondData(200, result, 400, err)
+}
+
 func (srv *CLIService) DatabaseCreate(apiKey string, options nt.IM) string {
 	if srv.Config["NT_API_KEY"] != apiKey {
 		return respondData(0, nil, 401, errors.New(ut.GetMessage("error_unauthorized")))
@@ -112,6 +177,49 @@ func (srv *CLIService) DatabaseCreate(apiKey string, options nt.IM) string {
 	return respondData(200, log, 400, err)
 }
 
+func (srv *CLIService) DatabaseUpgrade(apiKey string, options nt.IM) string {
+	if srv.Config["NT_API_KEY"] != apiKey {
+		return respondData(0, nil, 401, errors.New(ut.GetMessage("error_unauthorized")))
+	}
+	log, err := (&nt.API{NStore: srv.GetNervaStore("")}).DatabaseUpgrade(options)
+	return respondData(200, log, 400, err)
+}
+
+func (srv *CLIService) DatabaseBackup(apiKey string, options nt.IM) string {
+	if srv.Config["NT_API_KEY"] != apiKey {
+		return respondData(0, nil, 401, errors.New(ut.GetMessage("error_unauthorized")))
+	}
+	filename := ut.ToString(options["filename"], "")
+	if filename == "" {
+		return respondData(0, nil, 400, errors.New(ut.GetMessage("missing_required_field")+": filename"))
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return respondData(0, nil, 400, err)
+	}
+	defer file.Close()
+	result, err := (&nt.API{NStore: srv.GetNervaStore("")}).DatabaseBackup(options, file)
+	result["filename"] = filename
+	return respondData(200, result, 400, err)
+}
+
+func (srv *CLIService) DatabaseRestore(apiKey string, options nt.IM) string {
+	if srv.Config["NT_API_KEY"] != apiKey {
+		return respondData(0, nil, 401, errors.New(ut.GetMessage("error_unauthorized")))
+	}
+	filename := ut.ToString(options["filename"], "")
+	if filename == "" {
+		return respondData(0, nil, 400, errors.New(ut.GetMessage("missing_required_field")+": filename"))
+	}
+	file, err := os.Open(filename)
+	if err != nil {
+		return respondData(0, nil, 400, err)
+	}
+	defer file.Close()
+	log, err := (&nt.API{NStore: srv.GetNervaStore("")}).DatabaseRestore(options, file)
+	return respondData(200, log, 400, err)
+}
+
 func (srv *CLIService) ReportList(api *nt.API, options nt.IM) string {
 	if api.NStore.User.Scope != "admin" {
 		return respondData(0, nil, 401, errors.New(ut.GetMessage("error_unauthorized")))
@@ -137,7 +245,7 @@ func (srv *CLIService) ReportDelete(api *nt.API, options nt.IM) string {
 }
 
 func (srv *CLIService) Report(api *nt.API, options nt.IM) string {
-	if _, found := options["output"]; !found || (options["output"] != "xml") {
+	if _, found := options["output"]; !found || (options["output"] != "xml" && options["output"] != "xlsx" && options["output"] != "html") {
 		options["output"] = "base64"
 	}
 	results, err := api.Report(options)
@@ -3,10 +3,12 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	nt "github.com/nervatura/nervatura-service/pkg/nervatura"
 	pb "github.com/nervatura/nervatura-service/pkg/proto"
@@ -692,6 +694,37 @@ func (srv *RPCService) DatabaseCreate(ctx context.Context, req *pb.RequestDataba
 	return log, err
 }
 
+// DatabaseBackup - write the database to a backup archive
+func (srv *RPCService) DatabaseBackup(ctx context.Context, req *pb.RequestDatabaseBackup) (res *pb.ResponseDatabaseBackup, err error) {
+	api := ctx.Value(NstoreCtxKey).(*nt.API)
+	var buf bytes.Buffer
+	_, err = api.DatabaseBackup(nt.IM{"database": req.Alias}, &buf)
+	if err != nil {
+		return res, err
+	}
+	return &pb.ResponseDatabaseBackup{Value: buf.Bytes()}, nil
+}
+
+// DatabaseRestore - load a backup archive into a new, empty database
+func (srv *RPCService) DatabaseRestore(ctx context.Context, req *pb.RequestDatabaseRestore) (log *pb.ResponseDatabaseRestore, err error) {
+	api := ctx.Value(NstoreCtxKey).(*nt.API)
+	results, err := api.DatabaseRestore(nt.IM{"database": req.Alias}, bytes.NewReader(req.Value))
+	if err != nil {
+		return log, err
+	}
+	return &pb.ResponseDatabaseRestore{Details: srv.rowMap(results)}, nil
+}
+
+// DatabaseUpgrade - upgrade the schema of an existing database
+func (srv *RPCService) DatabaseUpgrade(ctx context.Context, req *pb.RequestDatabaseUpgrade) (log *pb.ResponseDatabaseUpgrade, err error) {
+	api := ctx.Value(NstoreCtxKey).(*nt.API)
+	results, err := api.DatabaseUpgrade(nt.IM{"database": req.Alias, "dry_run": req.DryRun})
+	if err != nil {
+		return log, err
+	}
+	return &pb.ResponseDatabaseUpgrade{Details: srv.rowMap(results)}, nil
+}
+
 // Get - returns one or more records
 func (srv *RPCService) Get(ctx context.Context, req *pb.RequestGet) (res *pb.ResponseGet, err error) {
 	res = &pb.ResponseGet{
@@ -707,9 +740,27 @@ func (srv *RPCService) Get(ctx context.Context, req *pb.RequestGet) (res *pb.Res
 			ids = append(ids, strconv.FormatInt(req.Ids[i], 10))
 		}
 		options["ids"] = strings.Join(ids, ",")
+	} else if req.FilterTree != "" {
+		options["filter"] = req.FilterTree
 	} else if len(req.Filter) > 0 {
 		options["filter"] = strings.Join(req.Filter, "|")
 	}
+	if len(req.Fields) > 0 {
+		options["fields"] = req.Fields
+	}
+	if len(req.Order) > 0 {
+		options["order"] = req.Order
+	}
+	options["limit"] = req.Limit
+	options["offset"] = req.Offset
+	if req.Cursor > 0 {
+		options["cursor"] = req.Cursor
+	}
+	if req.Total {
+		if res.Total, err = api.GetTotal(options); err != nil {
+			return res, err
+		}
+	}
 	results, err := api.Get(options)
 
 	for i := 0; i < len(results); i++ {
@@ -719,6 +770,80 @@ func (srv *RPCService) Get(ctx context.Context, req *pb.RequestGet) (res *pb.Res
 	return res, err
 }
 
+// Export the records of a nervatype to CSV or JSON Lines format
+func (srv *RPCService) Export(ctx context.Context, req *pb.RequestExport) (res *pb.ResponseExport, err error) {
+	api := ctx.Value(NstoreCtxKey).(*nt.API)
+	options := nt.IM{
+		"nervatype": pb.DataType_name[int32(req.Nervatype)], "format": req.Format,
+		"filter": req.Filter, "metadata": req.Metadata, "batch": req.Batch,
+	}
+	if len(req.Ids) > 0 {
+		ids := []string{}
+		for i := 0; i < len(req.Ids); i++ {
+			ids = append(ids, strconv.FormatInt(req.Ids[i], 10))
+		}
+		options["ids"] = strings.Join(ids, ",")
+	}
+	var buf bytes.Buffer
+	rows, err := api.Export(options, &buf)
+	return &pb.ResponseExport{Value: buf.Bytes(), Rows: rows}, err
+}
+
+// Add or update the records of a CSV or JSON Lines file
+func (srv *RPCService) Import(ctx context.Context, req *pb.RequestImport) (res *pb.ResponseImport, err error) {
+	api := ctx.Value(NstoreCtxKey).(*nt.API)
+	options := nt.IM{
+		"nervatype": pb.DataType_name[int32(req.Nervatype)], "format": req.Format,
+		"batch": req.Batch, "dry_run": req.DryRun,
+	}
+	result, err := api.Import(options, bytes.NewReader(req.Value))
+	res = &pb.ResponseImport{
+		Rows:    ut.ToInteger(result["rows"], 0),
+		Success: ut.ToInteger(result["success"], 0),
+		Failed:  ut.ToInteger(result["failed"], 0),
+		Errors:  []*pb.ResponseImport_Error{},
+	}
+	for _, rowError := range result["errors"].([]nt.IM) {
+		res.Errors = append(res.Errors, &pb.ResponseImport_Error{
+			Row: ut.ToInteger(rowError["row"], 0), Message: ut.ToString(rowError["message"], "")})
+	}
+	return res, err
+}
+
+// Import CAMT.053 or MT940 bank statements and match the payments to the open invoices
+func (srv *RPCService) BankImport(ctx context.Context, req *pb.RequestBankImport) (res *pb.ResponseBankImport, err error) {
+	api := ctx.Value(NstoreCtxKey).(*nt.API)
+	options := nt.IM{"format": req.Format, "planumber": req.Planumber, "dry_run": req.DryRun}
+	result, err := api.BankImport(options, bytes.NewReader(req.Value))
+	res = &pb.ResponseBankImport{
+		Rows:       ut.ToInteger(result["rows"], 0),
+		Matched:    ut.ToInteger(result["matched"], 0),
+		DryRun:     ut.ToBoolean(result["dry_run"], false),
+		Statements: []*pb.ResponseBankImport_Statement{},
+		Matches:    []*pb.ResponseBankImport_Match{},
+		Unmatched:  []*pb.ResponseBankImport_Line{},
+	}
+	for _, statement := range result["statements"].([]nt.IM) {
+		res.Statements = append(res.Statements, &pb.ResponseBankImport_Statement{
+			Id: ut.ToString(statement["id"], ""), Account: ut.ToString(statement["account"], ""),
+			Rows: ut.ToInteger(statement["rows"], 0), TransId: ut.ToInteger(statement["trans_id"], 0),
+			Transnumber: ut.ToString(statement["transnumber"], "")})
+	}
+	for _, match := range result["matches"].([]nt.IM) {
+		res.Matches = append(res.Matches, &pb.ResponseBankImport_Match{
+			Statement: ut.ToString(match["statement"], ""), Row: ut.ToInteger(match["row"], 0),
+			Transnumber: ut.ToString(match["transnumber"], ""), Amount: ut.ToFloat(match["amount"], 0),
+			Paid: ut.ToBoolean(match["paid"], false)})
+	}
+	for _, line := range result["unmatched"].([]nt.IM) {
+		res.Unmatched = append(res.Unmatched, &pb.ResponseBankImport_Line{
+			Statement: ut.ToString(line["statement"], ""), Row: ut.ToInteger(line["row"], 0),
+			Paiddate: ut.ToString(line["paiddate"], ""), Amount: ut.ToFloat(line["amount"], 0),
+			Reference: ut.ToString(line["reference"], ""), Name: ut.ToString(line["name"], "")})
+	}
+	return res, err
+}
+
 // Add/update one or more items
 func (srv *RPCService) Update(ctx context.Context, req *pb.RequestUpdate) (res *pb.ResponseUpdate, err error) {
 	res = &pb.ResponseUpdate{}
@@ -785,6 +910,40 @@ func (srv *RPCService) Function(ctx context.Context, req *pb.RequestFunction) (r
 	return res, err
 }
 
+// List the registered server-side functions and the function scripts
+func (srv *RPCService) FunctionList(ctx context.Context, req *pb.RequestEmpty) (res *pb.ResponseFunctionList, err error) {
+	api := ctx.Value(NstoreCtxKey).(*nt.API)
+	res = &pb.ResponseFunctionList{
+		Items: []*pb.ResponseFunctionList_Info{},
+	}
+	results, err := api.FunctionList()
+	if err != nil {
+		return res, err
+	}
+	for _, fn := range results {
+		info := &pb.ResponseFunctionList_Info{
+			Name:        ut.ToString(fn["name"], ""),
+			Description: ut.ToString(fn["description"], ""),
+			Args:        []*pb.ResponseFunctionList_Arg{},
+			Audit:       []*pb.ResponseFunctionList_Audit{},
+			Transaction: ut.ToBoolean(fn["transaction"], false),
+			Script:      ut.ToBoolean(fn["script"], false),
+		}
+		for _, arg := range fn["args"].([]nt.IM) {
+			info.Args = append(info.Args, &pb.ResponseFunctionList_Arg{
+				Name: ut.ToString(arg["name"], ""), Type: ut.ToString(arg["type"], ""),
+				Required: ut.ToBoolean(arg["required"], false), Description: ut.ToString(arg["description"], "")})
+		}
+		for _, audit := range fn["audit"].([]nt.IM) {
+			info.Audit = append(info.Audit, &pb.ResponseFunctionList_Audit{
+				Nervatype: ut.ToString(audit["nervatype"], ""), Transtype: ut.ToString(audit["transtype"], ""),
+				Right: ut.ToString(audit["right"], "")})
+		}
+		res.Items = append(res.Items, info)
+	}
+	return res, nil
+}
+
 // List all available Nervatura Report.
 func (srv *RPCService) ReportList(ctx context.Context, req *pb.RequestReportList) (res *pb.ResponseReportList, err error) {
 	api := ctx.Value(NstoreCtxKey).(*nt.API)
@@ -867,3 +1026,57 @@ func (srv *RPCService) Report(ctx context.Context, req *pb.RequestReport) (res *
 	}
 	return res, err
 }
+
+func (srv *RPCService) Subscribe(req *pb.RequestSubscribe, stream pb.API_SubscribeServer) (err error) {
+	api := stream.Context().Value(NstoreCtxKey).(*nt.API)
+	options := nt.IM{
+		"nervatype": []string{},
+		"operation": req.Operations,
+		"filters":   srv.fieldsToIMap(req.Filters),
+	}
+	if req.Cursor > 0 {
+		options["cursor"] = req.Cursor
+	}
+	for i := 0; i < len(req.Nervatypes); i++ {
+		options["nervatype"] = append(options["nervatype"].([]string), pb.DataType_name[int32(req.Nervatypes[i])])
+	}
+	interval := time.Duration(ut.ToFloat(srv.Config["NT_EVENT_STREAM_INTERVAL"], 2) * float64(time.Second))
+	for {
+		results, err := api.Events(options)
+		if err != nil {
+			return err
+		}
+		options["cursor"] = results["cursor"]
+		events := results["events"].([]nt.IM)
+		for i := 0; i < len(events); i++ {
+			// the events of the models without a DataType value (e.g. account, journal, ui_script) are not sent
+			nervatype, found := pb.DataType_value[ut.ToString(events[i]["nervatype"], "")]
+			if !found {
+				continue
+			}
+			event := &pb.ResponseEvent{
+				Id:        ut.ToInteger(events[i]["id"], 0),
+				Nervatype: pb.DataType(nervatype),
+				RefId:     ut.ToInteger(events[i]["ref_id"], 0),
+				Refnumber: ut.ToString(events[i]["refnumber"], ""),
+				Operation: ut.ToString(events[i]["operation"], ""),
+				Username:  ut.ToString(events[i]["username"], ""),
+				Crdate:    ut.ToString(events[i]["crdate"], ""),
+				Values:    make(map[string]*pb.Value),
+			}
+			for fieldname, value := range events[i]["values"].(nt.IM) {
+				event.Values[fieldname] = getValue(value)
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+		if len(events) == 0 {
+			select {
+			case <-stream.Context().Done():
+				return nil
+			case <-time.After(interval):
+			}
+		}
+	}
+}
@@ -3,6 +3,7 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
@@ -34,6 +35,9 @@ func (srv *HTTPService) respondMessage(w http.ResponseWriter, code int, payload
 	if err != nil || payload != nil {
 		w.Header().Set(contentKey, "application/json")
 		if err != nil {
+			if errors.Is(err, nt.ErrPermissionDenied) {
+				errCode = http.StatusForbidden
+			}
 			w.WriteHeader(errCode)
 			response, jerr = ut.ConvertToByte(nt.SM{"code": strconv.Itoa(errCode), "message": err.Error()})
 		} else {
@@ -161,9 +165,28 @@ func (srv *HTTPService) GetFilter(w http.ResponseWriter, r *http.Request) {
 	for index := 0; index < len(query); index++ {
 		if strings.HasPrefix(query[index], "filter=") {
 			params["filter"] = query[index][7:]
+			// JSON filter tree
+			if filter, err := url.QueryUnescape(query[index][7:]); err == nil &&
+				(strings.HasPrefix(filter, "{") || strings.HasPrefix(filter, "[")) {
+				params["filter"] = filter
+			}
 		}
 	}
-	results, err := (&nt.API{NStore: r.Context().Value(NstoreCtxKey).(*nt.NervaStore)}).Get(params)
+	for _, key := range []string{"fields", "order", "limit", "offset", "cursor"} {
+		if r.URL.Query().Get(key) != "" {
+			params[key] = r.URL.Query().Get(key)
+		}
+	}
+	api := &nt.API{NStore: r.Context().Value(NstoreCtxKey).(*nt.NervaStore)}
+	if ut.ToBoolean(r.URL.Query().Get("total"), false) {
+		total, err := api.GetTotal(params)
+		if err != nil {
+			srv.respondMessage(w, 0, nil, http.StatusBadRequest, err)
+			return
+		}
+		w.Header().Set("X-Total-Count", strconv.FormatInt(total, 10))
+	}
+	results, err := api.Get(params)
 	srv.respondMessage(w, http.StatusOK, results, http.StatusBadRequest, err)
 }
 
@@ -179,6 +202,54 @@ func (srv *HTTPService) GetIds(w http.ResponseWriter, r *http.Request) {
 	srv.respondMessage(w, http.StatusOK, results, http.StatusBadRequest, err)
 }
 
+func (srv *HTTPService) Export(w http.ResponseWriter, r *http.Request) {
+	if r.Context().Value(NstoreCtxKey) == nil {
+		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
+		return
+	}
+
+	options := nt.IM{"nervatype": srv.GetParam(r, "nervatype"), "format": r.URL.Query().Get("format"),
+		"filter": r.URL.Query().Get("filter"), "ids": r.URL.Query().Get("ids"),
+		"metadata": r.URL.Query().Get("metadata"), "batch": r.URL.Query().Get("batch")}
+	if options["format"] == "jsonl" {
+		w.Header().Set(contentKey, "application/x-ndjson")
+	} else {
+		w.Header().Set(contentKey, "text/csv")
+	}
+	var buf bytes.Buffer
+	_, err := (&nt.API{NStore: r.Context().Value(NstoreCtxKey).(*nt.NervaStore)}).Export(options, &buf)
+	if err != nil {
+		srv.respondMessage(w, 0, nil, http.StatusBadRequest, err)
+		return
+	}
+	w.Write(buf.Bytes())
+}
+
+func (srv *HTTPService) Import(w http.ResponseWriter, r *http.Request) {
+	if r.Context().Value(NstoreCtxKey) == nil {
+		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
+		return
+	}
+
+	options := nt.IM{"nervatype": srv.GetParam(r, "nervatype"), "format": r.URL.Query().Get("format"),
+		"batch": r.URL.Query().Get("batch"), "dry_run": r.URL.Query().Get("dry_run")}
+	result, err := (&nt.API{NStore: r.Context().Value(NstoreCtxKey).(*nt.NervaStore)}).Import(options, r.Body)
+	srv.respondMessage(w, http.StatusOK, result, http.StatusBadRequest, err)
+}
+
+func (srv *HTTPService) BankImport(w http.ResponseWriter, r *http.Request) {
+	if r.Context().Value(NstoreCtxKey) == nil {
+		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
+		return
+	}
+
+	options := nt.IM{"format": r.URL.Query().Get("format"), "planumber": r.URL.Query().Get("planumber"),
+		"dry_run": r.URL.Query().Get("dry_run")}
+	r.Body = http.MaxBytesReader(w, r.Body, ut.ToInteger(srv.Config["NT_BANK_MAX_FILE_SIZE"], 10)*1024*1024)
+	result, err := (&nt.API{NStore: r.Context().Value(NstoreCtxKey).(*nt.NervaStore)}).BankImport(options, r.Body)
+	srv.respondMessage(w, http.StatusOK, result, http.StatusBadRequest, err)
+}
+
 func (srv *HTTPService) View(w http.ResponseWriter, r *http.Request) {
 	if r.Context().Value(NstoreCtxKey) == nil {
 		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
@@ -211,6 +282,15 @@ func (srv *HTTPService) Function(w http.ResponseWriter, r *http.Request) {
 	srv.respondMessage(w, http.StatusOK, results, http.StatusBadRequest, err)
 }
 
+func (srv *HTTPService) FunctionList(w http.ResponseWriter, r *http.Request) {
+	if r.Context().Value(NstoreCtxKey) == nil {
+		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
+		return
+	}
+	results, err := (&nt.API{NStore: r.Context().Value(NstoreCtxKey).(*nt.NervaStore)}).FunctionList()
+	srv.respondMessage(w, http.StatusOK, results, http.StatusBadRequest, err)
+}
+
 func (srv *HTTPService) Update(w http.ResponseWriter, r *http.Request) {
 	if r.Context().Value(NstoreCtxKey) == nil {
 		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
@@ -250,6 +330,46 @@ func (srv *HTTPService) DatabaseCreate(w http.ResponseWriter, r *http.Request) {
 	srv.respondMessage(w, http.StatusOK, log, http.StatusBadRequest, err)
 }
 
+func (srv *HTTPService) DatabaseUpgrade(w http.ResponseWriter, r *http.Request) {
+	apiKey := r.Header.Get("X-Api-Key")
+	if srv.Config["NT_API_KEY"] != apiKey {
+		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
+		return
+	}
+	data := nt.IM{"database": r.URL.Query().Get("alias"), "dry_run": r.URL.Query().Get("dry_run")}
+	log, err := (&nt.API{NStore: nt.New(&db.SQLDriver{Config: srv.Config}, srv.Config)}).DatabaseUpgrade(data)
+	srv.respondMessage(w, http.StatusOK, log, http.StatusBadRequest, err)
+}
+
+func (srv *HTTPService) DatabaseBackup(w http.ResponseWriter, r *http.Request) {
+	apiKey := r.Header.Get("X-Api-Key")
+	if srv.Config["NT_API_KEY"] != apiKey {
+		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
+		return
+	}
+	data := nt.IM{"database": r.URL.Query().Get("alias")}
+	var buf bytes.Buffer
+	_, err := (&nt.API{NStore: nt.New(&db.SQLDriver{Config: srv.Config}, srv.Config)}).DatabaseBackup(data, &buf)
+	if err != nil {
+		srv.respondMessage(w, 0, nil, http.StatusBadRequest, err)
+		return
+	}
+	w.Header().Set(contentKey, "application/gzip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+r.URL.Query().Get("alias")+".backup\"")
+	w.Write(buf.Bytes())
+}
+
+func (srv *HTTPService) DatabaseRestore(w http.ResponseWriter, r *http.Request) {
+	apiKey := r.Header.Get("X-Api-Key")
+	if srv.Config["NT_API_KEY"] != apiKey {
+		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
+		return
+	}
+	data := nt.IM{"database": r.URL.Query().Get("alias")}
+	log, err := (&nt.API{NStore: nt.New(&db.SQLDriver{Config: srv.Config}, srv.Config)}).DatabaseRestore(data, r.Body)
+	srv.respondMessage(w, http.StatusOK, log, http.StatusBadRequest, err)
+}
+
 func (srv *HTTPService) ReportList(w http.ResponseWriter, r *http.Request) {
 	if r.Context().Value(NstoreCtxKey) == nil {
 		srv.respondMessage(w, 0, nil, http.StatusUnauthorized, errors.New(ut.GetMessage("error_unauthorized")))
@@ -355,6 +475,16 @@ func (srv *HTTPService) Report(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte(results["template"].(string)))
 		return
 	}
+	if results["filetype"] == "html" {
+		w.Header().Set(contentKey, "text/html; charset=utf-8")
+		w.Write([]byte(results["template"].(string)))
+		return
+	}
+	if results["filetype"] == "xlsx" {
+		w.Header().Set(contentKey, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		w.Write(results["template"].([]uint8))
+		return
+	}
 	if results["filetype"] == "xml" {
 		w.Header().Set(contentKey, "application/xml")
 		w.Write([]byte(results["template"].(string)))
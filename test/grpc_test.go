@@ -263,6 +263,21 @@ func TestRpcFunction(t *testing.T) {
 	fmt.Printf("Function %+v\n", string(resp.Value))
 }
 
+func TestRpcFunctionList(t *testing.T) {
+	conn, client := getClient(t)
+	defer conn.Close()
+
+	token := getRPCToken(t)
+	md := metadata.Pairs("Authorization", getAuth(token))
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.FunctionList(ctx, &pb.RequestEmpty{})
+	if err != nil {
+		t.Fatalf("FunctionList failed: %v", err)
+	}
+	fmt.Printf("FunctionList %+v\n", resp.Items)
+}
+
 func TestRpcReportList(t *testing.T) {
 	conn, client := getClient(t)
 	defer conn.Close()
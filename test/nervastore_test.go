@@ -9,7 +9,7 @@ import (
 
 func getNstore() *nt.NervaStore {
 	return nt.New(&db.SQLDriver{Config: nt.IM{}}, nt.IM{
-		"NT_ALIAS_TEST": "sqlite://file:data/demo.db?cache=shared&mode=rwc",
+		"NT_ALIAS_TEST": testAlias(),
 		"NT_HASHTABLE":  "ref17890714",
 	})
 }
@@ -1,8 +1,12 @@
 package test
 
 import (
+	"archive/zip"
+	"bytes"
 	"io/ioutil"
 	"path"
+	"strconv"
+	"strings"
 	"testing"
 
 	nt "github.com/nervatura/nervatura-service/pkg/nervatura"
@@ -226,6 +230,154 @@ func TestXMLData(t *testing.T) {
 	}
 }
 
+func TestXlsxData(t *testing.T) {
+	rpt := createGoReport(t)
+	xlsx, err := rpt.Save2Xlsx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(xlsx), int64(len(xlsx)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]bool{}
+	for _, file := range zr.File {
+		files[file.Name] = true
+	}
+	for _, name := range []string{"[Content_Types].xml", "xl/workbook.xml", "xl/styles.xml",
+		"xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"} {
+		if !files[name] {
+			t.Fatal("missing part:", name)
+		}
+	}
+	if err := ioutil.WriteFile("output/data.xlsx", xlsx, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHtmlData(t *testing.T) {
+	rpt := createGoReport(t)
+	html := rpt.Save2Html()
+	for _, value := range []string{"<title>Go Report</title>", `<table class="datagrid"`, "<thead>", "data:image/"} {
+		if !strings.Contains(html, value) {
+			t.Fatal("missing html:", value)
+		}
+	}
+	if err := ioutil.WriteFile("output/data.html", []byte(html), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDatagridGroups(t *testing.T) {
+	items := []nt.SM{}
+	for index := 0; index < 90; index++ {
+		items = append(items, nt.SM{"customer": "Customer " + strconv.Itoa(index/30+1),
+			"transnumber": "INV/" + strconv.Itoa(index+1), "amount": "10.50"})
+	}
+	jsonDef := `{"details":[{"datagrid":{"databind":"items","group-by":"customer","group-label":"labels.customer",
+		"subtotal-label":"Subtotal","carry-forward":true,"columns":[
+		{"column":{"fieldname":"counter","label":"No."}},
+		{"column":{"fieldname":"transnumber","label":"Invoice"}},
+		{"column":{"fieldname":"amount","label":"Amount","align":"right","subtotal":true}},
+		{"column":{"fieldname":"amount","label":"Balance","align":"right","running":true}}]}}]}`
+	rpt := report.New()
+	if err := rpt.LoadJSONDefinition(jsonDef); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rpt.SetData("labels", nt.SM{"customer": "Customer:"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rpt.SetData("items", items); err != nil {
+		t.Fatal(err)
+	}
+	html := rpt.Save2Html()
+	if strings.Count(html, `<tr class="group">`) != 3 || strings.Count(html, `<tr class="subtotal">`) != 3 ||
+		!strings.Contains(html, "Customer: Customer 2") || !strings.Contains(html, "Subtotal Customer 3") ||
+		strings.Count(html, ">315.00</td>") != 6 {
+		t.Fatal("datagrid groups")
+	}
+	rpt.CreateReport()
+	if _, err := rpt.Save2Pdf(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReportExpressions(t *testing.T) {
+	jsonDef := `{"details":[
+		{"row":{"if":"head.notax == 1","columns":[{"cell":{"value":"labels.notax"}}]}},
+		{"row":{"if":"!head.notax","columns":[{"cell":{"value":"VAT included"}}]}},
+		{"row":{"columns":[
+			{"cell":{"name":"netamount","value":"={{= number(head.netamount * 1.27, 2, 'hu')}} HUF"}},
+			{"cell":{"name":"discount","if":"head.discount > 0","value":"discount"}},
+			{"cell":{"name":"duedate","value":"={{= date(head.duedate, 'DD.MM.YYYY')}}"}},
+			{"cell":{"name":"status","value":"={{= head.paid ? upper('paid') : concat('due: ', count('items'), ' items')}}"}}]}},
+		{"datagrid":{"databind":"items","columns":[
+			{"column":{"fieldname":"description","label":"Description"}},
+			{"column":{"fieldname":"amount","label":"Amount","if":"sum('items', 'amount') > 100"}}]}}]}`
+	rpt := report.New()
+	if err := rpt.LoadJSONDefinition(jsonDef); err != nil {
+		t.Fatal(err)
+	}
+	rpt.SetData("labels", nt.SM{"notax": "VAT exempt"})
+	rpt.SetData("head", nt.SM{"notax": "1", "netamount": "1000000", "discount": "0", "duedate": "2021-12-24", "paid": "false"})
+	rpt.SetData("items", []nt.SM{{"description": "Item 1", "amount": "10"}, {"description": "Item 2", "amount": "20"}})
+	html := rpt.Save2Html()
+	for _, value := range []string{"VAT exempt", "1 270 000,00 HUF", "24.12.2021", "due: 2 items", ">Description</th>"} {
+		if !strings.Contains(html, value) {
+			t.Fatal("missing html:", value)
+		}
+	}
+	for _, value := range []string{"VAT included", ">discount<", ">Amount</th>"} {
+		if strings.Contains(html, value) {
+			t.Fatal("hidden html:", value)
+		}
+	}
+	rpt.CreateReport()
+	if _, err := rpt.Save2Pdf(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := report.New().LoadJSONDefinition(`{"details":[{"row":{"if":"head.notax ==","columns":[]}}]}`); err == nil {
+		t.Fatal("invalid expression")
+	}
+}
+
+func TestChartReport(t *testing.T) {
+	sales := []nt.SM{}
+	for index, month := range []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"} {
+		sales = append(sales, nt.SM{"month": month, "north": strconv.Itoa(1200 + index*150),
+			"south": strconv.Itoa(900 - index*110), "stock": strconv.FormatFloat(float64(index%4)*12.5, 'f', 2, 64)})
+	}
+	jsonDef := `{"details":[
+		{"chart":{"databind":"sales","chart-type":"bar","label":"month","title":"labels.title","height":70,"columns":[
+			{"column":{"fieldname":"north","label":"North"}},{"column":{"fieldname":"south","label":"South"}}]}},
+		{"chart":{"databind":"sales","chart-type":"stacked-bar","label":"month","colors":"#4E79A7,#F28E2B","columns":[
+			{"column":{"fieldname":"north","label":"North"}},{"column":{"fieldname":"south","label":"South"}}]}},
+		{"chart":{"databind":"sales","chart-type":"line","label":"month","width":"50%","columns":[
+			{"column":{"fieldname":"stock","label":"Stock"}}]}},
+		{"chart":{"databind":"sales","chart-type":"pie","label":"month","height":80,"columns":[
+			{"column":{"fieldname":"stock"}}]}},
+		{"chart":{"databind":"missing","columns":[{"column":{"fieldname":"stock"}}]}}]}`
+	rpt := report.New()
+	if err := rpt.LoadJSONDefinition(jsonDef); err != nil {
+		t.Fatal(err)
+	}
+	rpt.SetData("labels", nt.SM{"title": "Monthly sales"})
+	rpt.SetData("sales", sales)
+	columns, err := rpt.AppendElement("details", "chart", nt.IM{"databind": "sales", "chart-type": "line"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rpt.AppendElement(columns, "column", nt.IM{"fieldname": "north"}); err != nil {
+		t.Fatal(err)
+	}
+	rpt.CreateReport()
+	pdf, err := rpt.Save2Pdf()
+	if err != nil || !bytes.HasPrefix(pdf, []byte("%PDF")) {
+		t.Fatal("chart report", err)
+	}
+}
+
 func TestJSONReport(t *testing.T) {
 	json, _ := ut.Public.ReadFile(path.Join("static", "templates", "sample.json"))
 	rpt := report.New("L")
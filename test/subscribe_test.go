@@ -0,0 +1,70 @@
+//+build grpc all
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/nervatura/nervatura-service/pkg/database"
+	nt "github.com/nervatura/nervatura-service/pkg/nervatura"
+	pb "github.com/nervatura/nervatura-service/pkg/proto"
+	"github.com/nervatura/nervatura-service/pkg/service"
+	ut "github.com/nervatura/nervatura-service/pkg/utils"
+	"google.golang.org/grpc"
+)
+
+type subscribeStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*pb.ResponseEvent
+}
+
+func (stream *subscribeStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *subscribeStream) Send(event *pb.ResponseEvent) error {
+	stream.events = append(stream.events, event)
+	return nil
+}
+
+func TestSubscribe(t *testing.T) {
+	api := &nt.API{NStore: nt.New(&db.SQLDriver{Config: nt.IM{}}, nt.IM{
+		"NT_ALIAS_DEMO":    testAlias(),
+		"NT_HASHTABLE":     "ref17890714",
+		"NT_EVENT_ENABLED": true,
+	})}
+	_, _, err := api.UserLogin(nt.IM{"database": "demo", "username": "admin", "password": ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.Update("currency", []nt.IM{{"curr": "EVX", "description": "Event test"}}); err != nil {
+		t.Fatal(err)
+	}
+	results, err := api.Events(nt.IM{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cursor := ut.ToInteger(results["cursor"], 0)
+
+	// the account model has no DataType value
+	if _, err = api.Update("account", []nt.IM{{"accnumber": "EVT1", "description": "Event test", "acctype": "asset"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.Update("currency", []nt.IM{{"curr": "EVY", "description": "Event test"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), service.NstoreCtxKey, api), time.Second)
+	defer cancel()
+	stream := &subscribeStream{ctx: ctx}
+	srv := &service.RPCService{Config: map[string]interface{}{"NT_EVENT_STREAM_INTERVAL": 0.1}}
+	if err = srv.Subscribe(&pb.RequestSubscribe{Cursor: cursor}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.events) != 1 || stream.events[0].Nervatype != pb.DataType_currency {
+		t.Fatalf("subscribe events: %v", stream.events)
+	}
+}